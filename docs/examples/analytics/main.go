@@ -21,7 +21,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -32,56 +31,29 @@ import (
 
 func main() {
 	// Load credentials
-	clientID := os.Getenv("YOUTUBE_CLIENT_ID")
-	clientSecret := os.Getenv("YOUTUBE_CLIENT_SECRET")
-
-	if clientID == "" || clientSecret == "" {
-		log.Fatal("Set YOUTUBE_CLIENT_ID and YOUTUBE_CLIENT_SECRET environment variables")
+	config, err := auth.ConfigFromEnv("YOUTUBE")
+	if err != nil {
+		log.Fatalf("%v (set YOUTUBE_CLIENT_ID and YOUTUBE_CLIENT_SECRET)", err)
 	}
 
 	ctx := context.Background()
 
 	// Create auth client with analytics scopes
-	authClient := auth.NewAuthClient(auth.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		RedirectURL:  "http://localhost:8080/callback",
-		Scopes: []string{
-			auth.ScopeReadOnly,
-			auth.ScopePartner, // Required for analytics
-		},
-	})
-
-	// Auth flow
-	authDone := make(chan struct{})
-
-	http.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
-		state := fmt.Sprintf("%d", time.Now().UnixNano())
-		url := authClient.AuthorizationURL(state, auth.WithPrompt("consent"))
-		http.Redirect(w, r, url, http.StatusFound)
-	})
-
-	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
-		code := r.URL.Query().Get("code")
-		_, err := authClient.Exchange(r.Context(), code)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		_, _ = fmt.Fprintf(w, "Authentication successful! Check your terminal for the dashboard.")
-		close(authDone)
-	})
-
-	server := &http.Server{Addr: ":8080"}
-	go func() {
-		log.Println("Visit http://localhost:8080/login to authenticate")
-		_ = server.ListenAndServe()
-	}()
+	config.RedirectURL = "http://localhost:8080/callback"
+	config.Scopes = []string{
+		auth.ScopeReadOnly,
+		auth.ScopePartner, // Required for analytics
+	}
+	authClient := auth.NewAuthClient(config)
 
-	<-authDone
-	time.Sleep(500 * time.Millisecond)
-	_ = server.Shutdown(ctx)
+	// Auth flow: opens the browser and waits for the OAuth callback
+	_, err = auth.RunLocalCallback(ctx, authClient,
+		auth.WithCallbackAuthURLOptions(auth.WithPrompt("consent")),
+		auth.WithSuccessHTML("Authentication successful! Check your terminal for the dashboard."),
+	)
+	if err != nil {
+		log.Fatalf("Authentication failed: %v", err)
+	}
 
 	// Create analytics client with token provider
 	client := analytics.NewClient(
@@ -159,22 +131,14 @@ func printTopVideos(ctx context.Context, client *analytics.Client, startDate, en
 		return
 	}
 
-	rows := report.Rows()
-	if len(rows) == 0 {
+	if len(report.Rows()) == 0 {
 		fmt.Println("No data available")
 		fmt.Println()
 		return
 	}
 
-	for i, row := range rows {
-		videoID := row.GetString("video")
-		views := row.GetInt("views")
-		likes := row.GetInt("likes")
-		comments := row.GetInt("comments")
-
-		fmt.Printf("  %2d. %s\n", i+1, videoID)
-		fmt.Printf("      Views: %s | Likes: %d | Comments: %d\n",
-			formatNumber(views), likes, comments)
+	if err := report.RenderTable(os.Stdout, analytics.WithHumanizedNumbers(true)); err != nil {
+		log.Printf("Error rendering top videos: %v", err)
 	}
 	fmt.Println()
 }
@@ -209,6 +173,9 @@ func printDailyTrend(ctx context.Context, client *analytics.Client, startDate, e
 
 	for _, row := range rows {
 		date := row.GetString("day")
+		if day, ok := row.GetTime("day"); ok {
+			date = day.Format("Mon Jan 02")
+		}
 		views := row.GetInt("views")
 
 		// Create a simple bar chart
@@ -254,7 +221,7 @@ func printCountryBreakdown(ctx context.Context, client *analytics.Client, startD
 		pct := float64(views) / float64(totalViews) * 100
 
 		fmt.Printf("  %2d. %s: %s (%.1f%%)\n",
-			i+1, countryName(country), formatNumber(views), pct)
+			i+1, analytics.DimensionDisplayName(analytics.DimensionCountry, country, "en"), formatNumber(views), pct)
 	}
 	fmt.Println()
 }
@@ -283,7 +250,7 @@ func printDeviceBreakdown(ctx context.Context, client *analytics.Client, startDa
 		pct := float64(views) / float64(totalViews) * 100
 
 		fmt.Printf("  %-15s: %s (%.1f%%)\n",
-			deviceName(device), formatNumber(views), pct)
+			analytics.DimensionDisplayName(analytics.DimensionDeviceType, device, "en"), formatNumber(views), pct)
 	}
 	fmt.Println()
 }
@@ -317,37 +284,3 @@ func formatSeconds(seconds float64) string {
 	}
 	return fmt.Sprintf("%.0fs", seconds)
 }
-
-func countryName(code string) string {
-	countries := map[string]string{
-		"US": "United States",
-		"GB": "United Kingdom",
-		"CA": "Canada",
-		"AU": "Australia",
-		"DE": "Germany",
-		"FR": "France",
-		"JP": "Japan",
-		"BR": "Brazil",
-		"IN": "India",
-		"MX": "Mexico",
-	}
-	if name, ok := countries[code]; ok {
-		return name
-	}
-	return code
-}
-
-func deviceName(device string) string {
-	devices := map[string]string{
-		"MOBILE":       "Mobile",
-		"DESKTOP":      "Desktop",
-		"TABLET":       "Tablet",
-		"TV":           "TV",
-		"GAME_CONSOLE": "Game Console",
-		"UNKNOWN":      "Unknown",
-	}
-	if name, ok := devices[device]; ok {
-		return name
-	}
-	return device
-}
@@ -13,18 +13,16 @@
 //  1. Start a local server for OAuth callback
 //  2. Open a browser for authentication
 //  3. Connect to the live chat of your active broadcast
-//  4. Respond to !hello and !time commands
-//  5. Log all chat events
+//  4. Respond to !hello, !time, and !help commands, with per-user cooldowns
+//  5. Log all chat events as structured logs with log/slog
 package main
 
 import (
 	"context"
 	"fmt"
-	"log"
-	"net/http"
+	"log/slog"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
@@ -34,192 +32,136 @@ import (
 )
 
 func main() {
-	// Load credentials from environment
-	clientID := os.Getenv("YOUTUBE_CLIENT_ID")
-	clientSecret := os.Getenv("YOUTUBE_CLIENT_SECRET")
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	fatal := func(msg string, args ...any) {
+		logger.Error(msg, args...)
+		os.Exit(1)
+	}
 
-	if clientID == "" || clientSecret == "" {
-		log.Fatal("Set YOUTUBE_CLIENT_ID and YOUTUBE_CLIENT_SECRET environment variables")
+	// Load credentials from environment
+	config, err := auth.ConfigFromEnv("YOUTUBE")
+	if err != nil {
+		fatal("set YOUTUBE_CLIENT_ID and YOUTUBE_CLIENT_SECRET", "error", err)
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	// Create auth client
-	authClient := auth.NewAuthClient(auth.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		RedirectURL:  "http://localhost:8080/callback",
-		Scopes: []string{
-			auth.ScopeLiveChat,
-			auth.ScopeLiveChatModerate,
-		},
-	},
+	config.RedirectURL = "http://localhost:8080/callback"
+	config.Scopes = []string{
+		auth.ScopeLiveChat,
+		auth.ScopeLiveChatModerate,
+	}
+	authClient := auth.NewAuthClient(config,
 		auth.WithOnTokenRefresh(func(token *auth.Token) {
-			log.Println("Token refreshed automatically")
+			logger.Info("token refreshed")
 		}),
 	)
 
 	// Create core client
 	client := core.NewClient()
 
-	// Channel to signal when auth is complete
-	authDone := make(chan struct{})
-
-	// Start OAuth server
-	http.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
-		state := fmt.Sprintf("%d", time.Now().UnixNano())
-		url := authClient.AuthorizationURL(state, auth.WithPrompt("consent"))
-		http.Redirect(w, r, url, http.StatusFound)
-	})
+	// Authenticate: serves the OAuth callback on localhost:8080, opens the
+	// browser, and waits for the user to approve access
+	logger.Info("waiting for authentication in your browser")
+	token, err := auth.RunLocalCallback(ctx, authClient,
+		auth.WithCallbackAuthURLOptions(auth.WithPrompt("consent")),
+	)
+	if err != nil {
+		fatal("authentication failed", "error", err)
+	}
 
-	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
-		code := r.URL.Query().Get("code")
-		if code == "" {
-			http.Error(w, "No code provided", http.StatusBadRequest)
-			return
-		}
-
-		token, err := authClient.Exchange(r.Context(), code)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		// Update core client with access token
-		client.SetAccessToken(token.AccessToken)
-
-		// Start auto-refresh
-		if err := authClient.StartAutoRefresh(ctx); err != nil {
-			log.Printf("Warning: Could not start auto-refresh: %v", err)
-		}
-
-		_, _ = fmt.Fprintf(w, "Authentication successful! You can close this window.")
-		close(authDone)
-	})
+	// Update core client with access token
+	client.SetAccessToken(token.AccessToken)
 
-	// Start HTTP server
-	server := &http.Server{Addr: ":8080"}
-	go func() {
-		log.Println("Starting OAuth server on http://localhost:8080")
-		log.Println("Visit http://localhost:8080/login to authenticate")
-		if err := server.ListenAndServe(); err != http.ErrServerClosed {
-			log.Printf("HTTP server error: %v", err)
-		}
-	}()
-
-	// Wait for authentication
-	log.Println("Waiting for authentication...")
-	<-authDone
-
-	// Give a moment for the response to be sent
-	time.Sleep(500 * time.Millisecond)
-
-	// Shutdown OAuth server
-	if err := server.Shutdown(ctx); err != nil {
-		log.Printf("Error shutting down server: %v", err)
+	// Start auto-refresh
+	if err := authClient.StartAutoRefresh(ctx); err != nil {
+		logger.Warn("could not start token auto-refresh", "error", err)
 	}
 
 	// Find active broadcast
-	log.Println("Looking for active broadcast...")
+	logger.Info("looking for active broadcast")
 	broadcast, err := streaming.GetMyActiveBroadcast(ctx, client)
 	if err != nil {
-		log.Fatalf("Failed to get active broadcast: %v", err)
+		fatal("failed to get active broadcast", "error", err)
 	}
 	if broadcast == nil {
-		log.Fatal("No active broadcast found. Start a live stream first!")
+		fatal("no active broadcast found; start a live stream first")
 	}
 
 	liveChatID := broadcast.Snippet.LiveChatID
 	if liveChatID == "" {
-		log.Fatal("Broadcast has no live chat ID")
+		fatal("broadcast has no live chat ID")
 	}
 
-	log.Printf("Found broadcast: %s", broadcast.Snippet.Title)
-	log.Printf("Live Chat ID: %s", liveChatID)
+	logger.Info("found broadcast", "title", broadcast.Snippet.Title, "live_chat_id", liveChatID)
 
-	// Create chat bot
+	// Create chat bot. SetLogger logs every chat event (info), ban (warn),
+	// and failure (error) with the event type and author as attributes.
 	bot, err := streaming.NewChatBotClient(client, authClient, liveChatID)
 	if err != nil {
-		log.Fatalf("Failed to create chat bot: %v", err)
+		fatal("failed to create chat bot", "error", err)
 	}
+	bot.SetLogger(logger)
 
-	// Register event handlers
-	bot.OnMessage(func(msg *streaming.ChatMessage) {
-		log.Printf("[%s] %s: %s",
-			msg.PublishedAt.Format("15:04:05"),
-			msg.Author.DisplayName,
-			msg.Message,
-		)
-
-		// Handle commands
-		handleCommand(ctx, bot, msg)
-	})
+	// Route commands
+	router := newCommandRouter(bot)
+	router.Attach(ctx, bot)
 
 	bot.OnSuperChat(func(event *streaming.SuperChatEvent) {
-		log.Printf("SUPER CHAT from %s: $%.2f %s - %s",
-			event.Author.DisplayName,
-			float64(event.AmountMicros)/1000000,
-			event.Currency,
-			event.Message,
+		logger.Info("super chat received",
+			"author", event.Author.DisplayName,
+			"amount", event.Value().Decimal(),
+			"currency", event.Currency,
 		)
 	})
 
 	bot.OnMembership(func(event *streaming.MembershipEvent) {
-		log.Printf("NEW MEMBER: %s joined at %s level!",
-			event.Author.DisplayName,
-			event.LevelName,
-		)
-	})
-
-	bot.OnConnect(func() {
-		log.Println("Connected to live chat!")
-	})
-
-	bot.OnDisconnect(func() {
-		log.Println("Disconnected from live chat")
-	})
-
-	bot.OnError(func(err error) {
-		log.Printf("Error: %v", err)
+		logger.Info("new member", "author", event.Author.DisplayName, "level", event.LevelName)
 	})
 
 	// Connect to live chat
-	log.Println("Connecting to live chat...")
+	logger.Info("connecting to live chat")
 	if err := bot.Connect(ctx); err != nil {
-		log.Fatalf("Failed to connect: %v", err)
+		fatal("failed to connect", "error", err)
 	}
 	defer func() { _ = bot.Close() }()
 
-	log.Println("Bot is running! Press Ctrl+C to stop.")
+	logger.Info("bot is running; press Ctrl+C to stop")
 
 	// Wait for shutdown signal
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 	<-sigCh
 
-	log.Println("Shutting down...")
+	logger.Info("shutting down")
 	cancel()
 }
 
-func handleCommand(ctx context.Context, bot *streaming.ChatBotClient, msg *streaming.ChatMessage) {
-	text := strings.TrimSpace(msg.Message)
+func newCommandRouter(bot *streaming.ChatBotClient) *streaming.CommandRouter {
+	router := streaming.NewCommandRouter()
+	say := func(ctx context.Context, message string) {
+		_ = bot.Say(ctx, message) // Failures are logged by the bot's logger
+	}
+	cooldown := streaming.WithCooldown(10 * time.Second)
 
-	switch text {
-	case "!hello":
-		if err := bot.Say(ctx, fmt.Sprintf("Hello, %s!", msg.Author.DisplayName)); err != nil {
-			log.Printf("Failed to send message: %v", err)
-		}
+	router.Handle("hello", func(ctx context.Context, msg *streaming.ChatMessage, args []string) {
+		say(ctx, fmt.Sprintf("Hello, %s!", msg.Author.DisplayName))
+	}, cooldown, streaming.WithCooldownNotice(
+		func(ctx context.Context, msg *streaming.ChatMessage, name string, remaining time.Duration) {
+			say(ctx, fmt.Sprintf("@%s !%s is on cooldown (%ds)",
+				msg.Author.DisplayName, name, int(remaining.Seconds()+0.5)))
+		}))
 
-	case "!time":
+	router.Handle("time", func(ctx context.Context, msg *streaming.ChatMessage, args []string) {
 		now := time.Now().Format("3:04 PM MST")
-		if err := bot.Say(ctx, fmt.Sprintf("The current time is %s", now)); err != nil {
-			log.Printf("Failed to send message: %v", err)
-		}
-
-	case "!help":
-		if err := bot.Say(ctx, "Available commands: !hello, !time, !help"); err != nil {
-			log.Printf("Failed to send message: %v", err)
-		}
-	}
+		say(ctx, fmt.Sprintf("The current time is %s", now))
+	}, cooldown)
+
+	router.Handle("help", func(ctx context.Context, msg *streaming.ChatMessage, args []string) {
+		say(ctx, "Available commands: !hello, !time, !help")
+	}, cooldown)
+
+	return router
 }
@@ -22,10 +22,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"os/signal"
-	"regexp"
 	"strings"
 	"sync"
 	"syscall"
@@ -88,11 +86,9 @@ func (t *MessageTracker) AddMessage(channelID string) int {
 
 func main() {
 	// Load credentials
-	clientID := os.Getenv("YOUTUBE_CLIENT_ID")
-	clientSecret := os.Getenv("YOUTUBE_CLIENT_SECRET")
-
-	if clientID == "" || clientSecret == "" {
-		log.Fatal("Set YOUTUBE_CLIENT_ID and YOUTUBE_CLIENT_SECRET environment variables")
+	authConfig, err := auth.ConfigFromEnv("YOUTUBE")
+	if err != nil {
+		log.Fatalf("%v (set YOUTUBE_CLIENT_ID and YOUTUBE_CLIENT_SECRET)", err)
 	}
 
 	// Moderation configuration
@@ -111,52 +107,26 @@ func main() {
 	defer cancel()
 
 	// Create clients
-	authClient := auth.NewAuthClient(auth.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		RedirectURL:  "http://localhost:8080/callback",
-		Scopes: []string{
-			auth.ScopeLiveChat,
-			auth.ScopeLiveChatModerate,
-		},
-	})
+	authConfig.RedirectURL = "http://localhost:8080/callback"
+	authConfig.Scopes = []string{
+		auth.ScopeLiveChat,
+		auth.ScopeLiveChatModerate,
+	}
+	authClient := auth.NewAuthClient(authConfig)
 
 	client := core.NewClient()
 	tracker := NewMessageTracker()
 
-	// Auth flow (simplified for example)
-	authDone := make(chan struct{})
-
-	http.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
-		state := fmt.Sprintf("%d", time.Now().UnixNano())
-		url := authClient.AuthorizationURL(state, auth.WithPrompt("consent"))
-		http.Redirect(w, r, url, http.StatusFound)
-	})
-
-	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
-		code := r.URL.Query().Get("code")
-		token, err := authClient.Exchange(r.Context(), code)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		client.SetAccessToken(token.AccessToken)
-		_ = authClient.StartAutoRefresh(ctx)
-
-		_, _ = fmt.Fprintf(w, "Authentication successful!")
-		close(authDone)
-	})
-
-	server := &http.Server{Addr: ":8080"}
-	go func() {
-		log.Println("Visit http://localhost:8080/login to authenticate")
-		_ = server.ListenAndServe()
-	}()
+	// Auth flow: opens the browser and waits for the OAuth callback
+	token, err := auth.RunLocalCallback(ctx, authClient,
+		auth.WithCallbackAuthURLOptions(auth.WithPrompt("consent")),
+	)
+	if err != nil {
+		log.Fatalf("Authentication failed: %v", err)
+	}
 
-	<-authDone
-	time.Sleep(500 * time.Millisecond)
-	_ = server.Shutdown(ctx)
+	client.SetAccessToken(token.AccessToken)
+	_ = authClient.StartAutoRefresh(ctx)
 
 	// Find broadcast
 	log.Println("Looking for active broadcast...")
@@ -243,7 +213,7 @@ func main() {
 	}
 	defer func() { _ = bot.Close() }()
 
-	log.Println("Moderation bot running! Commands: !ban <user>, !timeout <user> [seconds], !unban <banID>")
+	log.Println("Moderation bot running! Commands: !ban <user>, !timeout <user> [duration], !unban <banID>")
 
 	// Wait for shutdown
 	sigCh := make(chan os.Signal, 1)
@@ -281,48 +251,48 @@ func containsBadWord(text string, badWords []string) bool {
 	return false
 }
 
-var cmdRegex = regexp.MustCompile(`^!(\w+)\s*(.*)$`)
-
 func handleModCommand(ctx context.Context, bot *streaming.ChatBotClient, msg *streaming.ChatMessage) {
-	matches := cmdRegex.FindStringSubmatch(msg.Message)
-	if matches == nil {
+	cmd, ok := streaming.ParseCommand(msg, streaming.DefaultCommandPrefix)
+	if !ok {
 		return
 	}
 
-	cmd := strings.ToLower(matches[1])
-	args := strings.TrimSpace(matches[2])
-
-	switch cmd {
+	switch cmd.Name {
 	case "ban":
-		if args == "" {
+		user, err := cmd.ArgString(0)
+		if err != nil {
+			sayUsage(ctx, bot, "!ban <user>")
 			return
 		}
 		// Note: In real use, you'd need to resolve the username to channel ID
-		log.Printf("MOD ACTION: %s requested ban for %s", msg.Author.DisplayName, args)
+		log.Printf("MOD ACTION: %s requested ban for %s", msg.Author.DisplayName, user)
 		// bot.Ban(ctx, channelID) would be called with resolved channel ID
 
 	case "timeout":
-		if args == "" {
-			return
+		user, err := cmd.ArgString(0)
+		duration := 5 * time.Minute // default
+		if err == nil && cmd.NArg() > 1 {
+			duration, err = cmd.ArgDuration(1)
 		}
-		parts := strings.Fields(args)
-		duration := 300 // default 5 minutes
-		if len(parts) > 1 {
-			_, _ = fmt.Sscanf(parts[1], "%d", &duration)
+		if err != nil {
+			log.Printf("Bad !timeout from %s: %v", msg.Author.DisplayName, err)
+			sayUsage(ctx, bot, "!timeout <user> [duration, e.g. 300 or 5m]")
+			return
 		}
-		log.Printf("MOD ACTION: %s requested %ds timeout for %s",
-			msg.Author.DisplayName, duration, parts[0])
-		// bot.Timeout(ctx, channelID, duration)
+		log.Printf("MOD ACTION: %s requested %v timeout for %s",
+			msg.Author.DisplayName, duration, user)
+		// bot.Timeout(ctx, channelID, int(duration.Seconds()))
 
 	case "unban":
-		if args == "" {
+		banID, err := cmd.ArgString(0)
+		if err != nil {
+			sayUsage(ctx, bot, "!unban <banID>")
 			return
 		}
-		// args should be the ban ID
-		if err := bot.Unban(ctx, args); err != nil {
+		if err := bot.Unban(ctx, banID); err != nil {
 			log.Printf("Failed to unban: %v", err)
 		} else {
-			log.Printf("MOD ACTION: %s unbanned %s", msg.Author.DisplayName, args)
+			log.Printf("MOD ACTION: %s unbanned %s", msg.Author.DisplayName, banID)
 		}
 
 	case "stats":
@@ -331,3 +301,9 @@ func handleModCommand(ctx context.Context, bot *streaming.ChatBotClient, msg *st
 		}
 	}
 }
+
+func sayUsage(ctx context.Context, bot *streaming.ChatBotClient, usage string) {
+	if err := bot.Say(ctx, "Usage: "+usage); err != nil {
+		log.Printf("Failed to send usage: %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package analytics
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Column types used in ColumnHeader.ColumnType.
+const (
+	ColumnTypeDimension = "DIMENSION"
+	ColumnTypeMetric    = "METRIC"
+)
+
+// AggFunc combines the values of one metric across the rows of a group.
+// Use AggSum, AggAvg, or AggWeightedAvg, or supply a custom function.
+type AggFunc func(rows []ReportRow, metric string) float64
+
+// AggSum sums a metric across rows. Use it for additive metrics such as
+// views, estimatedMinutesWatched, or likes.
+func AggSum(rows []ReportRow, metric string) float64 {
+	var total float64
+	for i := range rows {
+		total += rows[i].GetFloat(metric)
+	}
+	return total
+}
+
+// AggAvg averages a metric across rows, giving each row equal weight. For
+// average-type metrics such as averageViewDuration, use AggWeightedAvg.
+func AggAvg(rows []ReportRow, metric string) float64 {
+	if len(rows) == 0 {
+		return 0
+	}
+	return AggSum(rows, metric) / float64(len(rows))
+}
+
+// AggWeightedAvg averages a metric weighted by another metric. Use it for
+// ratio metrics, e.g. averageViewDuration weighted by views, so that rows
+// with more traffic count for more. Returns 0 if the weights sum to zero.
+func AggWeightedAvg(weightMetric string) AggFunc {
+	return func(rows []ReportRow, metric string) float64 {
+		var sum, weights float64
+		for i := range rows {
+			w := rows[i].GetFloat(weightMetric)
+			sum += rows[i].GetFloat(metric) * w
+			weights += w
+		}
+		if weights == 0 {
+			return 0
+		}
+		return sum / weights
+	}
+}
+
+// Sum returns the sum of a metric across all rows. The bool is false, and
+// the sum 0, if the report has no such metric column.
+func (r *Report) Sum(metric string) (float64, bool) {
+	values, ok := r.metricValues(metric)
+	if !ok {
+		return 0, false
+	}
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total, true
+}
+
+// Average returns the mean of a metric across all rows, giving each row
+// equal weight. The bool is false if the report has no such metric column
+// or no rows. To combine average-type metrics such as averageViewDuration
+// across rows, use WeightedAverage.
+func (r *Report) Average(metric string) (float64, bool) {
+	values, ok := r.metricValues(metric)
+	if !ok || len(values) == 0 {
+		return 0, false
+	}
+	total, _ := r.Sum(metric)
+	return total / float64(len(values)), true
+}
+
+// Max returns the largest value of a metric across all rows. The bool is
+// false if the report has no such metric column or no rows.
+func (r *Report) Max(metric string) (float64, bool) {
+	values, ok := r.metricValues(metric)
+	if !ok || len(values) == 0 {
+		return 0, false
+	}
+	return slices.Max(values), true
+}
+
+// Min returns the smallest value of a metric across all rows. The bool is
+// false if the report has no such metric column or no rows.
+func (r *Report) Min(metric string) (float64, bool) {
+	values, ok := r.metricValues(metric)
+	if !ok || len(values) == 0 {
+		return 0, false
+	}
+	return slices.Min(values), true
+}
+
+// WeightedAverage returns the average of metric across all rows, with each
+// row weighted by weightMetric. Use it to roll up average-type metrics,
+// which are neither additive nor correctly combined by Average: a day with
+// 1,000 views should count for more than a day with 10.
+//
+// Use views as the weight for averageViewDuration and
+// averageViewPercentage, adImpressions for cpm, and monetizedPlaybacks for
+// playbackBasedCpm:
+//
+//	avgDuration, ok := report.WeightedAverage(analytics.MetricAverageViewDuration, analytics.MetricViews)
+//
+// The bool is false if the report lacks either metric column or the
+// weights sum to zero (e.g., no rows).
+func (r *Report) WeightedAverage(metric, weightMetric string) (float64, bool) {
+	values, ok := r.metricValues(metric)
+	if !ok {
+		return 0, false
+	}
+	weights, ok := r.metricValues(weightMetric)
+	if !ok {
+		return 0, false
+	}
+
+	var sum, total float64
+	for i, v := range values {
+		sum += v * weights[i]
+		total += weights[i]
+	}
+	if total == 0 {
+		return 0, false
+	}
+	return sum / total, true
+}
+
+// metricValues returns a metric's value in each row, with non-numeric cells
+// counted as 0. The bool is false if the report has no such metric column.
+func (r *Report) metricValues(metric string) ([]float64, bool) {
+	if r == nil {
+		return nil, false
+	}
+	idx := r.metricIndex(metric)
+	if idx < 0 || r.ColumnHeaders[idx].ColumnType == ColumnTypeDimension {
+		return nil, false
+	}
+
+	values := make([]float64, 0, len(r.RawRows))
+	for _, row := range r.RawRows {
+		var v float64
+		if idx < len(row) {
+			v, _ = row[idx].(float64)
+		}
+		values = append(values, v)
+	}
+	return values, true
+}
+
+// GroupBy collapses the report to a single dimension, combining the metrics
+// of rows that share the same dimension value. For example, a report by day
+// and country grouped by country yields one row per country.
+//
+// agg chooses the aggregation per metric; metrics without an entry are
+// summed. Metrics with an entry are reported with DataType FLOAT. Rows
+// appear in the order each dimension value is first seen. The receiver is
+// not modified.
+//
+// Returns an error if dimension is not a dimension column of the report or
+// agg names a metric that is not in the report.
+func (r *Report) GroupBy(dimension string, agg map[string]AggFunc) (*Report, error) {
+	if r == nil {
+		return nil, fmt.Errorf("report cannot be nil")
+	}
+
+	dimIdx := r.metricIndex(dimension)
+	if dimIdx < 0 || r.ColumnHeaders[dimIdx].ColumnType != ColumnTypeDimension {
+		return nil, fmt.Errorf("unknown dimension %q", dimension)
+	}
+
+	var metrics []ColumnHeader
+	for _, h := range r.ColumnHeaders {
+		if h.ColumnType != ColumnTypeMetric {
+			continue
+		}
+		// Averages of integer metrics are fractional.
+		if agg[h.Name] != nil {
+			h.DataType = "FLOAT"
+		}
+		metrics = append(metrics, h)
+	}
+	for name := range agg {
+		if idx := r.metricIndex(name); idx < 0 || r.ColumnHeaders[idx].ColumnType != ColumnTypeMetric {
+			return nil, fmt.Errorf("unknown metric %q", name)
+		}
+	}
+
+	// Group rows by dimension value, preserving first-seen order.
+	var keys []any
+	groups := make(map[any][]ReportRow)
+	for _, row := range r.Rows() {
+		key := row.Values[dimension]
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], row)
+	}
+
+	out := &Report{
+		Kind:          r.Kind,
+		ColumnHeaders: append([]ColumnHeader{r.ColumnHeaders[dimIdx]}, metrics...),
+	}
+	for _, key := range keys {
+		row := make([]any, 0, len(out.ColumnHeaders))
+		row = append(row, key)
+		for _, m := range metrics {
+			fn := agg[m.Name]
+			if fn == nil {
+				fn = AggSum
+			}
+			row = append(row, fn(groups[key], m.Name))
+		}
+		out.RawRows = append(out.RawRows, row)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,238 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newDayCountryReport() *Report {
+	return &Report{
+		Kind: "youtubeAnalytics#resultTable",
+		ColumnHeaders: []ColumnHeader{
+			{Name: DimensionDay, ColumnType: ColumnTypeDimension, DataType: "STRING"},
+			{Name: DimensionCountry, ColumnType: ColumnTypeDimension, DataType: "STRING"},
+			{Name: MetricViews, ColumnType: ColumnTypeMetric, DataType: "INTEGER"},
+			{Name: MetricAverageViewDuration, ColumnType: ColumnTypeMetric, DataType: "INTEGER"},
+		},
+		RawRows: [][]any{
+			{"2024-01-01", "US", float64(100), float64(60)},
+			{"2024-01-01", "GB", float64(50), float64(30)},
+			{"2024-01-02", "US", float64(300), float64(100)},
+			{"2024-01-02", "GB", float64(50), float64(90)},
+		},
+	}
+}
+
+func TestReport_GroupBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		dim      string
+		agg      map[string]AggFunc
+		wantRows [][]any
+	}{
+		{
+			name: "sum by default",
+			dim:  DimensionCountry,
+			wantRows: [][]any{
+				{"US", float64(400), float64(160)},
+				{"GB", float64(100), float64(120)},
+			},
+		},
+		{
+			name: "average",
+			dim:  DimensionCountry,
+			agg:  map[string]AggFunc{MetricAverageViewDuration: AggAvg},
+			wantRows: [][]any{
+				{"US", float64(400), float64(80)},
+				{"GB", float64(100), float64(60)},
+			},
+		},
+		{
+			name: "weighted average",
+			dim:  DimensionCountry,
+			agg:  map[string]AggFunc{MetricAverageViewDuration: AggWeightedAvg(MetricViews)},
+			wantRows: [][]any{
+				{"US", float64(400), float64(90)}, // (100*60 + 300*100) / 400
+				{"GB", float64(100), float64(60)}, // (50*30 + 50*90) / 100
+			},
+		},
+		{
+			name: "by day",
+			dim:  DimensionDay,
+			wantRows: [][]any{
+				{"2024-01-01", float64(150), float64(90)},
+				{"2024-01-02", float64(350), float64(190)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := newDayCountryReport()
+			got, err := report.GroupBy(tt.dim, tt.agg)
+			if err != nil {
+				t.Fatalf("GroupBy() error = %v", err)
+			}
+
+			if len(got.ColumnHeaders) != 3 || got.ColumnHeaders[0].Name != tt.dim {
+				t.Errorf("ColumnHeaders = %+v, want %s followed by metrics", got.ColumnHeaders, tt.dim)
+			}
+			if !reflect.DeepEqual(got.RawRows, tt.wantRows) {
+				t.Errorf("RawRows = %v, want %v", got.RawRows, tt.wantRows)
+			}
+			if len(report.RawRows) != 4 || len(report.ColumnHeaders) != 4 {
+				t.Error("GroupBy modified the original report")
+			}
+		})
+	}
+
+	t.Run("averaged metrics are floats", func(t *testing.T) {
+		got, err := newDayCountryReport().GroupBy(DimensionCountry, map[string]AggFunc{MetricAverageViewDuration: AggAvg})
+		if err != nil {
+			t.Fatalf("GroupBy() error = %v", err)
+		}
+		if got.ColumnHeaders[1].DataType != "INTEGER" {
+			t.Errorf("summed DataType = %q, want INTEGER", got.ColumnHeaders[1].DataType)
+		}
+		if got.ColumnHeaders[2].DataType != "FLOAT" {
+			t.Errorf("averaged DataType = %q, want FLOAT", got.ColumnHeaders[2].DataType)
+		}
+		if got.TotalViews() != 500 {
+			t.Errorf("TotalViews() = %d, want 500", got.TotalViews())
+		}
+	})
+
+	errTests := []struct {
+		name string
+		dim  string
+		agg  map[string]AggFunc
+	}{
+		{"unknown dimension", DimensionDeviceType, nil},
+		{"metric as dimension", MetricViews, nil},
+		{"unknown metric", DimensionCountry, map[string]AggFunc{MetricLikes: AggSum}},
+		{"dimension as metric", DimensionCountry, map[string]AggFunc{DimensionDay: AggSum}},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newDayCountryReport().GroupBy(tt.dim, tt.agg); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	t.Run("nil report", func(t *testing.T) {
+		var r *Report
+		if _, err := r.GroupBy(DimensionCountry, nil); err == nil {
+			t.Error("expected error for nil report")
+		}
+	})
+}
+
+func TestAggFuncs_Empty(t *testing.T) {
+	if got := AggAvg(nil, MetricViews); got != 0 {
+		t.Errorf("AggAvg(nil) = %v, want 0", got)
+	}
+	if got := AggWeightedAvg(MetricViews)(nil, MetricAverageViewDuration); got != 0 {
+		t.Errorf("AggWeightedAvg(nil) = %v, want 0", got)
+	}
+}
+
+func TestReport_MetricAggregates(t *testing.T) {
+	report := newDayCountryReport()
+
+	tests := []struct {
+		name   string
+		fn     func(string) (float64, bool)
+		metric string
+		want   float64
+		wantOK bool
+	}{
+		{"sum", report.Sum, MetricViews, 500, true},
+		{"average", report.Average, MetricAverageViewDuration, 70, true},
+		{"max", report.Max, MetricViews, 300, true},
+		{"min", report.Min, MetricAverageViewDuration, 30, true},
+		{"sum absent metric", report.Sum, MetricLikes, 0, false},
+		{"average absent metric", report.Average, MetricLikes, 0, false},
+		{"max absent metric", report.Max, MetricLikes, 0, false},
+		{"min absent metric", report.Min, MetricLikes, 0, false},
+		{"dimension is not a metric", report.Sum, DimensionCountry, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.fn(tt.metric)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("got (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestReport_MetricAggregates_Empty(t *testing.T) {
+	report := newDayCountryReport()
+	report.RawRows = nil
+
+	if got, ok := report.Sum(MetricViews); got != 0 || !ok {
+		t.Errorf("Sum() = (%v, %v), want (0, true)", got, ok)
+	}
+	if _, ok := report.Average(MetricViews); ok {
+		t.Error("Average() ok = true for empty report, want false")
+	}
+	if _, ok := report.Max(MetricViews); ok {
+		t.Error("Max() ok = true for empty report, want false")
+	}
+	if _, ok := report.Min(MetricViews); ok {
+		t.Error("Min() ok = true for empty report, want false")
+	}
+
+	var nilReport *Report
+	if _, ok := nilReport.Sum(MetricViews); ok {
+		t.Error("Sum() ok = true for nil report, want false")
+	}
+}
+
+func TestReport_WeightedAverage(t *testing.T) {
+	report := newDayCountryReport()
+
+	tests := []struct {
+		name         string
+		metric       string
+		weightMetric string
+		want         float64
+		wantOK       bool
+	}{
+		// (100*60 + 50*30 + 300*100 + 50*90) / 500
+		{"weighted by views", MetricAverageViewDuration, MetricViews, 84, true},
+		{"self weighted", MetricViews, MetricViews, 210, true},
+		{"absent metric", MetricAverageViewPercentage, MetricViews, 0, false},
+		{"absent weight", MetricAverageViewDuration, MetricAdImpressions, 0, false},
+		{"dimension weight", MetricAverageViewDuration, DimensionCountry, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := report.WeightedAverage(tt.metric, tt.weightMetric)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("WeightedAverage() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	// Naive averaging gives 70, overweighting the low-traffic rows.
+	if naive, _ := report.Average(MetricAverageViewDuration); naive == 84 {
+		t.Error("Average() unexpectedly matches WeightedAverage()")
+	}
+
+	t.Run("zero weights", func(t *testing.T) {
+		empty := newDayCountryReport()
+		empty.RawRows = nil
+		if _, ok := empty.WeightedAverage(MetricAverageViewDuration, MetricViews); ok {
+			t.Error("ok = true for empty report, want false")
+		}
+
+		var nilReport *Report
+		if _, ok := nilReport.WeightedAverage(MetricAverageViewDuration, MetricViews); ok {
+			t.Error("ok = true for nil report, want false")
+		}
+	})
+}
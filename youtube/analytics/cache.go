@@ -0,0 +1,93 @@
+package analytics
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// cacheKeyPrefix namespaces analytics entries in a shared core.Cache.
+const cacheKeyPrefix = "analytics:"
+
+// WithCache caches successful Query results in cache for ttl, keyed by a
+// hash of the query parameters (including the content owner). Cached
+// results cost no quota. A ttl of zero or less uses the cache's default TTL.
+//
+// Analytics data updates slowly, so a dashboard refreshing the same panels
+// every minute can serve most of them from the cache:
+//
+//	cache := core.NewCache(core.WithMaxItems(500))
+//	client := analytics.NewClient(
+//		analytics.WithTokenProvider(tokenFn),
+//		analytics.WithCache(cache, time.Hour),
+//	)
+//
+// Queries whose end date is today or later are not cached, since that day's
+// data is still changing; see WithCacheToday. "channel==MINE" resolves to
+// the token's channel, so share a cache only between clients authorized for
+// the same account.
+func WithCache(cache *core.Cache, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+// WithCacheToday sets whether queries whose end date is today or later are
+// cached. Default is false, so still-changing data is always fetched.
+// Has no effect without WithCache.
+func WithCacheToday(enabled bool) ClientOption {
+	return func(c *Client) { c.cacheToday = enabled }
+}
+
+// cacheKey returns the cache key for an encoded query, or "" if the query
+// should not be cached.
+func (c *Client) cacheKey(query url.Values) string {
+	if c.cache == nil {
+		return ""
+	}
+	if !c.cacheToday && query.Get("endDate") >= c.earliestToday() {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(c.analyticsURL + "?" + query.Encode()))
+	return cacheKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// earliestToday returns the earliest date that is currently "today" in any
+// time zone, so a report ending on a day that has not finished everywhere
+// is treated as still changing.
+func (c *Client) earliestToday() string {
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	return now().UTC().Add(-12 * time.Hour).Format(dayLayout)
+}
+
+// cachedBody returns the cached response body for key, if any.
+func (c *Client) cachedBody(key string) ([]byte, bool) {
+	if key == "" {
+		return nil, false
+	}
+	v, ok := c.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	body, ok := v.([]byte)
+	return body, ok
+}
+
+// storeBody caches a successful response body under key.
+func (c *Client) storeBody(key string, body []byte) {
+	if key == "" {
+		return
+	}
+	if c.cacheTTL > 0 {
+		c.cache.SetWithTTL(key, body, c.cacheTTL)
+	} else {
+		c.cache.Set(key, body)
+	}
+}
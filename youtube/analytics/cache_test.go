@@ -0,0 +1,165 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// newCountingServer serves a one-row report and counts requests.
+func newCountingServer(t *testing.T, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(`{"error":{"code":500,"message":"backend error"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"columnHeaders": []map[string]string{
+				{"name": "views", "columnType": "METRIC", "dataType": "INTEGER"},
+			},
+			"rows": [][]any{{100}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestClient_Query_Cache(t *testing.T) {
+	now := time.Date(2025, 3, 15, 18, 0, 0, 0, time.UTC)
+	pastParams := &QueryParams{IDs: "channel==MINE", StartDate: "2025-03-01", EndDate: "2025-03-10", Metrics: "views"}
+	todayParams := &QueryParams{IDs: "channel==MINE", StartDate: "2025-03-01", EndDate: "2025-03-15", Metrics: "views"}
+
+	tests := []struct {
+		name      string
+		params    *QueryParams
+		opts      []ClientOption
+		status    int
+		wantCalls int32
+	}{
+		{"past end date is cached", pastParams, nil, http.StatusOK, 1},
+		{"end date today is not cached", todayParams, nil, http.StatusOK, 2},
+		{"end date today cached with WithCacheToday", todayParams, []ClientOption{WithCacheToday(true)}, http.StatusOK, 1},
+		{"errors are not cached", pastParams, nil, http.StatusInternalServerError, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newCountingServer(t, tt.status)
+			tracker := core.NewQuotaTracker(10000)
+			opts := append([]ClientOption{
+				WithAnalyticsURL(server.URL),
+				WithAccessToken("test-token"),
+				WithCache(core.NewCache(), time.Hour),
+				WithQuotaTracker(tracker),
+			}, tt.opts...)
+			client := NewClient(opts...)
+			client.now = func() time.Time { return now }
+
+			for range 2 {
+				report, err := client.Query(context.Background(), tt.params)
+				if tt.status == http.StatusOK {
+					if err != nil {
+						t.Fatalf("Query() error = %v", err)
+					}
+					if report.TotalViews() != 100 {
+						t.Errorf("TotalViews() = %d, want 100", report.TotalViews())
+					}
+				}
+			}
+
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("API calls = %d, want %d", got, tt.wantCalls)
+			}
+			if got := tracker.Used(); got != int(tt.wantCalls) {
+				t.Errorf("quota used = %d, want %d (cache hits are free)", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_Query_CacheKey(t *testing.T) {
+	server, calls := newCountingServer(t, http.StatusOK)
+	client := NewClient(
+		WithAnalyticsURL(server.URL),
+		WithAccessToken("test-token"),
+		WithScopes(ScopePartner),
+		WithCache(core.NewCache(), time.Hour),
+	)
+	ctx := context.Background()
+	base := QueryParams{IDs: "channel==MINE", StartDate: "2025-01-01", EndDate: "2025-01-31", Metrics: "views"}
+
+	variants := []QueryParams{base, base, base, base}
+	variants[1].Dimensions = "day"
+	variants[2].ContentOwner = "owner1"
+	variants[3].EndDate = "2025-01-30"
+
+	for _, params := range variants {
+		if _, err := client.Query(ctx, &params); err != nil {
+			t.Fatalf("Query() error = %v", err)
+		}
+	}
+	if got := calls.Load(); got != int32(len(variants)) {
+		t.Errorf("API calls = %d, want %d (distinct params must not share entries)", got, len(variants))
+	}
+
+	// Repeating every variant is served from the cache.
+	for _, params := range variants {
+		_, _ = client.Query(ctx, &params)
+	}
+	if got := calls.Load(); got != int32(len(variants)) {
+		t.Errorf("API calls after repeat = %d, want %d", got, len(variants))
+	}
+}
+
+func TestClient_Query_CacheTTL(t *testing.T) {
+	server, calls := newCountingServer(t, http.StatusOK)
+	now := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+	cache := core.NewCache(core.WithTimeFunc(func() time.Time { return now }))
+	client := NewClient(
+		WithAnalyticsURL(server.URL),
+		WithAccessToken("test-token"),
+		WithCache(cache, time.Minute),
+	)
+	params := &QueryParams{IDs: "channel==MINE", StartDate: "2025-01-01", EndDate: "2025-01-31", Metrics: "views"}
+	ctx := context.Background()
+
+	_, _ = client.Query(ctx, params)
+	_, _ = client.Query(ctx, params)
+	now = now.Add(2 * time.Minute)
+	_, _ = client.Query(ctx, params)
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("API calls = %d, want 2 (entry expires after TTL)", got)
+	}
+}
+
+func TestClient_Query_CacheReturnsCopies(t *testing.T) {
+	server, _ := newCountingServer(t, http.StatusOK)
+	client := NewClient(
+		WithAnalyticsURL(server.URL),
+		WithAccessToken("test-token"),
+		WithCache(core.NewCache(), time.Hour),
+	)
+	params := &QueryParams{IDs: "channel==MINE", StartDate: "2025-01-01", EndDate: "2025-01-31", Metrics: "views"}
+
+	first, _ := client.Query(context.Background(), params)
+	first.RawRows[0][0] = 0.0
+
+	second, err := client.Query(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if second.TotalViews() != 100 {
+		t.Errorf("TotalViews() = %d, want 100 (mutating a result must not affect the cache)", second.TotalViews())
+	}
+}
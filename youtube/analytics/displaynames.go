@@ -0,0 +1,459 @@
+package analytics
+
+import (
+	"maps"
+	"strings"
+)
+
+// DefaultDisplayLanguage is the language of the built-in display name tables.
+const DefaultDisplayLanguage = "en"
+
+// builtinDisplayNames maps dimension → language → value → display name. It
+// is never modified; WithDimensionDisplayNames adds names per client.
+var builtinDisplayNames = map[string]map[string]map[string]string{
+	DimensionCountry:           {DefaultDisplayLanguage: countryNames},
+	DimensionDeviceType:        {DefaultDisplayLanguage: deviceTypeNames},
+	DimensionOperatingSystem:   {DefaultDisplayLanguage: operatingSystemNames},
+	DimensionTrafficSourceType: {DefaultDisplayLanguage: trafficSourceTypeNames},
+	DimensionAgeGroup:          {DefaultDisplayLanguage: ageGroupNames},
+	DimensionGender:            {DefaultDisplayLanguage: genderNames},
+}
+
+// DimensionDisplayName returns a human-readable name for a dimension value
+// from the built-in English tables, e.g. "United States" for country "US"
+// or "Game console" for deviceType "GAME_CONSOLE".
+//
+// lang is a BCP 47 language tag such as "en" or "pt-BR". Built-in tables
+// cover country, deviceType, operatingSystem, trafficSourceType, ageGroup,
+// and gender in English only. Use Client.DimensionDisplayName for names
+// added with WithDimensionDisplayNames. Unknown values are returned
+// unchanged.
+func DimensionDisplayName(dimension, value, lang string) string {
+	return lookupDisplayName(nil, dimension, value, lang)
+}
+
+// DimensionDisplayName returns a human-readable name for a dimension value,
+// preferring names added with WithDimensionDisplayNames over the built-in
+// tables. Names for the exact language tag are tried first, then its base
+// language, then DefaultDisplayLanguage. Unknown values are returned
+// unchanged.
+func (c *Client) DimensionDisplayName(dimension, value, lang string) string {
+	return lookupDisplayName(c.displayNames, dimension, value, lang)
+}
+
+// WithDimensionDisplayNames adds display names for a dimension in a
+// language, overriding the built-in names for the same values. Names only
+// apply to this client's DimensionDisplayName. Use the option once per
+// dimension and language:
+//
+//	client := analytics.NewClient(
+//		analytics.WithDimensionDisplayNames(analytics.DimensionDeviceType, "de", map[string]string{
+//			"MOBILE":  "Mobilgerät",
+//			"DESKTOP": "Computer",
+//		}),
+//	)
+//
+// An empty lang adds names for DefaultDisplayLanguage. names is copied.
+func WithDimensionDisplayNames(dimension, lang string, names map[string]string) ClientOption {
+	lang = normalizeLanguage(lang)
+	if lang == "" {
+		lang = DefaultDisplayLanguage
+	}
+
+	return func(c *Client) {
+		if c.displayNames == nil {
+			c.displayNames = make(map[string]map[string]map[string]string)
+		}
+		byLang := c.displayNames[dimension]
+		if byLang == nil {
+			byLang = make(map[string]map[string]string)
+			c.displayNames[dimension] = byLang
+		}
+		if byLang[lang] == nil {
+			byLang[lang] = make(map[string]string, len(names))
+		}
+		maps.Copy(byLang[lang], names)
+	}
+}
+
+// TrafficSourceName returns the English name of a trafficSourceType code,
+// e.g. "YouTube search" for "YT_SEARCH" or "Suggested videos" for
+// "RELATED_VIDEO". It is shorthand for DimensionDisplayName with
+// DefaultDisplayLanguage. Unknown codes are returned unchanged.
+func TrafficSourceName(code string) string {
+	return DimensionDisplayName(DimensionTrafficSourceType, code, DefaultDisplayLanguage)
+}
+
+// lookupDisplayName looks value up in custom, then the built-in tables, for
+// each of lang's fallback languages in turn.
+func lookupDisplayName(custom map[string]map[string]map[string]string, dimension, value, lang string) string {
+	for _, l := range languageFallbacks(lang) {
+		if name, ok := custom[dimension][l][value]; ok {
+			return name
+		}
+		if name, ok := builtinDisplayNames[dimension][l][value]; ok {
+			return name
+		}
+	}
+	return value
+}
+
+// languageFallbacks returns the languages to try for lang, most specific
+// first: "pt-BR" yields ["pt-br", "pt", "en"].
+func languageFallbacks(lang string) []string {
+	lang = normalizeLanguage(lang)
+	var langs []string
+	if lang != "" {
+		langs = append(langs, lang)
+		if base, _, ok := strings.Cut(lang, "-"); ok {
+			langs = append(langs, base)
+		}
+	}
+	return append(langs, DefaultDisplayLanguage)
+}
+
+// normalizeLanguage lowercases a language tag and uses "-" as the separator.
+func normalizeLanguage(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+// ageGroupNames are English names for ageGroup values.
+var ageGroupNames = map[string]string{
+	"age13-17": "13–17",
+	"age18-24": "18–24",
+	"age25-34": "25–34",
+	"age35-44": "35–44",
+	"age45-54": "45–54",
+	"age55-64": "55–64",
+	"age65-":   "65+",
+}
+
+// genderNames are English names for gender values.
+var genderNames = map[string]string{
+	"female":         "Female",
+	"male":           "Male",
+	"user_specified": "User-specified",
+}
+
+// deviceTypeNames are English names for deviceType values.
+var deviceTypeNames = map[string]string{
+	"DESKTOP":          "Computer",
+	"GAME_CONSOLE":     "Game console",
+	"MOBILE":           "Mobile phone",
+	"TABLET":           "Tablet",
+	"TV":               "TV",
+	"UNKNOWN_PLATFORM": "Unknown",
+}
+
+// operatingSystemNames are English names for operatingSystem values.
+var operatingSystemNames = map[string]string{
+	"ANDROID":          "Android",
+	"BADA":             "Bada",
+	"BLACKBERRY":       "BlackBerry",
+	"CHROMECAST":       "Chromecast",
+	"DOCOMO":           "Docomo",
+	"FIREFOX":          "Firefox OS",
+	"HIPTOP":           "Hiptop",
+	"IOS":              "iOS",
+	"KAIOS":            "KaiOS",
+	"LINUX":            "Linux",
+	"MACINTOSH":        "macOS",
+	"MEEGO":            "MeeGo",
+	"NINTENDO_3DS":     "Nintendo 3DS",
+	"OTHER":            "Other",
+	"PLAYSTATION":      "PlayStation",
+	"PLAYSTATION_VITA": "PlayStation Vita",
+	"REALMEDIA":        "RealMedia",
+	"SMART_TV":         "Smart TV",
+	"SYMBIAN":          "Symbian",
+	"TIZEN":            "Tizen",
+	"VIDAA":            "VIDAA",
+	"WEBOS":            "webOS",
+	"WII":              "Wii",
+	"WINDOWS":          "Windows",
+	"WINDOWS_MOBILE":   "Windows Mobile",
+	"XBOX":             "Xbox",
+}
+
+// trafficSourceTypeNames are English names for trafficSourceType values,
+// matching the labels used in YouTube Studio where possible.
+var trafficSourceTypeNames = map[string]string{
+	"ADVERTISING":      "YouTube advertising",
+	"ANNOTATION":       "Annotations",
+	"CAMPAIGN_CARD":    "Campaign cards",
+	"END_SCREEN":       "End screens",
+	"EXT_URL":          "External",
+	"HASHTAGS":         "Hashtag pages",
+	"IMMERSIVE_LIVE":   "Immersive live",
+	"LIVE_REDIRECT":    "Live redirects",
+	"NO_LINK_EMBEDDED": "Embedded players",
+	"NO_LINK_OTHER":    "Direct or unknown",
+	"NOTIFICATION":     "Notifications",
+	"PLAYLIST":         "Playlists",
+	"PRODUCT_PAGE":     "Product pages",
+	"PROMOTED":         "Unpaid promotion",
+	"RELATED_VIDEO":    "Suggested videos",
+	"SHORTS":           "Shorts feed",
+	"SOUND_PAGE":       "Sound pages",
+	"SUBSCRIBER":       "Browse features",
+	"VIDEO_REMIXES":    "Video remixes",
+	"YT_CHANNEL":       "Channel pages",
+	"YT_OTHER_PAGE":    "Other YouTube features",
+	"YT_PLAYLIST_PAGE": "Playlist pages",
+	"YT_SEARCH":        "YouTube search",
+}
+
+// countryNames are English names for ISO 3166-1 alpha-2 country codes.
+var countryNames = map[string]string{
+	"AD": "Andorra",
+	"AE": "United Arab Emirates",
+	"AF": "Afghanistan",
+	"AG": "Antigua and Barbuda",
+	"AI": "Anguilla",
+	"AL": "Albania",
+	"AM": "Armenia",
+	"AO": "Angola",
+	"AQ": "Antarctica",
+	"AR": "Argentina",
+	"AS": "American Samoa",
+	"AT": "Austria",
+	"AU": "Australia",
+	"AW": "Aruba",
+	"AX": "Åland Islands",
+	"AZ": "Azerbaijan",
+	"BA": "Bosnia and Herzegovina",
+	"BB": "Barbados",
+	"BD": "Bangladesh",
+	"BE": "Belgium",
+	"BF": "Burkina Faso",
+	"BG": "Bulgaria",
+	"BH": "Bahrain",
+	"BI": "Burundi",
+	"BJ": "Benin",
+	"BL": "Saint Barthélemy",
+	"BM": "Bermuda",
+	"BN": "Brunei",
+	"BO": "Bolivia",
+	"BQ": "Caribbean Netherlands",
+	"BR": "Brazil",
+	"BS": "Bahamas",
+	"BT": "Bhutan",
+	"BV": "Bouvet Island",
+	"BW": "Botswana",
+	"BY": "Belarus",
+	"BZ": "Belize",
+	"CA": "Canada",
+	"CC": "Cocos (Keeling) Islands",
+	"CD": "Congo - Kinshasa",
+	"CF": "Central African Republic",
+	"CG": "Congo - Brazzaville",
+	"CH": "Switzerland",
+	"CI": "Côte d’Ivoire",
+	"CK": "Cook Islands",
+	"CL": "Chile",
+	"CM": "Cameroon",
+	"CN": "China",
+	"CO": "Colombia",
+	"CR": "Costa Rica",
+	"CU": "Cuba",
+	"CV": "Cape Verde",
+	"CW": "Curaçao",
+	"CX": "Christmas Island",
+	"CY": "Cyprus",
+	"CZ": "Czechia",
+	"DE": "Germany",
+	"DJ": "Djibouti",
+	"DK": "Denmark",
+	"DM": "Dominica",
+	"DO": "Dominican Republic",
+	"DZ": "Algeria",
+	"EC": "Ecuador",
+	"EE": "Estonia",
+	"EG": "Egypt",
+	"EH": "Western Sahara",
+	"ER": "Eritrea",
+	"ES": "Spain",
+	"ET": "Ethiopia",
+	"FI": "Finland",
+	"FJ": "Fiji",
+	"FK": "Falkland Islands",
+	"FM": "Micronesia",
+	"FO": "Faroe Islands",
+	"FR": "France",
+	"GA": "Gabon",
+	"GB": "United Kingdom",
+	"GD": "Grenada",
+	"GE": "Georgia",
+	"GF": "French Guiana",
+	"GG": "Guernsey",
+	"GH": "Ghana",
+	"GI": "Gibraltar",
+	"GL": "Greenland",
+	"GM": "Gambia",
+	"GN": "Guinea",
+	"GP": "Guadeloupe",
+	"GQ": "Equatorial Guinea",
+	"GR": "Greece",
+	"GS": "South Georgia and South Sandwich Islands",
+	"GT": "Guatemala",
+	"GU": "Guam",
+	"GW": "Guinea-Bissau",
+	"GY": "Guyana",
+	"HK": "Hong Kong",
+	"HM": "Heard and McDonald Islands",
+	"HN": "Honduras",
+	"HR": "Croatia",
+	"HT": "Haiti",
+	"HU": "Hungary",
+	"ID": "Indonesia",
+	"IE": "Ireland",
+	"IL": "Israel",
+	"IM": "Isle of Man",
+	"IN": "India",
+	"IO": "British Indian Ocean Territory",
+	"IQ": "Iraq",
+	"IR": "Iran",
+	"IS": "Iceland",
+	"IT": "Italy",
+	"JE": "Jersey",
+	"JM": "Jamaica",
+	"JO": "Jordan",
+	"JP": "Japan",
+	"KE": "Kenya",
+	"KG": "Kyrgyzstan",
+	"KH": "Cambodia",
+	"KI": "Kiribati",
+	"KM": "Comoros",
+	"KN": "Saint Kitts and Nevis",
+	"KP": "North Korea",
+	"KR": "South Korea",
+	"KW": "Kuwait",
+	"KY": "Cayman Islands",
+	"KZ": "Kazakhstan",
+	"LA": "Laos",
+	"LB": "Lebanon",
+	"LC": "Saint Lucia",
+	"LI": "Liechtenstein",
+	"LK": "Sri Lanka",
+	"LR": "Liberia",
+	"LS": "Lesotho",
+	"LT": "Lithuania",
+	"LU": "Luxembourg",
+	"LV": "Latvia",
+	"LY": "Libya",
+	"MA": "Morocco",
+	"MC": "Monaco",
+	"MD": "Moldova",
+	"ME": "Montenegro",
+	"MF": "Saint Martin",
+	"MG": "Madagascar",
+	"MH": "Marshall Islands",
+	"MK": "North Macedonia",
+	"ML": "Mali",
+	"MM": "Myanmar (Burma)",
+	"MN": "Mongolia",
+	"MO": "Macao",
+	"MP": "Northern Mariana Islands",
+	"MQ": "Martinique",
+	"MR": "Mauritania",
+	"MS": "Montserrat",
+	"MT": "Malta",
+	"MU": "Mauritius",
+	"MV": "Maldives",
+	"MW": "Malawi",
+	"MX": "Mexico",
+	"MY": "Malaysia",
+	"MZ": "Mozambique",
+	"NA": "Namibia",
+	"NC": "New Caledonia",
+	"NE": "Niger",
+	"NF": "Norfolk Island",
+	"NG": "Nigeria",
+	"NI": "Nicaragua",
+	"NL": "Netherlands",
+	"NO": "Norway",
+	"NP": "Nepal",
+	"NR": "Nauru",
+	"NU": "Niue",
+	"NZ": "New Zealand",
+	"OM": "Oman",
+	"PA": "Panama",
+	"PE": "Peru",
+	"PF": "French Polynesia",
+	"PG": "Papua New Guinea",
+	"PH": "Philippines",
+	"PK": "Pakistan",
+	"PL": "Poland",
+	"PM": "Saint Pierre and Miquelon",
+	"PN": "Pitcairn Islands",
+	"PR": "Puerto Rico",
+	"PS": "Palestinian Territories",
+	"PT": "Portugal",
+	"PW": "Palau",
+	"PY": "Paraguay",
+	"QA": "Qatar",
+	"RE": "Réunion",
+	"RO": "Romania",
+	"RS": "Serbia",
+	"RU": "Russia",
+	"RW": "Rwanda",
+	"SA": "Saudi Arabia",
+	"SB": "Solomon Islands",
+	"SC": "Seychelles",
+	"SD": "Sudan",
+	"SE": "Sweden",
+	"SG": "Singapore",
+	"SH": "Saint Helena",
+	"SI": "Slovenia",
+	"SJ": "Svalbard and Jan Mayen",
+	"SK": "Slovakia",
+	"SL": "Sierra Leone",
+	"SM": "San Marino",
+	"SN": "Senegal",
+	"SO": "Somalia",
+	"SR": "Suriname",
+	"SS": "South Sudan",
+	"ST": "São Tomé and Príncipe",
+	"SV": "El Salvador",
+	"SX": "Sint Maarten",
+	"SY": "Syria",
+	"SZ": "Eswatini",
+	"TC": "Turks and Caicos Islands",
+	"TD": "Chad",
+	"TF": "French Southern Territories",
+	"TG": "Togo",
+	"TH": "Thailand",
+	"TJ": "Tajikistan",
+	"TK": "Tokelau",
+	"TL": "Timor-Leste",
+	"TM": "Turkmenistan",
+	"TN": "Tunisia",
+	"TO": "Tonga",
+	"TR": "Türkiye",
+	"TT": "Trinidad and Tobago",
+	"TV": "Tuvalu",
+	"TW": "Taiwan",
+	"TZ": "Tanzania",
+	"UA": "Ukraine",
+	"UG": "Uganda",
+	"UM": "U.S. Outlying Islands",
+	"US": "United States",
+	"UY": "Uruguay",
+	"UZ": "Uzbekistan",
+	"VA": "Vatican City",
+	"VC": "Saint Vincent and the Grenadines",
+	"VE": "Venezuela",
+	"VG": "British Virgin Islands",
+	"VI": "U.S. Virgin Islands",
+	"VN": "Vietnam",
+	"VU": "Vanuatu",
+	"WF": "Wallis and Futuna",
+	"WS": "Samoa",
+	"XK": "Kosovo",
+	"YE": "Yemen",
+	"YT": "Mayotte",
+	"ZA": "South Africa",
+	"ZM": "Zambia",
+	"ZW": "Zimbabwe",
+	"ZZ": "Unknown region",
+}
@@ -0,0 +1,99 @@
+package analytics
+
+import "testing"
+
+func TestDimensionDisplayName(t *testing.T) {
+	tests := []struct {
+		dimension string
+		value     string
+		lang      string
+		want      string
+	}{
+		{DimensionCountry, "US", "en", "United States"},
+		{DimensionCountry, "GB", "", "United Kingdom"},
+		{DimensionCountry, "JP", "fr", "Japan"}, // falls back to English
+		{DimensionCountry, "QQ", "en", "QQ"},    // unknown code
+		{DimensionDeviceType, "GAME_CONSOLE", "en-US", "Game console"},
+		{DimensionOperatingSystem, "MACINTOSH", "en", "macOS"},
+		{DimensionTrafficSourceType, "RELATED_VIDEO", "en", "Suggested videos"},
+		{DimensionAgeGroup, "age65-", "en", "65+"},
+		{DimensionGender, "user_specified", "en", "User-specified"},
+		{DimensionDay, "2024-01-01", "en", "2024-01-01"}, // no table
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dimension+"/"+tt.value, func(t *testing.T) {
+			if got := DimensionDisplayName(tt.dimension, tt.value, tt.lang); got != tt.want {
+				t.Errorf("DimensionDisplayName(%q, %q, %q) = %q, want %q",
+					tt.dimension, tt.value, tt.lang, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithDimensionDisplayNames(t *testing.T) {
+	names := map[string]string{"US": "USA"}
+	client := NewClient(
+		WithDimensionDisplayNames(DimensionDeviceType, "pt", map[string]string{
+			"MOBILE": "Celular",
+		}),
+		WithDimensionDisplayNames(DimensionDeviceType, "pt_BR", map[string]string{
+			"TABLET": "Tablet (BR)",
+		}),
+		WithDimensionDisplayNames(DimensionCountry, "", names),
+		WithDimensionDisplayNames(DimensionSubscribedStatus, "en", map[string]string{
+			"SUBSCRIBED": "Subscribers",
+		}),
+	)
+	names["US"] = "changed after NewClient"
+
+	tests := []struct {
+		name      string
+		dimension string
+		value     string
+		lang      string
+		want      string
+	}{
+		{"exact tag", DimensionDeviceType, "TABLET", "pt-BR", "Tablet (BR)"},
+		{"base language", DimensionDeviceType, "MOBILE", "pt-BR", "Celular"},
+		{"default language", DimensionDeviceType, "DESKTOP", "pt-BR", "Computer"},
+		{"override built-in", DimensionCountry, "US", "en", "USA"},
+		{"other built-ins kept", DimensionCountry, "CA", "en", "Canada"},
+		{"new dimension", DimensionSubscribedStatus, "SUBSCRIBED", "en", "Subscribers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := client.DimensionDisplayName(tt.dimension, tt.value, tt.lang); got != tt.want {
+				t.Errorf("Client.DimensionDisplayName(%q, %q, %q) = %q, want %q",
+					tt.dimension, tt.value, tt.lang, got, tt.want)
+			}
+		})
+	}
+
+	// Names added to one client do not affect other clients or the
+	// package-level lookup.
+	if got := NewClient().DimensionDisplayName(DimensionCountry, "US", "en"); got != "United States" {
+		t.Errorf("other client DimensionDisplayName() = %q, want United States", got)
+	}
+	if got := DimensionDisplayName(DimensionCountry, "US", "en"); got != "United States" {
+		t.Errorf("DimensionDisplayName() = %q, want United States", got)
+	}
+}
+
+func TestTrafficSourceName(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"YT_SEARCH", "YouTube search"},
+		{"RELATED_VIDEO", "Suggested videos"},
+		{"EXT_URL", "External"},
+		{"NEW_SOURCE", "NEW_SOURCE"},
+	}
+	for _, tt := range tests {
+		if got := TrafficSourceName(tt.code); got != tt.want {
+			t.Errorf("TrafficSourceName(%q) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
@@ -16,6 +16,9 @@
 //		Dimensions: "day",
 //	})
 //
+// WithHTTPClient and WithBaseURL route requests through a custom transport
+// or a mock server; the token options still set the Authorization header.
+//
 // # Convenience Methods
 //
 // Common queries have helper methods:
@@ -26,6 +29,9 @@
 //	// Daily breakdown
 //	report, err := client.QueryDailyViews(ctx, "2025-01-01", "2025-01-31")
 //
+//	// Weekly breakdown (ISO weeks, rolled up from daily data)
+//	report, err := client.QueryWeeklyViews(ctx, "2025-01-01", "2025-01-31")
+//
 //	// Top videos
 //	report, err := client.QueryTopVideos(ctx, "2025-01-01", "2025-01-31", 10)
 //
@@ -35,21 +41,63 @@
 //	// Device breakdown
 //	report, err := client.QueryDeviceBreakdown(ctx, "2025-01-01", "2025-01-31")
 //
+//	// Views by traffic source; label codes with TrafficSourceName
+//	report, err := client.QueryTrafficSources(ctx, "2025-01-01", "2025-01-31")
+//
+//	// Viewer percentage by age group and gender (no rows for small audiences)
+//	report, err := client.QueryDemographics(ctx, "2025-01-01", "2025-01-31")
+//
+//	// Daily revenue breakdown: ad vs. YouTube Premium revenue, gross revenue, CPMs
+//	report, err := client.QueryRevenueReport(ctx, "2025-01-01", "2025-01-31")
+//
+// QueryRevenueReport needs a monetized channel and the ScopeMonetaryReadOnly
+// scope. Otherwise it returns an error wrapping ErrRevenueUnavailable:
+//
+//	if errors.Is(err, analytics.ErrRevenueUnavailable) {
+//		// Hide the revenue panel
+//	}
+//
 // # Working with Reports
 //
 // Access report data using typed accessors:
 //
 //	for _, row := range report.Rows() {
-//		day := row.GetString("day")
+//		day, _ := row.GetTime("day") // parses YYYY-MM-DD and YYYY-MM
 //		views := row.GetInt("views")
 //		minutes := row.GetFloat("estimatedMinutesWatched")
-//		fmt.Printf("%s: %d views, %.1f minutes\n", day, views, minutes)
+//		fmt.Printf("%s: %d views, %.1f minutes\n", day.Format("Jan 2"), views, minutes)
 //	}
 //
 //	// Aggregate totals
 //	totalViews := report.TotalViews()
 //	totalMinutes := report.TotalMinutesWatched()
 //
+//	// Any metric; ok is false if the report doesn't include it
+//	likes, ok := report.Sum(analytics.MetricLikes)
+//	peak, _ := report.Max(analytics.MetricViews)
+//
+//	// Average-type metrics must be weighted, not summed or averaged
+//	avgDuration, _ := report.WeightedAverage(analytics.MetricAverageViewDuration, analytics.MetricViews)
+//
+//	// Derived metrics: (likes+comments+shares)/views and likes/(likes+dislikes)
+//	engagement, ok := report.EngagementRate()
+//	likeRatio, ok := report.LikeRatio()
+//
+// Roll a report up to a single dimension without another API call, e.g.
+// collapse a day-by-country report to countries:
+//
+//	byCountry, err := report.GroupBy(analytics.DimensionCountry, map[string]analytics.AggFunc{
+//		analytics.MetricAverageViewDuration: analytics.AggWeightedAvg(analytics.MetricViews),
+//	})
+//
+// Print a report as an aligned text table:
+//
+//	err := report.RenderTable(os.Stdout,
+//		analytics.WithMaxRows(10),
+//		analytics.WithTotalRow(true),
+//		analytics.WithHumanizedNumbers(true),
+//	)
+//
 // # Common Metrics
 //
 //   - views: Number of video views
@@ -59,6 +107,14 @@
 //   - subscribersLost: Lost subscribers
 //   - likes, dislikes, comments, shares: Engagement metrics
 //   - estimatedRevenue: Total estimated revenue (if monetized)
+//   - estimatedAdRevenue, estimatedRedPartnerRevenue: Ad and YouTube Premium revenue
+//
+// Counts and totals (views, estimatedMinutesWatched, subscribersGained,
+// likes, comments, shares, estimatedRevenue, monetizedPlaybacks) are
+// additive: sum them across rows. Averages and rates are not. Combine
+// averageViewDuration and averageViewPercentage with WeightedAverage
+// weighted by views, cpm weighted by adImpressions, and playbackBasedCpm
+// weighted by monetizedPlaybacks.
 //
 // # Dimensions
 //
@@ -72,6 +128,90 @@
 //   - ageGroup, gender: Demographics (if available)
 //   - trafficSourceType: Where views came from
 //
+// Dimension values are codes such as "US" or "GAME_CONSOLE". Convert them to
+// display names for dashboards with DimensionDisplayName, which falls back to
+// the raw code for unknown values:
+//
+//	name := analytics.DimensionDisplayName(analytics.DimensionCountry, row.GetString("country"), "en")
+//
+// Built-in names are English; add other languages or overrides to a client
+// with WithDimensionDisplayNames and look them up with
+// Client.DimensionDisplayName.
+//
+// # Content Owners
+//
+// CMS partners can query managed channels on behalf of a content owner.
+// The token must be granted auth.ScopePartner by a user linked to the
+// content owner account:
+//
+//	client := analytics.NewClient(
+//		analytics.WithTokenProvider(authClient.AccessToken),
+//		analytics.WithContentOwner("CONTENT_OWNER_ID"),
+//		analytics.WithScopes(token.Scopes...), // optional fail-fast scope check
+//	)
+//	report, err := client.Query(ctx, &analytics.QueryParams{
+//		IDs:       "contentOwner==CONTENT_OWNER_ID",
+//		StartDate: "2025-01-01",
+//		EndDate:   "2025-01-31",
+//		Metrics:   "views",
+//		Filters:   "channel==UC1234",
+//	})
+//
+// # Large Reports
+//
+// A single response holds at most MaxResults rows. QueryAll fetches
+// windows of rows with startIndex until the report is exhausted, for
+// reports such as a per-city breakdown over a long range:
+//
+//	report, err := client.QueryAll(ctx, &analytics.QueryParams{
+//		IDs:        "channel==MINE",
+//		StartDate:  "2024-01-01",
+//		EndDate:    "2024-12-31",
+//		Metrics:    "views",
+//		Dimensions: "city",
+//		Sort:       "-views",
+//	})
+//
+// # Multiple Channels
+//
+// QueryMultiChannel runs one query per channel concurrently and merges the
+// rows, tagging each with a DimensionChannel column:
+//
+//	report, err := client.QueryMultiChannel(ctx, []string{"UC1", "UC2"}, &analytics.QueryParams{
+//		StartDate: "2025-01-01",
+//		EndDate:   "2025-01-31",
+//		Metrics:   "views",
+//	})
+//	total, _ := report.Sum(analytics.MetricViews)
+//	perChannel, err := report.GroupBy(analytics.DimensionChannel, nil)
+//
+// The token must be authorized for every channel, e.g. as a content owner.
+// With a token per channel, query each channel with its own client and
+// combine the reports with MergeChannelReports.
+//
+// # Quota Tracking
+//
+// Attach a core.QuotaTracker to count API calls. Each underlying request
+// records a "reports.query" operation:
+//
+//	tracker := core.NewQuotaTracker(core.DefaultDailyQuota)
+//	client := analytics.NewClient(
+//		analytics.WithTokenProvider(authClient.AccessToken),
+//		analytics.WithQuotaTracker(tracker),
+//	)
+//	fmt.Printf("Used: %d\n", client.QuotaUsed())
+//
+// # Caching
+//
+// WithCache serves repeated identical queries from a core.Cache without
+// spending quota. Queries ending today are not cached by default, since
+// that day's data is still changing:
+//
+//	client := analytics.NewClient(
+//		analytics.WithTokenProvider(authClient.AccessToken),
+//		analytics.WithCache(core.NewCache(), time.Hour),
+//	)
+//
 // # Error Handling
 //
 // Handle analytics-specific errors:
@@ -85,4 +225,10 @@
 //			// Rate limit hit
 //		}
 //	}
+//
+// Query checks dates before sending a request: a malformed date, a start
+// date after the end date, or a date in the future returns an
+// AnalyticsError with IsInvalidArgument true and Field naming the bad
+// parameter, without spending quota. Call QueryParams.Validate to check
+// params up front.
 package analytics
@@ -0,0 +1,46 @@
+package analytics
+
+// EngagementRate returns the share of views that led to a like, comment, or
+// share, across all rows:
+//
+//	(likes + comments + shares) / views
+//
+// For example, 30 likes, 5 comments, and 5 shares on 1,000 views is an
+// engagement rate of 0.04 (4%). Request the views, likes, comments, and
+// shares metrics. The bool is false if any of them is missing from the
+// report or there are no views.
+func (r *Report) EngagementRate() (float64, bool) {
+	views, ok := r.Sum(MetricViews)
+	if !ok || views == 0 {
+		return 0, false
+	}
+
+	var interactions float64
+	for _, metric := range []string{MetricLikes, MetricComments, MetricShares} {
+		n, ok := r.Sum(metric)
+		if !ok {
+			return 0, false
+		}
+		interactions += n
+	}
+	return interactions / views, true
+}
+
+// LikeRatio returns the share of ratings that are likes, across all rows:
+//
+//	likes / (likes + dislikes)
+//
+// For example, 90 likes and 10 dislikes is a like ratio of 0.9. Request
+// the likes and dislikes metrics. The bool is false if either is missing
+// from the report or there are no ratings.
+func (r *Report) LikeRatio() (float64, bool) {
+	likes, ok := r.Sum(MetricLikes)
+	if !ok {
+		return 0, false
+	}
+	dislikes, ok := r.Sum(MetricDislikes)
+	if !ok || likes+dislikes == 0 {
+		return 0, false
+	}
+	return likes / (likes + dislikes), true
+}
@@ -0,0 +1,78 @@
+package analytics
+
+import "testing"
+
+// newEngagementReport returns a report by day with the given metric columns,
+// using fixed values per metric.
+func newEngagementReport(metrics ...string) *Report {
+	values := map[string][2]float64{
+		MetricViews:    {600, 400},
+		MetricLikes:    {30, 15},
+		MetricDislikes: {2, 3},
+		MetricComments: {6, 4},
+		MetricShares:   {3, 1},
+	}
+
+	report := &Report{
+		ColumnHeaders: []ColumnHeader{{Name: DimensionDay, ColumnType: ColumnTypeDimension, DataType: "STRING"}},
+		RawRows:       [][]any{{"2024-01-01"}, {"2024-01-02"}},
+	}
+	for _, m := range metrics {
+		report.ColumnHeaders = append(report.ColumnHeaders, ColumnHeader{Name: m, ColumnType: ColumnTypeMetric, DataType: "INTEGER"})
+		for i := range report.RawRows {
+			report.RawRows[i] = append(report.RawRows[i], values[m][i])
+		}
+	}
+	return report
+}
+
+func TestReport_EngagementRate(t *testing.T) {
+	all := []string{MetricViews, MetricLikes, MetricComments, MetricShares}
+
+	tests := []struct {
+		name   string
+		report *Report
+		want   float64
+		wantOK bool
+	}{
+		// (45 + 10 + 4) / 1000
+		{"all metrics", newEngagementReport(all...), 0.059, true},
+		{"missing shares", newEngagementReport(MetricViews, MetricLikes, MetricComments), 0, false},
+		{"missing views", newEngagementReport(MetricLikes, MetricComments, MetricShares), 0, false},
+		{"no rows", func() *Report { r := newEngagementReport(all...); r.RawRows = nil; return r }(), 0, false},
+		{"nil report", nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.report.EngagementRate()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("EngagementRate() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestReport_LikeRatio(t *testing.T) {
+	tests := []struct {
+		name   string
+		report *Report
+		want   float64
+		wantOK bool
+	}{
+		// 45 / (45 + 5)
+		{"likes and dislikes", newEngagementReport(MetricViews, MetricLikes, MetricDislikes), 0.9, true},
+		{"missing dislikes", newEngagementReport(MetricLikes), 0, false},
+		{"no ratings", func() *Report { r := newEngagementReport(MetricLikes, MetricDislikes); r.RawRows = nil; return r }(), 0, false},
+		{"nil report", nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.report.LikeRatio()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("LikeRatio() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
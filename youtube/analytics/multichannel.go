@@ -0,0 +1,145 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// maxConcurrentChannelQueries limits the requests QueryMultiChannel has in
+// flight at once.
+const maxConcurrentChannelQueries = 8
+
+// ChannelReport is one channel's report, as merged by MergeChannelReports.
+type ChannelReport struct {
+	// ChannelID is the channel the report covers.
+	ChannelID string
+
+	// Report is the channel's report.
+	Report *Report
+}
+
+// QueryMultiChannel runs the same query for each channel concurrently and
+// merges the results with MergeChannelReports: every row gets a leading
+// DimensionChannel column with its channel ID, and rows are ordered by
+// channelIDs. params is a template; its IDs are replaced with
+// "channel==<id>" for each query, and limits such as MaxResults and Sort
+// apply per channel.
+//
+// Each channel costs one query. For combined totals, use Sum or GroupBy on
+// the merged report; for per-channel totals, GroupBy(DimensionChannel, ...).
+//
+// The client's token must be authorized for every channel. That is usually
+// a content owner managing the channels (see WithContentOwner), or a user
+// who owns all of them. If each channel has its own token, query each
+// channel with its own client and merge with MergeChannelReports instead.
+//
+// If any query fails, the others are canceled and the error names the
+// channel.
+func (c *Client) QueryMultiChannel(ctx context.Context, channelIDs []string, params *QueryParams) (*Report, error) {
+	if len(channelIDs) == 0 {
+		return nil, fmt.Errorf("at least one channel ID is required")
+	}
+	if params == nil {
+		return nil, fmt.Errorf("query params cannot be nil")
+	}
+	for _, id := range channelIDs {
+		if id == "" {
+			return nil, fmt.Errorf("channel ID cannot be empty")
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	reports := make([]ChannelReport, len(channelIDs))
+	sem := make(chan struct{}, maxConcurrentChannelQueries)
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i, id := range channelIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			p := *params
+			p.IDs = "channel==" + id
+			report, err := c.Query(ctx, &p)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("querying channel %s: %w", id, err)
+					cancel()
+				})
+				return
+			}
+			reports[i] = ChannelReport{ChannelID: id, Report: report}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return MergeChannelReports(reports)
+}
+
+// QueryMultiChannel is a package-level convenience function for
+// Client.QueryMultiChannel.
+func QueryMultiChannel(ctx context.Context, client *Client, channelIDs []string, params *QueryParams) (*Report, error) {
+	return client.QueryMultiChannel(ctx, channelIDs, params)
+}
+
+// MergeChannelReports combines reports for different channels into one,
+// adding a leading DimensionChannel column with each row's channel ID.
+// Rows keep the order of reports. Nil reports are treated as empty.
+//
+// All reports must have the same columns, and none may already have a
+// DimensionChannel column.
+func MergeChannelReports(reports []ChannelReport) (*Report, error) {
+	var headers []ColumnHeader
+	for _, cr := range reports {
+		if cr.Report == nil || len(cr.Report.ColumnHeaders) == 0 {
+			continue
+		}
+		if headers == nil {
+			headers = cr.Report.ColumnHeaders
+			continue
+		}
+		if !slices.Equal(headers, cr.Report.ColumnHeaders) {
+			return nil, fmt.Errorf("report for channel %s has different columns", cr.ChannelID)
+		}
+	}
+	if slices.ContainsFunc(headers, func(h ColumnHeader) bool { return h.Name == DimensionChannel }) {
+		return nil, fmt.Errorf("reports already have a %q column", DimensionChannel)
+	}
+
+	merged := &Report{
+		Kind: "youtubeAnalytics#resultTable",
+		ColumnHeaders: append([]ColumnHeader{{
+			Name:       DimensionChannel,
+			ColumnType: ColumnTypeDimension,
+			DataType:   "STRING",
+		}}, headers...),
+	}
+	for _, cr := range reports {
+		if cr.Report == nil {
+			continue
+		}
+		for _, row := range cr.Report.RawRows {
+			merged.RawRows = append(merged.RawRows, append([]any{cr.ChannelID}, row...))
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,195 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_QueryMultiChannel(t *testing.T) {
+	views := map[string]int{"UC1": 100, "UC2": 250, "UC3": 50}
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		q := r.URL.Query()
+		id := strings.TrimPrefix(q.Get("ids"), "channel==")
+		if q.Get("onBehalfOfContentOwner") != "owner1" {
+			t.Errorf("onBehalfOfContentOwner = %q, want owner1", q.Get("onBehalfOfContentOwner"))
+		}
+		if q.Get("dimensions") != "day" {
+			t.Errorf("dimensions = %q, want day", q.Get("dimensions"))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"columnHeaders": []map[string]string{
+				{"name": "day", "columnType": "DIMENSION", "dataType": "STRING"},
+				{"name": "views", "columnType": "METRIC", "dataType": "INTEGER"},
+			},
+			"rows": [][]any{
+				{"2025-01-01", views[id]},
+				{"2025-01-02", views[id]},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithAnalyticsURL(server.URL),
+		WithAccessToken("test-token"),
+		WithContentOwner("owner1"),
+	)
+	params := &QueryParams{
+		IDs:        "contentOwner==owner1",
+		StartDate:  "2025-01-01",
+		EndDate:    "2025-01-02",
+		Metrics:    MetricViews,
+		Dimensions: DimensionDay,
+	}
+
+	report, err := QueryMultiChannel(context.Background(), client, []string{"UC1", "UC2", "UC3"}, params)
+	if err != nil {
+		t.Fatalf("QueryMultiChannel() error = %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("API calls = %d, want 3", calls.Load())
+	}
+	if params.IDs != "contentOwner==owner1" {
+		t.Errorf("params.IDs modified to %q", params.IDs)
+	}
+
+	if len(report.ColumnHeaders) != 3 || report.ColumnHeaders[0].Name != DimensionChannel ||
+		report.ColumnHeaders[0].ColumnType != ColumnTypeDimension {
+		t.Fatalf("ColumnHeaders = %+v, want leading channel dimension", report.ColumnHeaders)
+	}
+	rows := report.Rows()
+	if len(rows) != 6 {
+		t.Fatalf("got %d rows, want 6", len(rows))
+	}
+	for i, want := range []string{"UC1", "UC1", "UC2", "UC2", "UC3", "UC3"} {
+		if got := rows[i].GetString(DimensionChannel); got != want {
+			t.Errorf("row %d channel = %q, want %q", i, got, want)
+		}
+	}
+
+	if total := report.TotalViews(); total != 800 {
+		t.Errorf("TotalViews() = %d, want 800", total)
+	}
+	perChannel, err := report.GroupBy(DimensionChannel, nil)
+	if err != nil {
+		t.Fatalf("GroupBy() error = %v", err)
+	}
+	if got := perChannel.Rows()[1].GetInt(MetricViews); got != 500 {
+		t.Errorf("UC2 views = %d, want 500", got)
+	}
+}
+
+func TestClient_QueryMultiChannel_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ids") == "channel==UCforbidden" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"Forbidden","status":"PERMISSION_DENIED"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"columnHeaders": []map[string]string{
+				{"name": "views", "columnType": "METRIC", "dataType": "INTEGER"},
+			},
+			"rows": [][]any{{1}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(WithAnalyticsURL(server.URL), WithAccessToken("test-token"))
+	params := &QueryParams{StartDate: "2025-01-01", EndDate: "2025-01-31", Metrics: "views"}
+
+	tests := []struct {
+		name       string
+		channelIDs []string
+		params     *QueryParams
+		wantErr    string
+	}{
+		{"no channels", nil, params, "at least one channel ID"},
+		{"empty channel", []string{"UC1", ""}, params, "cannot be empty"},
+		{"nil params", []string{"UC1"}, nil, "cannot be nil"},
+		{"unauthorized channel", []string{"UC1", "UCforbidden"}, params, "querying channel UCforbidden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.QueryMultiChannel(context.Background(), tt.channelIDs, tt.params)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMergeChannelReports(t *testing.T) {
+	viewsHeader := []ColumnHeader{{Name: "views", ColumnType: ColumnTypeMetric, DataType: "INTEGER"}}
+
+	tests := []struct {
+		name     string
+		reports  []ChannelReport
+		wantRows int
+		wantErr  string
+	}{
+		{
+			name: "merges rows",
+			reports: []ChannelReport{
+				{ChannelID: "UC1", Report: &Report{ColumnHeaders: viewsHeader, RawRows: [][]any{{1.0}}}},
+				{ChannelID: "UC2", Report: &Report{ColumnHeaders: viewsHeader, RawRows: [][]any{{2.0}, {3.0}}}},
+			},
+			wantRows: 3,
+		},
+		{
+			name: "skips empty reports",
+			reports: []ChannelReport{
+				{ChannelID: "UC1", Report: nil},
+				{ChannelID: "UC2", Report: &Report{}},
+				{ChannelID: "UC3", Report: &Report{ColumnHeaders: viewsHeader, RawRows: [][]any{{1.0}}}},
+			},
+			wantRows: 1,
+		},
+		{
+			name: "mismatched columns",
+			reports: []ChannelReport{
+				{ChannelID: "UC1", Report: &Report{ColumnHeaders: viewsHeader}},
+				{ChannelID: "UC2", Report: &Report{ColumnHeaders: []ColumnHeader{{Name: "likes"}}}},
+			},
+			wantErr: "channel UC2 has different columns",
+		},
+		{
+			name: "existing channel column",
+			reports: []ChannelReport{
+				{ChannelID: "UC1", Report: &Report{ColumnHeaders: []ColumnHeader{{Name: DimensionChannel}}}},
+			},
+			wantErr: "already have",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := MergeChannelReports(tt.reports)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergeChannelReports() error = %v", err)
+			}
+			if len(merged.RawRows) != tt.wantRows {
+				t.Errorf("got %d rows, want %d", len(merged.RawRows), tt.wantRows)
+			}
+			// The inputs are not modified.
+			for _, cr := range tt.reports {
+				if cr.Report != nil && len(cr.Report.ColumnHeaders) > 1 {
+					t.Errorf("input report for %s modified", cr.ChannelID)
+				}
+			}
+		})
+	}
+}
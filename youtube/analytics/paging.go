@@ -0,0 +1,62 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultPageSize is the number of rows QueryAll requests per call when
+// QueryParams.MaxResults is not set. It is within the row limit of every
+// report, including top-video reports sorted by a metric (200 rows).
+const DefaultPageSize = 200
+
+// QueryAll executes an analytics query and returns every row, fetching
+// windows of rows until the API returns fewer than requested. This is for
+// reports with more rows than one response holds, such as a per-city
+// breakdown over a long date range.
+//
+// params.MaxResults sets the page size (default DefaultPageSize), not a
+// total limit; params.StartIndex is the 1-based row to start from (default
+// 1). Each window is one query (and one quota charge); successive windows
+// use startIndex 1, 1+pageSize, 1+2*pageSize, and so on. params is not
+// modified. Cancelling ctx stops between windows.
+//
+// Rows are only consistent across windows when the query has a stable
+// order, so set Sort for reports with many rows.
+func (c *Client) QueryAll(ctx context.Context, params *QueryParams) (*Report, error) {
+	if params == nil {
+		return nil, fmt.Errorf("query params cannot be nil")
+	}
+
+	page := *params
+	if page.MaxResults <= 0 {
+		page.MaxResults = DefaultPageSize
+	}
+	if page.StartIndex <= 0 {
+		page.StartIndex = 1
+	}
+
+	var report *Report
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resp, err := c.Query(ctx, &page)
+		if err != nil {
+			if report == nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("querying rows from %d: %w", page.StartIndex, err)
+		}
+
+		if report == nil {
+			report = resp
+		} else {
+			report.RawRows = append(report.RawRows, resp.RawRows...)
+		}
+		if len(resp.RawRows) < page.MaxResults {
+			return report, nil
+		}
+		page.StartIndex += len(resp.RawRows)
+	}
+}
@@ -0,0 +1,150 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// cityServer serves a report of n city rows, honoring startIndex and
+// maxResults. Requests starting at failAt return a server error.
+func cityServer(t *testing.T, n, failAt int, starts *[]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		*starts = append(*starts, q.Get("startIndex")+"/"+q.Get("maxResults"))
+
+		start, _ := strconv.Atoi(q.Get("startIndex"))
+		size, _ := strconv.Atoi(q.Get("maxResults"))
+		if start == failAt {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"code":500,"message":"backend error"}}`))
+			return
+		}
+
+		rows := [][]any{}
+		for i := start; i < start+size && i <= n; i++ {
+			rows = append(rows, []any{fmt.Sprintf("city%d", i), i})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"columnHeaders": []map[string]string{
+				{"name": "city", "columnType": "DIMENSION", "dataType": "STRING"},
+				{"name": "views", "columnType": "METRIC", "dataType": "INTEGER"},
+			},
+			"rows": rows,
+		})
+	}))
+}
+
+func TestClient_QueryAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		rows       int
+		maxResults int
+		startIndex int
+		wantCalls  []string
+		wantFirst  string
+		wantRows   int
+	}{
+		{"multiple pages", 450, 0, 0, []string{"1/200", "201/200", "401/200"}, "city1", 450},
+		{"single page", 20, 0, 0, []string{"1/200"}, "city1", 20},
+		{"exact multiple", 200, 100, 0, []string{"1/100", "101/100", "201/100"}, "city1", 200},
+		{"custom start", 250, 100, 51, []string{"51/100", "151/100", "251/100"}, "city51", 200},
+		{"empty report", 0, 0, 0, []string{"1/200"}, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			server := cityServer(t, tt.rows, -1, &calls)
+			defer server.Close()
+
+			client := NewClient(WithAnalyticsURL(server.URL), WithAccessToken("test-token"))
+			params := &QueryParams{
+				IDs:        "channel==MINE",
+				StartDate:  "2024-01-01",
+				EndDate:    "2024-12-31",
+				Metrics:    "views",
+				Dimensions: "city",
+				Sort:       "-views",
+				MaxResults: tt.maxResults,
+				StartIndex: tt.startIndex,
+			}
+			report, err := client.QueryAll(context.Background(), params)
+			if err != nil {
+				t.Fatalf("QueryAll() error = %v", err)
+			}
+
+			if fmt.Sprint(calls) != fmt.Sprint(tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			rows := report.Rows()
+			if len(rows) != tt.wantRows {
+				t.Errorf("got %d rows, want %d", len(rows), tt.wantRows)
+			}
+			if tt.wantRows > 0 {
+				if got := rows[0].GetString("city"); got != tt.wantFirst {
+					t.Errorf("first city = %q, want %q", got, tt.wantFirst)
+				}
+				last := rows[len(rows)-1].GetString("city")
+				if want := fmt.Sprintf("city%d", tt.rows); last != want {
+					t.Errorf("last city = %q, want %q", last, want)
+				}
+			}
+			if params.MaxResults != tt.maxResults || params.StartIndex != tt.startIndex {
+				t.Errorf("params modified: %+v", params)
+			}
+		})
+	}
+}
+
+func TestClient_QueryAll_Errors(t *testing.T) {
+	params := &QueryParams{
+		IDs: "channel==MINE", StartDate: "2024-01-01", EndDate: "2024-12-31",
+		Metrics: "views", Dimensions: "city",
+	}
+
+	t.Run("nil params", func(t *testing.T) {
+		if _, err := NewClient().QueryAll(context.Background(), nil); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("later page fails", func(t *testing.T) {
+		var calls []string
+		server := cityServer(t, 450, 201, &calls)
+		defer server.Close()
+
+		client := NewClient(WithAnalyticsURL(server.URL), WithAccessToken("test-token"))
+		_, err := client.QueryAll(context.Background(), params)
+
+		var analyticsErr *AnalyticsError
+		if !errors.As(err, &analyticsErr) || analyticsErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("error = %v, want wrapped AnalyticsError", err)
+		}
+		if len(calls) != 2 {
+			t.Errorf("calls = %v, want 2", calls)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		var calls []string
+		server := cityServer(t, 450, -1, &calls)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		client := NewClient(WithAnalyticsURL(server.URL), WithAccessToken("test-token"))
+		if _, err := client.QueryAll(ctx, params); !errors.Is(err, context.Canceled) {
+			t.Errorf("error = %v, want context.Canceled", err)
+		}
+		if len(calls) != 0 {
+			t.Errorf("calls = %v, want none", calls)
+		}
+	})
+}
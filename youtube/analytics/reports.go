@@ -7,12 +7,25 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
 )
 
-// YouTube Analytics API endpoint.
-const DefaultAnalyticsURL = "https://youtubeanalytics.googleapis.com/v2/reports"
+// YouTube Analytics API endpoints.
+const (
+	// DefaultBaseURL is the base URL for YouTube Analytics API v2.
+	DefaultBaseURL = "https://youtubeanalytics.googleapis.com/v2"
+
+	// DefaultAnalyticsURL is the reports endpoint under DefaultBaseURL.
+	DefaultAnalyticsURL = DefaultBaseURL + "/reports"
+)
+
+// ScopePartner is the OAuth scope required for content owner queries.
+// It matches auth.ScopePartner.
+const ScopePartner = "https://www.googleapis.com/auth/youtubepartner"
 
 // Common metrics for analytics queries.
 const (
@@ -30,6 +43,7 @@ const (
 	MetricAverageViewPercentage    = "averageViewPercentage"
 	MetricEstimatedRevenue         = "estimatedRevenue"
 	MetricEstimatedAdRevenue       = "estimatedAdRevenue"
+	MetricEstimatedRedRevenue      = "estimatedRedPartnerRevenue"
 	MetricGrossRevenue             = "grossRevenue"
 	MetricCPM                      = "cpm"
 	MetricPlaybackBasedCPM         = "playbackBasedCpm"
@@ -39,6 +53,7 @@ const (
 	MetricCardTeaserClickRate      = "cardTeaserClickRate"
 	MetricRedViews                 = "redViews"
 	MetricRedWatchedMinutes        = "redWatchedMinutes"
+	MetricViewerPercentage         = "viewerPercentage"
 )
 
 // Common dimensions for analytics queries.
@@ -100,7 +115,8 @@ type QueryParams struct {
 	// Example: "-views,day"
 	Sort string
 
-	// MaxResults limits the number of rows returned (optional).
+	// MaxResults limits the number of rows returned (optional). With
+	// QueryAll, it sets the page size instead.
 	MaxResults int
 
 	// StartIndex is the 1-based index of the first row to retrieve (optional).
@@ -113,6 +129,76 @@ type QueryParams struct {
 	// Currency specifies the currency for revenue metrics (optional).
 	// Example: "USD", "EUR"
 	Currency string
+
+	// ContentOwner is the CMS content owner the request is made on behalf of
+	// (optional). Sent as onBehalfOfContentOwner and overrides the client's
+	// WithContentOwner default. Requires the youtubepartner scope.
+	ContentOwner string
+}
+
+// maxDateAhead is how far past the current UTC date a query date may be.
+// The API's dates follow the channel's time zone, which can be up to 14
+// hours ahead of UTC, so "today" there may already be tomorrow in UTC.
+const maxDateAhead = 14 * time.Hour
+
+// Validate checks that the required parameters are set and that StartDate
+// and EndDate are YYYY-MM-DD dates with StartDate not after EndDate and
+// neither in the future. Query calls Validate before sending a request.
+//
+// Date errors are *AnalyticsError values with Code "INVALID_ARGUMENT" and
+// Field naming the offending parameter, matching the API's own errors.
+func (p *QueryParams) Validate() error {
+	if p == nil {
+		return fmt.Errorf("query params cannot be nil")
+	}
+	if p.IDs == "" {
+		return fmt.Errorf("ids parameter is required")
+	}
+	if p.StartDate == "" {
+		return fmt.Errorf("startDate parameter is required")
+	}
+	if p.EndDate == "" {
+		return fmt.Errorf("endDate parameter is required")
+	}
+	if p.Metrics == "" {
+		return fmt.Errorf("metrics parameter is required")
+	}
+
+	start, err := parseQueryDate("startDate", p.StartDate)
+	if err != nil {
+		return err
+	}
+	end, err := parseQueryDate("endDate", p.EndDate)
+	if err != nil {
+		return err
+	}
+	if start.After(end) {
+		return invalidParamError("startDate", fmt.Sprintf("startDate %s is after endDate %s", p.StartDate, p.EndDate))
+	}
+	return nil
+}
+
+// parseQueryDate parses a YYYY-MM-DD query date and rejects future dates.
+func parseQueryDate(field, value string) (time.Time, error) {
+	t, err := time.Parse(dayLayout, value)
+	if err != nil {
+		return time.Time{}, invalidParamError(field, fmt.Sprintf("%s %q must be a date in YYYY-MM-DD format", field, value))
+	}
+	latest := time.Now().UTC().Add(maxDateAhead).Format(dayLayout)
+	if value > latest {
+		return time.Time{}, invalidParamError(field, fmt.Sprintf("%s %s is in the future; no data is available yet", field, value))
+	}
+	return t, nil
+}
+
+// invalidParamError returns a client-side validation error for field.
+func invalidParamError(field, message string) *AnalyticsError {
+	return &AnalyticsError{
+		Code:    "INVALID_ARGUMENT",
+		Reason:  "invalidParameter",
+		Message: message,
+		Field:   field,
+	}
 }
 
 // Report represents an analytics report response.
@@ -146,6 +232,12 @@ type ReportRow struct {
 	Values map[string]any
 }
 
+// Get returns the raw value for the given column name and whether it exists.
+func (r *ReportRow) Get(name string) (any, bool) {
+	v, ok := r.Values[name]
+	return v, ok
+}
+
 // GetString returns a string value for the given column name.
 func (r *ReportRow) GetString(name string) string {
 	if v, ok := r.Values[name]; ok {
@@ -186,6 +278,31 @@ func (r *ReportRow) GetFloat(name string) float64 {
 	return 0
 }
 
+// Date layouts used by time-based dimensions.
+const (
+	dayLayout   = "2006-01-02" // day dimension
+	monthLayout = "2006-01"    // month dimension
+)
+
+// GetTime parses a date dimension value (e.g., "day" or "month") for the
+// given column name. It accepts YYYY-MM-DD and YYYY-MM values and returns
+// the time in UTC. Returns false for missing, non-string, or unparseable values.
+func (r *ReportRow) GetTime(name string) (time.Time, bool) {
+	s, ok := r.Values[name].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{dayLayout, monthLayout} {
+		if len(s) != len(layout) {
+			continue
+		}
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
 // Rows returns the report data as a slice of ReportRow for easier access.
 func (r *Report) Rows() []ReportRow {
 	if r == nil || len(r.ColumnHeaders) == 0 || len(r.RawRows) == 0 {
@@ -269,6 +386,30 @@ type Client struct {
 	// TokenProvider is a function that returns a valid access token.
 	// If set, it takes precedence over the static accessToken.
 	tokenProvider func(context.Context) (string, error)
+
+	// quotaTracker records quota usage for each API call, if set.
+	quotaTracker *core.QuotaTracker
+
+	// quotaObserver is notified of each API call's quota cost, if set.
+	quotaObserver core.QuotaObserver
+
+	// contentOwner is the default onBehalfOfContentOwner value.
+	contentOwner string
+
+	// scopes are the OAuth scopes granted to the access token, if known.
+	scopes []string
+
+	// cache stores successful report bodies, if set.
+	cache      *core.Cache
+	cacheTTL   time.Duration
+	cacheToday bool
+
+	// now returns the current time; nil means time.Now.
+	now func() time.Time
+
+	// displayNames maps dimension → language → value → display name, added
+	// with WithDimensionDisplayNames.
+	displayNames map[string]map[string]map[string]string
 }
 
 // ClientOption configures an analytics Client.
@@ -288,7 +429,9 @@ func NewClient(opts ...ClientOption) *Client {
 	return c
 }
 
-// WithHTTPClient sets a custom HTTP client.
+// WithHTTPClient sets a custom HTTP client, e.g. for proxies, custom TLS,
+// or test transports. Authorization headers from WithAccessToken or
+// WithTokenProvider are still added to each request.
 func WithHTTPClient(hc *http.Client) ClientOption {
 	return func(c *Client) { c.httpClient = hc }
 }
@@ -309,22 +452,63 @@ func WithAnalyticsURL(url string) ClientOption {
 	return func(c *Client) { c.analyticsURL = url }
 }
 
+// WithBaseURL sets a custom API base URL, mirroring core.WithBaseURL.
+// Reports are requested from the "/reports" path under it, so a mock
+// server only needs to serve that path.
+func WithBaseURL(url string) ClientOption {
+	return func(c *Client) { c.analyticsURL = strings.TrimSuffix(url, "/") + "/reports" }
+}
+
+// WithContentOwner sets a default content owner for all queries. Requests are
+// sent with onBehalfOfContentOwner, letting CMS partners query managed
+// channels. The access token must be granted the youtubepartner scope
+// (ScopePartner) by a user linked to the content owner.
+func WithContentOwner(contentOwner string) ClientOption {
+	return func(c *Client) { c.contentOwner = contentOwner }
+}
+
+// WithScopes declares the OAuth scopes granted to the access token
+// (e.g., auth.Token.Scopes). When set, content owner queries fail fast
+// if ScopePartner is missing instead of waiting for a 403 from the API.
+func WithScopes(scopes ...string) ClientOption {
+	return func(c *Client) { c.scopes = scopes }
+}
+
+// WithQuotaTracker sets a quota tracker. Each API call made by the client
+// (including each call made by convenience helpers) records a
+// "reports.query" operation. The tracker may be shared with a core.Client.
+func WithQuotaTracker(qt *core.QuotaTracker) ClientOption {
+	return func(c *Client) { c.quotaTracker = qt }
+}
+
+// WithQuotaObserver sets an observer notified with the "reports.query"
+// operation and its cost after each API call. Share one observer with
+// core.WithQuotaObserver for unified quota reporting across API families,
+// but give the Analytics API its own QuotaTracker, since its quota is
+// separate.
+func WithQuotaObserver(o core.QuotaObserver) ClientOption {
+	return func(c *Client) { c.quotaObserver = o }
+}
+
+// QuotaTracker returns the client's quota tracker, if any.
+func (c *Client) QuotaTracker() *core.QuotaTracker {
+	return c.quotaTracker
+}
+
+// QuotaUsed returns the quota used as reported by the tracker,
+// or 0 if no tracker is set.
+func (c *Client) QuotaUsed() int {
+	if c.quotaTracker != nil {
+		return c.quotaTracker.Used()
+	}
+	return 0
+}
+
 // Query executes an analytics query and returns the report.
+// With WithCache, a cached report is returned without an API call.
 func (c *Client) Query(ctx context.Context, params *QueryParams) (*Report, error) {
-	if params == nil {
-		return nil, fmt.Errorf("query params cannot be nil")
-	}
-	if params.IDs == "" {
-		return nil, fmt.Errorf("ids parameter is required")
-	}
-	if params.StartDate == "" {
-		return nil, fmt.Errorf("startDate parameter is required")
-	}
-	if params.EndDate == "" {
-		return nil, fmt.Errorf("endDate parameter is required")
-	}
-	if params.Metrics == "" {
-		return nil, fmt.Errorf("metrics parameter is required")
+	if err := params.Validate(); err != nil {
+		return nil, err
 	}
 
 	// Build query parameters
@@ -357,6 +541,25 @@ func (c *Client) Query(ctx context.Context, params *QueryParams) (*Report, error
 		query.Set("currency", params.Currency)
 	}
 
+	contentOwner := params.ContentOwner
+	if contentOwner == "" {
+		contentOwner = c.contentOwner
+	}
+	if contentOwner != "" {
+		if c.scopes != nil && !slices.Contains(c.scopes, ScopePartner) {
+			return nil, fmt.Errorf("content owner queries require the %s scope", ScopePartner)
+		}
+		query.Set("onBehalfOfContentOwner", contentOwner)
+	}
+
+	cacheKey := c.cacheKey(query)
+	if body, ok := c.cachedBody(cacheKey); ok {
+		var report Report
+		if err := json.Unmarshal(body, &report); err == nil {
+			return &report, nil
+		}
+	}
+
 	// Get access token
 	accessToken, err := c.getAccessToken(ctx)
 	if err != nil {
@@ -382,6 +585,14 @@ func (c *Client) Query(ctx context.Context, params *QueryParams) (*Report, error
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	// Track quota usage
+	if c.quotaTracker != nil {
+		c.quotaTracker.Add("reports.query", 1)
+	}
+	if c.quotaObserver != nil {
+		c.quotaObserver.ObserveQuota("reports.query", core.OperationCost("reports.query"))
+	}
+
 	// Read response body
 	body, err := c.readResponseBody(resp.Body)
 	if err != nil {
@@ -398,6 +609,7 @@ func (c *Client) Query(ctx context.Context, params *QueryParams) (*Report, error
 	if err := json.Unmarshal(body, &report); err != nil {
 		return nil, fmt.Errorf("parsing response: %w", err)
 	}
+	c.storeBody(cacheKey, body)
 
 	return &report, nil
 }
@@ -461,17 +673,65 @@ func (c *Client) QueryDeviceBreakdown(ctx context.Context, startDate, endDate st
 	})
 }
 
-// QueryRevenueReport gets revenue metrics for the channel.
-// Requires the youtube.readonly scope and channel monetization.
-func (c *Client) QueryRevenueReport(ctx context.Context, startDate, endDate string) (*Report, error) {
+// QueryTrafficSources gets views and watch time broken down by how viewers
+// found the channel's videos (trafficSourceType), most views first. Use
+// TrafficSourceName to label the source codes.
+func (c *Client) QueryTrafficSources(ctx context.Context, startDate, endDate string) (*Report, error) {
+	return c.Query(ctx, &QueryParams{
+		IDs:        "channel==MINE",
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Metrics:    "views,estimatedMinutesWatched",
+		Dimensions: DimensionTrafficSourceType,
+		Sort:       "-views",
+	})
+}
+
+// QueryDemographics gets the percentage of viewers by age group and gender.
+// Each row has ageGroup (e.g., "age25-34"), gender ("female", "male", or
+// "user_specified"), and viewerPercentage (0-100, relative to all signed-in
+// viewers in the date range).
+//
+// YouTube omits demographics for channels or date ranges without enough
+// viewers to keep them anonymous. The report then has no rows rather than
+// an error; check len(report.Rows()) before using it.
+func (c *Client) QueryDemographics(ctx context.Context, startDate, endDate string) (*Report, error) {
 	return c.Query(ctx, &QueryParams{
 		IDs:        "channel==MINE",
 		StartDate:  startDate,
 		EndDate:    endDate,
-		Metrics:    "estimatedRevenue,estimatedAdRevenue,monetizedPlaybacks,cpm",
+		Metrics:    MetricViewerPercentage,
+		Dimensions: DimensionAgeGroup + "," + DimensionGender,
+		Sort:       DimensionGender + "," + DimensionAgeGroup,
+	})
+}
+
+// QueryRevenueReport gets a daily revenue breakdown for the channel: one
+// row per day with the RevenueMetrics, splitting estimated revenue into ad
+// revenue (estimatedAdRevenue) and YouTube Premium revenue
+// (estimatedRedPartnerRevenue). Sum the rows for period totals.
+//
+// Requires a monetized channel and ScopeMonetaryReadOnly (or ScopePartner
+// for content owners). A permission error from the API, or a scope list
+// from WithScopes without either scope, returns an error wrapping
+// ErrRevenueUnavailable.
+func (c *Client) QueryRevenueReport(ctx context.Context, startDate, endDate string) (*Report, error) {
+	if err := c.checkRevenueScopes(); err != nil {
+		return nil, err
+	}
+
+	report, err := c.Query(ctx, &QueryParams{
+		IDs:        "channel==MINE",
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Metrics:    strings.Join(RevenueMetrics, ","),
 		Dimensions: "day",
 		Sort:       "day",
 	})
+	if err != nil {
+		return nil, revenueError(err)
+	}
+	return report, nil
 }
 
 // getAccessToken retrieves the access token from provider or static value.
@@ -527,12 +787,14 @@ func (c *Client) parseError(statusCode int, body []byte) error {
 	return fmt.Errorf("analytics API error (status %d): %s", statusCode, string(body))
 }
 
-// AnalyticsError represents an error from the YouTube Analytics API.
+// AnalyticsError represents an error from the YouTube Analytics API, or a
+// parameter rejected by QueryParams.Validate before a request was sent.
 type AnalyticsError struct {
-	StatusCode int
+	StatusCode int    // 0 for client-side validation errors
 	Code       string // e.g., "PERMISSION_DENIED", "INVALID_ARGUMENT"
 	Reason     string // e.g., "forbidden", "invalidParameter"
 	Message    string
+	Field      string // Offending parameter (e.g., "startDate") for validation errors
 }
 
 // Error implements the error interface.
@@ -4,10 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/auth"
+	"github.com/Its-donkey/yougopher/youtube/core"
 )
 
 func TestNewClient(t *testing.T) {
@@ -60,6 +65,69 @@ func TestNewClient_WithOptions(t *testing.T) {
 	}
 }
 
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestWithBaseURL(t *testing.T) {
+	tests := []struct {
+		base string
+		want string
+	}{
+		{"http://localhost:8080", "http://localhost:8080/reports"},
+		{"http://localhost:8080/v2/", "http://localhost:8080/v2/reports"},
+		{DefaultBaseURL, DefaultAnalyticsURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.base, func(t *testing.T) {
+			client := NewClient(WithBaseURL(tt.base))
+			if client.analyticsURL != tt.want {
+				t.Errorf("analyticsURL = %q, want %q", client.analyticsURL, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CustomHTTPClientWithTokenProvider(t *testing.T) {
+	var transportCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer provider-token" {
+			t.Errorf("Authorization = %q, want provider token", got)
+		}
+		if got := r.Header.Get("X-Proxy"); got != "yes" {
+			t.Errorf("X-Proxy = %q, custom transport not used", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"kind": "youtubeAnalytics#resultTable"})
+	}))
+	defer server.Close()
+
+	hc := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		transportCalls++
+		r = r.Clone(r.Context())
+		r.Header.Set("X-Proxy", "yes")
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+
+	client := NewClient(
+		WithHTTPClient(hc),
+		WithBaseURL(server.URL),
+		WithTokenProvider(func(context.Context) (string, error) { return "provider-token", nil }),
+	)
+
+	if _, err := client.QueryChannelViews(context.Background(), "2025-01-01", "2025-01-31"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if transportCalls != 1 {
+		t.Errorf("transport called %d times, want 1", transportCalls)
+	}
+}
+
 func TestClient_Query(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
@@ -131,6 +199,141 @@ func TestClient_Query(t *testing.T) {
 	}
 }
 
+func TestClient_QuotaTracking(t *testing.T) {
+	t.Run("counts each API call", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"kind": "youtubeAnalytics#resultTable"})
+		}))
+		defer server.Close()
+
+		qt := core.NewQuotaTracker(1000)
+		client := NewClient(
+			WithAnalyticsURL(server.URL),
+			WithAccessToken("test-token"),
+			WithQuotaTracker(qt),
+		)
+		if client.QuotaTracker() != qt {
+			t.Error("QuotaTracker() did not return configured tracker")
+		}
+
+		_, _ = client.QueryChannelViews(context.Background(), "2025-01-01", "2025-01-31")
+		_, _ = client.QueryDailyViews(context.Background(), "2025-01-01", "2025-01-31")
+
+		if got := client.QuotaUsed(); got != 2 {
+			t.Errorf("QuotaUsed() = %d, want 2", got)
+		}
+	})
+
+	t.Run("counts failed API calls", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		client := NewClient(
+			WithAnalyticsURL(server.URL),
+			WithAccessToken("test-token"),
+			WithQuotaTracker(core.NewQuotaTracker(1000)),
+		)
+		_, _ = client.QueryChannelViews(context.Background(), "2025-01-01", "2025-01-31")
+
+		if got := client.QuotaUsed(); got != 1 {
+			t.Errorf("QuotaUsed() = %d, want 1", got)
+		}
+	})
+
+	t.Run("notifies observer", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"kind": "youtubeAnalytics#resultTable"})
+		}))
+		defer server.Close()
+
+		var observed []string
+		client := NewClient(
+			WithAnalyticsURL(server.URL),
+			WithAccessToken("test-token"),
+			WithQuotaObserver(core.QuotaObserverFunc(func(operation string, cost int) {
+				observed = append(observed, fmt.Sprintf("%s:%d", operation, cost))
+			})),
+		)
+		_, _ = client.QueryChannelViews(context.Background(), "2025-01-01", "2025-01-31")
+
+		if len(observed) != 1 || observed[0] != "reports.query:1" {
+			t.Errorf("observed = %v, want [reports.query:1]", observed)
+		}
+	})
+
+	t.Run("no tracker", func(t *testing.T) {
+		client := NewClient()
+		if got := client.QuotaUsed(); got != 0 {
+			t.Errorf("QuotaUsed() = %d, want 0", got)
+		}
+	})
+}
+
+func TestClient_Query_ContentOwner(t *testing.T) {
+	if ScopePartner != auth.ScopePartner {
+		t.Errorf("ScopePartner = %q, want %q", ScopePartner, auth.ScopePartner)
+	}
+
+	tests := []struct {
+		name      string
+		opts      []ClientOption
+		params    string
+		wantOwner string
+		wantErr   bool
+	}{
+		{name: "none", wantOwner: ""},
+		{name: "client default", opts: []ClientOption{WithContentOwner("owner1")}, wantOwner: "owner1"},
+		{name: "params override", opts: []ClientOption{WithContentOwner("owner1")}, params: "owner2", wantOwner: "owner2"},
+		{name: "partner scope granted", opts: []ClientOption{WithScopes(ScopePartner)}, params: "owner1", wantOwner: "owner1"},
+		{name: "partner scope missing", opts: []ClientOption{WithScopes(auth.ScopeReadOnly)}, params: "owner1", wantErr: true},
+		{name: "scopes without owner", opts: []ClientOption{WithScopes(auth.ScopeReadOnly)}, wantOwner: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOwner string
+			var called bool
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				gotOwner = r.URL.Query().Get("onBehalfOfContentOwner")
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]any{"kind": "youtubeAnalytics#resultTable"})
+			}))
+			defer server.Close()
+
+			opts := append([]ClientOption{WithAnalyticsURL(server.URL), WithAccessToken("test-token")}, tt.opts...)
+			client := NewClient(opts...)
+
+			_, err := client.Query(context.Background(), &QueryParams{
+				IDs:          "contentOwner==owner1",
+				StartDate:    "2025-01-01",
+				EndDate:      "2025-01-31",
+				Metrics:      "views",
+				ContentOwner: tt.params,
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if called {
+					t.Error("request should not be sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotOwner != tt.wantOwner {
+				t.Errorf("onBehalfOfContentOwner = %q, want %q", gotOwner, tt.wantOwner)
+			}
+		})
+	}
+}
+
 func TestClient_Query_AllParams(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		q := r.URL.Query()
@@ -233,6 +436,71 @@ func TestClient_Query_ValidationErrors(t *testing.T) {
 	}
 }
 
+func TestQueryParams_Validate(t *testing.T) {
+	valid := func(start, end string) *QueryParams {
+		return &QueryParams{IDs: "channel==MINE", StartDate: start, EndDate: end, Metrics: "views"}
+	}
+	today := time.Now().UTC().Format("2006-01-02")
+
+	tests := []struct {
+		name      string
+		params    *QueryParams
+		wantField string // "" for no error
+		wantMsg   string
+	}{
+		{"valid range", valid("2025-01-01", "2025-01-31"), "", ""},
+		{"single day", valid("2025-01-01", "2025-01-01"), "", ""},
+		{"ends today", valid("2025-01-01", today), "", ""},
+		{"slashes in start", valid("2025/01/01", "2025-01-31"), "startDate", `startDate "2025/01/01" must be a date in YYYY-MM-DD format`},
+		{"month only end", valid("2025-01-01", "2025-01"), "endDate", "YYYY-MM-DD"},
+		{"impossible date", valid("2025-02-30", "2025-03-01"), "startDate", "YYYY-MM-DD"},
+		{"start after end", valid("2025-02-01", "2025-01-01"), "startDate", "startDate 2025-02-01 is after endDate 2025-01-01"},
+		{"future end", valid("2025-01-01", "2999-01-01"), "endDate", "in the future"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+
+			var analyticsErr *AnalyticsError
+			if !errors.As(err, &analyticsErr) {
+				t.Fatalf("Validate() error = %v, want *AnalyticsError", err)
+			}
+			if analyticsErr.Field != tt.wantField {
+				t.Errorf("Field = %q, want %q", analyticsErr.Field, tt.wantField)
+			}
+			if !analyticsErr.IsInvalidArgument() {
+				t.Error("IsInvalidArgument() = false, want true")
+			}
+			if !strings.Contains(analyticsErr.Message, tt.wantMsg) {
+				t.Errorf("Message = %q, want containing %q", analyticsErr.Message, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestClient_Query_InvalidDateNotSent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent for invalid params")
+	}))
+	defer server.Close()
+
+	client := NewClient(WithAnalyticsURL(server.URL), WithAccessToken("test-token"))
+	_, err := client.Query(context.Background(), &QueryParams{
+		IDs: "channel==MINE", StartDate: "01/01/2025", EndDate: "2025-01-31", Metrics: "views",
+	})
+	var analyticsErr *AnalyticsError
+	if !errors.As(err, &analyticsErr) || analyticsErr.Field != "startDate" {
+		t.Errorf("Query() error = %v, want startDate validation error", err)
+	}
+}
+
 func TestClient_Query_NoToken(t *testing.T) {
 	client := NewClient()
 
@@ -470,10 +738,132 @@ func TestClient_QueryDeviceBreakdown(t *testing.T) {
 	}
 }
 
+func TestClient_QueryTrafficSources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("dimensions") != "trafficSourceType" {
+			t.Errorf("expected dimensions trafficSourceType, got %s", q.Get("dimensions"))
+		}
+		if q.Get("metrics") != "views,estimatedMinutesWatched" {
+			t.Errorf("expected metrics views,estimatedMinutesWatched, got %s", q.Get("metrics"))
+		}
+		if q.Get("sort") != "-views" {
+			t.Errorf("expected sort -views, got %s", q.Get("sort"))
+		}
+
+		resp := map[string]any{
+			"kind": "youtubeAnalytics#resultTable",
+			"columnHeaders": []map[string]string{
+				{"name": "trafficSourceType", "columnType": "DIMENSION", "dataType": "STRING"},
+				{"name": "views", "columnType": "METRIC", "dataType": "INTEGER"},
+				{"name": "estimatedMinutesWatched", "columnType": "METRIC", "dataType": "INTEGER"},
+			},
+			"rows": [][]any{
+				{"YT_SEARCH", 500, 1200},
+				{"RELATED_VIDEO", 300, 900},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithAnalyticsURL(server.URL),
+		WithAccessToken("test-token"),
+	)
+
+	report, err := client.QueryTrafficSources(context.Background(), "2025-01-01", "2025-01-31")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows := report.Rows()
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if got := TrafficSourceName(rows[0].GetString(DimensionTrafficSourceType)); got != "YouTube search" {
+		t.Errorf("expected YouTube search, got %q", got)
+	}
+}
+
+func TestClient_QueryDemographics(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     [][]any
+		wantRows int
+	}{
+		{
+			name: "with data",
+			rows: [][]any{
+				{"age18-24", "female", 12.5},
+				{"age25-34", "male", 40.0},
+			},
+			wantRows: 2,
+		},
+		{
+			// Below the anonymity threshold the API returns no rows.
+			name:     "audience too small",
+			rows:     nil,
+			wantRows: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if q.Get("metrics") != "viewerPercentage" {
+					t.Errorf("expected metrics viewerPercentage, got %s", q.Get("metrics"))
+				}
+				if q.Get("dimensions") != "ageGroup,gender" {
+					t.Errorf("expected dimensions ageGroup,gender, got %s", q.Get("dimensions"))
+				}
+
+				resp := map[string]any{
+					"kind": "youtubeAnalytics#resultTable",
+					"columnHeaders": []map[string]string{
+						{"name": "ageGroup", "columnType": "DIMENSION", "dataType": "STRING"},
+						{"name": "gender", "columnType": "DIMENSION", "dataType": "STRING"},
+						{"name": "viewerPercentage", "columnType": "METRIC", "dataType": "FLOAT"},
+					},
+				}
+				if tt.rows != nil {
+					resp["rows"] = tt.rows
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+
+			client := NewClient(
+				WithAnalyticsURL(server.URL),
+				WithAccessToken("test-token"),
+			)
+
+			report, err := client.QueryDemographics(context.Background(), "2025-01-01", "2025-01-31")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			rows := report.Rows()
+			if len(rows) != tt.wantRows {
+				t.Fatalf("expected %d rows, got %d", tt.wantRows, len(rows))
+			}
+			if tt.wantRows > 0 {
+				if got := rows[1].GetFloat(MetricViewerPercentage); got != 40.0 {
+					t.Errorf("expected viewerPercentage 40, got %v", got)
+				}
+				if got := DimensionDisplayName(DimensionAgeGroup, rows[0].GetString(DimensionAgeGroup), "en"); got != "18–24" {
+					t.Errorf("expected age group display name 18–24, got %q", got)
+				}
+			}
+		})
+	}
+}
+
 func TestClient_QueryRevenueReport(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		q := r.URL.Query()
-		if q.Get("metrics") != "estimatedRevenue,estimatedAdRevenue,monetizedPlaybacks,cpm" {
+		if q.Get("metrics") != "estimatedRevenue,estimatedAdRevenue,estimatedRedPartnerRevenue,grossRevenue,monetizedPlaybacks,cpm,playbackBasedCpm" {
 			t.Errorf("unexpected metrics: %s", q.Get("metrics"))
 		}
 
@@ -609,6 +999,55 @@ func TestReportRow_TypeConversion(t *testing.T) {
 	}
 }
 
+func TestReportRow_Get(t *testing.T) {
+	row := ReportRow{Values: map[string]any{"views": float64(10)}}
+
+	if v, ok := row.Get("views"); !ok || v != float64(10) {
+		t.Errorf("Get(views) = %v, %v; want 10, true", v, ok)
+	}
+	if _, ok := row.Get("missing"); ok {
+		t.Error("Get(missing) ok = true, want false")
+	}
+}
+
+func TestReportRow_GetTime(t *testing.T) {
+	row := ReportRow{
+		Values: map[string]any{
+			"day":     "2025-01-15",
+			"month":   "2025-02",
+			"country": "US",
+			"views":   float64(100),
+			"bad":     "2025-13-45",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		column string
+		want   time.Time
+		wantOK bool
+	}{
+		{"day", "day", time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), true},
+		{"month", "month", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), true},
+		{"non-date string", "country", time.Time{}, false},
+		{"numeric", "views", time.Time{}, false},
+		{"invalid date", "bad", time.Time{}, false},
+		{"missing", "missing", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := row.GetTime(tt.column)
+			if ok != tt.wantOK {
+				t.Fatalf("GetTime(%q) ok = %v, want %v", tt.column, ok, tt.wantOK)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("GetTime(%q) = %v, want %v", tt.column, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestReport_TotalViews(t *testing.T) {
 	report := &Report{
 		ColumnHeaders: []ColumnHeader{
@@ -0,0 +1,49 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ScopeMonetaryReadOnly is the OAuth scope required for revenue and ad
+// performance metrics.
+const ScopeMonetaryReadOnly = "https://www.googleapis.com/auth/yt-analytics-monetary.readonly"
+
+// RevenueMetrics are the metrics returned by QueryRevenueReport: total
+// estimated revenue, its ad and YouTube Premium parts, gross ad revenue,
+// monetized playbacks, and CPMs.
+var RevenueMetrics = []string{
+	MetricEstimatedRevenue,
+	MetricEstimatedAdRevenue,
+	MetricEstimatedRedRevenue,
+	MetricGrossRevenue,
+	MetricMonetizedPlaybacks,
+	MetricCPM,
+	MetricPlaybackBasedCPM,
+}
+
+// ErrRevenueUnavailable is returned by QueryRevenueReport when revenue metrics
+// cannot be read: the channel is not monetized, or the token was not
+// granted ScopeMonetaryReadOnly or ScopePartner.
+var ErrRevenueUnavailable = errors.New("revenue metrics unavailable: the channel must be monetized and the token must be granted " + ScopeMonetaryReadOnly)
+
+// checkRevenueScopes returns an error wrapping ErrRevenueUnavailable if
+// WithScopes lists neither ScopeMonetaryReadOnly nor ScopePartner.
+func (c *Client) checkRevenueScopes() error {
+	if c.scopes != nil && !slices.Contains(c.scopes, ScopeMonetaryReadOnly) && !slices.Contains(c.scopes, ScopePartner) {
+		return fmt.Errorf("%w (granted scopes: %s)", ErrRevenueUnavailable, strings.Join(c.scopes, " "))
+	}
+	return nil
+}
+
+// revenueError wraps a permission error from a revenue query with
+// ErrRevenueUnavailable. Other errors are returned unchanged.
+func revenueError(err error) error {
+	var apiErr *AnalyticsError
+	if errors.As(err, &apiErr) && apiErr.IsPermissionDenied() {
+		return fmt.Errorf("%w: %w", ErrRevenueUnavailable, err)
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_QueryRevenueReport_Breakdown(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("ids") != "channel==MINE" || q.Get("dimensions") != "day" {
+			t.Errorf("ids = %s, dimensions = %s", q.Get("ids"), q.Get("dimensions"))
+		}
+
+		headers := []map[string]string{{"name": "day", "columnType": "DIMENSION", "dataType": "STRING"}}
+		for _, m := range RevenueMetrics {
+			headers = append(headers, map[string]string{"name": m, "columnType": "METRIC", "dataType": "FLOAT"})
+		}
+		resp := map[string]any{
+			"kind":          "youtubeAnalytics#resultTable",
+			"columnHeaders": headers,
+			"rows":          [][]any{{"2025-01-01", 120.5, 100.0, 20.5, 150.0, 900.0, 4.2, 5.1}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithAnalyticsURL(server.URL),
+		WithAccessToken("test-token"),
+		WithScopes(ScopeMonetaryReadOnly),
+	)
+
+	report, err := client.QueryRevenueReport(context.Background(), "2025-01-01", "2025-01-31")
+	if err != nil {
+		t.Fatalf("QueryRevenueReport() error = %v", err)
+	}
+	rows := report.Rows()
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	if got := rows[0].GetFloat(MetricEstimatedAdRevenue); got != 100 {
+		t.Errorf("estimatedAdRevenue = %v, want 100", got)
+	}
+	if got := rows[0].GetFloat(MetricEstimatedRedRevenue); got != 20.5 {
+		t.Errorf("estimatedRedPartnerRevenue = %v, want 20.5", got)
+	}
+}
+
+func TestClient_QueryRevenueReport_Unavailable(t *testing.T) {
+	tests := []struct {
+		name      string
+		scopes    []string
+		status    int
+		body      string
+		wantCalls int32
+		wantErr   bool
+		wantUnav  bool
+	}{
+		{
+			name:      "not monetized",
+			status:    http.StatusForbidden,
+			body:      `{"error": {"code": 403, "message": "Forbidden", "status": "PERMISSION_DENIED", "errors": [{"reason": "forbidden"}]}}`,
+			wantCalls: 1,
+			wantErr:   true,
+			wantUnav:  true,
+		},
+		{
+			name:     "missing scope",
+			scopes:   []string{"https://www.googleapis.com/auth/youtube.readonly"},
+			wantErr:  true,
+			wantUnav: true,
+		},
+		{
+			name:      "partner scope",
+			scopes:    []string{ScopePartner},
+			status:    http.StatusOK,
+			body:      `{"kind": "youtubeAnalytics#resultTable"}`,
+			wantCalls: 1,
+		},
+		{
+			name:      "other error",
+			status:    http.StatusBadRequest,
+			body:      `{"error": {"code": 400, "message": "Invalid date", "status": "INVALID_ARGUMENT", "errors": [{"reason": "invalidParameter"}]}}`,
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(
+				WithAnalyticsURL(server.URL),
+				WithAccessToken("test-token"),
+				WithScopes(tt.scopes...),
+			)
+
+			_, err := client.QueryRevenueReport(context.Background(), "2025-01-01", "2025-01-31")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("QueryRevenueReport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, ErrRevenueUnavailable); got != tt.wantUnav {
+				t.Errorf("errors.Is(err, ErrRevenueUnavailable) = %v, want %v (err = %v)", got, tt.wantUnav, err)
+			}
+			if tt.status == http.StatusForbidden {
+				var apiErr *AnalyticsError
+				if !errors.As(err, &apiErr) || !apiErr.IsPermissionDenied() {
+					t.Errorf("error = %v, want wrapped *AnalyticsError", err)
+				}
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("API calls = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
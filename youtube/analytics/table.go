@@ -0,0 +1,185 @@
+package analytics
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TableOption configures Report.RenderTable.
+type TableOption func(*tableConfig)
+
+// tableConfig holds RenderTable settings.
+type tableConfig struct {
+	maxRows  int
+	totalRow bool
+	humanize bool
+}
+
+// WithMaxRows limits the table to the first n rows. A note with the number
+// of omitted rows is written after the table. Zero or negative means no limit.
+func WithMaxRows(n int) TableOption {
+	return func(c *tableConfig) { c.maxRows = n }
+}
+
+// WithTotalRow appends a row with the sum of each metric column across all
+// rows of the report, including rows hidden by WithMaxRows. Note that sums
+// of ratio metrics such as averageViewDuration are not meaningful.
+func WithTotalRow(enabled bool) TableOption {
+	return func(c *tableConfig) { c.totalRow = enabled }
+}
+
+// WithHumanizedNumbers abbreviates large numbers, e.g. 1234567 as "1.2M".
+func WithHumanizedNumbers(enabled bool) TableOption {
+	return func(c *tableConfig) { c.humanize = enabled }
+}
+
+// RenderTable writes the report as an aligned text table with a header row.
+// Metric columns are right-aligned and dimension columns left-aligned.
+//
+//	err := report.RenderTable(os.Stdout, analytics.WithMaxRows(10), analytics.WithTotalRow(true))
+//
+// produces output like:
+//
+//	country   views  likes
+//	-------  ------  -----
+//	US       120000    800
+//	GB        30500    210
+//	Total    150500   1010
+func (r *Report) RenderTable(w io.Writer, opts ...TableOption) error {
+	if r == nil {
+		return fmt.Errorf("report cannot be nil")
+	}
+
+	var cfg tableConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	rows := r.RawRows
+	omitted := 0
+	if cfg.maxRows > 0 && len(rows) > cfg.maxRows {
+		omitted = len(rows) - cfg.maxRows
+		rows = rows[:cfg.maxRows]
+	}
+
+	// Format every cell first so column widths are known.
+	header := make([]string, len(r.ColumnHeaders))
+	for i, h := range r.ColumnHeaders {
+		header[i] = h.Name
+	}
+	cells := make([][]string, 0, len(rows)+1)
+	for _, row := range rows {
+		line := make([]string, len(r.ColumnHeaders))
+		for i, h := range r.ColumnHeaders {
+			if i < len(row) {
+				line[i] = formatCell(row[i], h, cfg.humanize)
+			}
+		}
+		cells = append(cells, line)
+	}
+	if cfg.totalRow {
+		line := make([]string, len(r.ColumnHeaders))
+		for i, h := range r.ColumnHeaders {
+			if h.ColumnType == ColumnTypeDimension {
+				continue
+			}
+			if total, ok := r.Sum(h.Name); ok {
+				line[i] = formatCell(total, h, cfg.humanize)
+			}
+		}
+		if len(line) > 0 && line[0] == "" {
+			line[0] = "Total"
+		}
+		cells = append(cells, line)
+	}
+
+	widths := make([]int, len(header))
+	for i, name := range header {
+		widths[i] = utf8.RuneCountInString(name)
+	}
+	for _, line := range cells {
+		for i, cell := range line {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	separator := make([]string, len(header))
+	for i, width := range widths {
+		separator[i] = strings.Repeat("-", width)
+	}
+
+	var b strings.Builder
+	r.writeTableLine(&b, header, widths)
+	r.writeTableLine(&b, separator, widths)
+	for _, line := range cells {
+		r.writeTableLine(&b, line, widths)
+	}
+	if omitted > 0 {
+		fmt.Fprintf(&b, "... %d more rows\n", omitted)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeTableLine writes one padded table line, right-aligning metric columns.
+func (r *Report) writeTableLine(b *strings.Builder, cells []string, widths []int) {
+	for i, cell := range cells {
+		if i > 0 {
+			b.WriteString("  ")
+		}
+		pad := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+		if r.ColumnHeaders[i].ColumnType == ColumnTypeDimension {
+			b.WriteString(cell)
+			// Don't pad the last column with trailing spaces.
+			if i < len(cells)-1 {
+				b.WriteString(pad)
+			}
+		} else {
+			b.WriteString(pad)
+			b.WriteString(cell)
+		}
+	}
+	b.WriteString("\n")
+}
+
+// formatCell formats a report value for display.
+func formatCell(v any, h ColumnHeader, humanize bool) string {
+	f, ok := v.(float64)
+	if !ok {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprint(v)
+	}
+	if humanize {
+		return humanizeNumber(f)
+	}
+	if h.DataType == "INTEGER" || f == float64(int64(f)) {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+// humanizeNumber abbreviates a number with K, M, or B suffixes.
+func humanizeNumber(f float64) string {
+	abs := f
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs >= 1e9:
+		return strconv.FormatFloat(f/1e9, 'f', 1, 64) + "B"
+	case abs >= 1e6:
+		return strconv.FormatFloat(f/1e6, 'f', 1, 64) + "M"
+	case abs >= 1e3:
+		return strconv.FormatFloat(f/1e3, 'f', 1, 64) + "K"
+	case f == float64(int64(f)):
+		return strconv.FormatInt(int64(f), 10)
+	default:
+		return strconv.FormatFloat(f, 'f', 2, 64)
+	}
+}
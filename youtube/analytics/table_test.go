@@ -0,0 +1,110 @@
+package analytics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newCountryReport() *Report {
+	return &Report{
+		ColumnHeaders: []ColumnHeader{
+			{Name: DimensionCountry, ColumnType: ColumnTypeDimension, DataType: "STRING"},
+			{Name: MetricViews, ColumnType: ColumnTypeMetric, DataType: "INTEGER"},
+			{Name: MetricEstimatedMinutesWatched, ColumnType: ColumnTypeMetric, DataType: "FLOAT"},
+		},
+		RawRows: [][]any{
+			{"US", float64(120000), 2500.5},
+			{"GB", float64(30500), float64(700)},
+			{"DE", float64(1500), 12.25},
+		},
+	}
+}
+
+func TestReport_RenderTable(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []TableOption
+		want string
+	}{
+		{
+			name: "default",
+			want: "" +
+				"country   views  estimatedMinutesWatched\n" +
+				"-------  ------  -----------------------\n" +
+				"US       120000                  2500.50\n" +
+				"GB        30500                      700\n" +
+				"DE         1500                    12.25\n",
+		},
+		{
+			name: "max rows and total",
+			opts: []TableOption{WithMaxRows(2), WithTotalRow(true)},
+			want: "" +
+				"country   views  estimatedMinutesWatched\n" +
+				"-------  ------  -----------------------\n" +
+				"US       120000                  2500.50\n" +
+				"GB        30500                      700\n" +
+				"Total    152000                  3212.75\n" +
+				"... 1 more rows\n",
+		},
+		{
+			name: "humanized",
+			opts: []TableOption{WithHumanizedNumbers(true)},
+			want: "" +
+				"country   views  estimatedMinutesWatched\n" +
+				"-------  ------  -----------------------\n" +
+				"US       120.0K                     2.5K\n" +
+				"GB        30.5K                      700\n" +
+				"DE         1.5K                    12.25\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := newCountryReport().RenderTable(&b, tt.opts...); err != nil {
+				t.Fatalf("RenderTable() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("RenderTable() =\n%s\nwant\n%s", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestReport_RenderTable_Errors(t *testing.T) {
+	var nilReport *Report
+	if err := nilReport.RenderTable(&strings.Builder{}); err == nil {
+		t.Error("expected error for nil report")
+	}
+	if err := newCountryReport().RenderTable(failingWriter{}); err == nil {
+		t.Error("expected write error")
+	}
+}
+
+func TestHumanizeNumber(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{12.5, "12.50"},
+		{1000, "1.0K"},
+		{1250000, "1.2M"},
+		{3400000000, "3.4B"},
+		{-2500, "-2.5K"},
+	}
+
+	for _, tt := range tests {
+		if got := humanizeNumber(tt.in); got != tt.want {
+			t.Errorf("humanizeNumber(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Columns added by QueryWeeklyViews.
+const (
+	// DimensionWeek is the week-start date (YYYY-MM-DD) of a weekly report.
+	// It is computed client-side; the API does not accept it in queries.
+	DimensionWeek = "week"
+
+	// MetricDays is the number of days of data in each week of a weekly report.
+	MetricDays = "days"
+)
+
+// QueryWeeklyViews gets the channel's views by ISO week (Monday to Sunday).
+// The API has no weekly dimension, so this queries daily views and rolls
+// them up client-side into a report with a DimensionWeek column, the
+// summed views and estimatedMinutesWatched, and a MetricDays column.
+//
+// Weeks cut off by the date range are labeled with their first date in the
+// range rather than their Monday; MetricDays tells partial weeks apart, e.g.
+// to compare average daily views.
+func (c *Client) QueryWeeklyViews(ctx context.Context, startDate, endDate string) (*Report, error) {
+	start, err := time.Parse(dayLayout, startDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date %q: %w", startDate, err)
+	}
+
+	daily, err := c.QueryDailyViews(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return weeklyRollup(daily, start)
+}
+
+// weeklyRollup groups a report with a day dimension into weeks starting on
+// Monday, clamped to start.
+func weeklyRollup(daily *Report, start time.Time) (*Report, error) {
+	dayIdx := daily.metricIndex(DimensionDay)
+	if dayIdx < 0 {
+		return nil, fmt.Errorf("report has no %q dimension", DimensionDay)
+	}
+
+	weekly := &Report{Kind: daily.Kind}
+	for i, h := range daily.ColumnHeaders {
+		if i == dayIdx {
+			h = ColumnHeader{Name: DimensionWeek, ColumnType: ColumnTypeDimension, DataType: "STRING"}
+		}
+		weekly.ColumnHeaders = append(weekly.ColumnHeaders, h)
+	}
+	weekly.ColumnHeaders = append(weekly.ColumnHeaders, ColumnHeader{Name: MetricDays, ColumnType: ColumnTypeMetric, DataType: "INTEGER"})
+
+	for _, row := range daily.RawRows {
+		if dayIdx >= len(row) {
+			continue
+		}
+		day, ok := row[dayIdx].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid day value %v", row[dayIdx])
+		}
+		t, err := time.Parse(dayLayout, day)
+		if err != nil {
+			return nil, fmt.Errorf("invalid day value %q: %w", day, err)
+		}
+
+		week := isoWeekStart(t)
+		if week.Before(start) {
+			week = start
+		}
+
+		out := append(make([]any, 0, len(row)+1), row...)
+		out[dayIdx] = week.Format(dayLayout)
+		weekly.RawRows = append(weekly.RawRows, append(out, float64(1)))
+	}
+
+	return weekly.GroupBy(DimensionWeek, nil)
+}
+
+// isoWeekStart returns the Monday of t's ISO week.
+func isoWeekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7 // days since Monday
+	return t.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,116 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_QueryWeeklyViews(t *testing.T) {
+	// 2025-01-01 is a Wednesday and 2025-01-14 a Tuesday, so the range
+	// has a partial week at each end.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("dimensions") != "day" {
+			t.Errorf("expected dimensions day, got %s", q.Get("dimensions"))
+		}
+
+		var rows [][]any
+		for d := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC); d.Day() <= 14; d = d.AddDate(0, 0, 1) {
+			rows = append(rows, []any{d.Format("2006-01-02"), 10, 2.5})
+		}
+		resp := map[string]any{
+			"kind": "youtubeAnalytics#resultTable",
+			"columnHeaders": []map[string]string{
+				{"name": "day", "columnType": "DIMENSION", "dataType": "STRING"},
+				{"name": "views", "columnType": "METRIC", "dataType": "INTEGER"},
+				{"name": "estimatedMinutesWatched", "columnType": "METRIC", "dataType": "FLOAT"},
+			},
+			"rows": rows,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithAnalyticsURL(server.URL),
+		WithAccessToken("test-token"),
+	)
+
+	report, err := client.QueryWeeklyViews(context.Background(), "2025-01-01", "2025-01-14")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantHeaders := []string{DimensionWeek, MetricViews, MetricEstimatedMinutesWatched, MetricDays}
+	if len(report.ColumnHeaders) != len(wantHeaders) {
+		t.Fatalf("got %d columns, want %d", len(report.ColumnHeaders), len(wantHeaders))
+	}
+	for i, name := range wantHeaders {
+		if report.ColumnHeaders[i].Name != name {
+			t.Errorf("column %d = %q, want %q", i, report.ColumnHeaders[i].Name, name)
+		}
+	}
+
+	want := []struct {
+		week    string
+		views   int64
+		minutes float64
+		days    int64
+	}{
+		{"2025-01-01", 50, 12.5, 5}, // partial: Wed-Sun
+		{"2025-01-06", 70, 17.5, 7},
+		{"2025-01-13", 20, 5, 2}, // partial: Mon-Tue
+	}
+	rows := report.Rows()
+	if len(rows) != len(want) {
+		t.Fatalf("got %d weeks, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		row := rows[i]
+		if got := row.GetString(DimensionWeek); got != w.week {
+			t.Errorf("week %d = %q, want %q", i, got, w.week)
+		}
+		if got := row.GetInt(MetricViews); got != w.views {
+			t.Errorf("week %s views = %d, want %d", w.week, got, w.views)
+		}
+		if got := row.GetFloat(MetricEstimatedMinutesWatched); got != w.minutes {
+			t.Errorf("week %s minutes = %v, want %v", w.week, got, w.minutes)
+		}
+		if got := row.GetInt(MetricDays); got != w.days {
+			t.Errorf("week %s days = %d, want %d", w.week, got, w.days)
+		}
+	}
+}
+
+func TestClient_QueryWeeklyViews_InvalidStartDate(t *testing.T) {
+	client := NewClient(WithAccessToken("test-token"))
+	if _, err := client.QueryWeeklyViews(context.Background(), "2025/01/01", "2025-01-31"); err == nil {
+		t.Error("expected error for invalid start date")
+	}
+}
+
+func TestIsoWeekStart(t *testing.T) {
+	tests := []struct {
+		day  string
+		want string
+	}{
+		{"2025-01-06", "2025-01-06"}, // Monday
+		{"2025-01-08", "2025-01-06"}, // Wednesday
+		{"2025-01-12", "2025-01-06"}, // Sunday
+		{"2025-01-01", "2024-12-30"}, // week spans the year boundary
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.day, func(t *testing.T) {
+			d, _ := time.Parse(dayLayout, tt.day)
+			if got := isoWeekStart(d).Format(dayLayout); got != tt.want {
+				t.Errorf("isoWeekStart(%s) = %s, want %s", tt.day, got, tt.want)
+			}
+		})
+	}
+}
@@ -39,6 +39,10 @@ const (
 
 	// ScopePartnerChannelAudit grants access to YouTube Analytics monetary reports.
 	ScopePartnerChannelAudit = "https://www.googleapis.com/auth/youtubepartner-channel-audit"
+
+	// ScopeChannelMemberships grants access to a creator's channel membership
+	// levels and members list.
+	ScopeChannelMemberships = "https://www.googleapis.com/auth/youtube.channel-memberships.creator"
 )
 
 // Config holds OAuth 2.0 configuration.
@@ -114,6 +118,38 @@ func NewAuthClient(config Config, opts ...AuthClientOption) *AuthClient {
 	return c
 }
 
+// NewAuthClientFromRefreshToken creates an OAuth client from a stored refresh
+// token, e.g. for a headless worker that completed the authorization flow
+// once and restarts without user interaction:
+//
+//	client, err := auth.NewAuthClientFromRefreshToken(ctx, config, storedRefreshToken)
+//	if err != nil {
+//		var authErr *auth.AuthError
+//		if errors.As(err, &authErr) && authErr.IsInvalidGrant() {
+//			// Revoked or expired: the user must authorize again
+//		}
+//		return err
+//	}
+//
+// It refreshes once before returning, so a refresh token that no longer
+// works fails here rather than on the first API call. Afterwards the client
+// mints access tokens on demand through AccessToken, or in the background
+// with StartAutoRefresh. A WithOnTokenRefresh callback also sees the initial
+// refresh. The refresh token replaces any token set with WithToken.
+func NewAuthClientFromRefreshToken(ctx context.Context, config Config, refreshToken string, opts ...AuthClientOption) (*AuthClient, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refresh token cannot be empty")
+	}
+
+	c := NewAuthClient(config, opts...)
+	c.token = &Token{RefreshToken: refreshToken}
+
+	if _, err := c.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
 // WithHTTPClient sets a custom HTTP client.
 func WithHTTPClient(hc *http.Client) AuthClientOption {
 	return func(c *AuthClient) { c.httpClient = hc }
@@ -172,12 +208,18 @@ func WithLoginHint(hint string) AuthURLOption {
 
 // Exchange exchanges an authorization code for a token.
 func (c *AuthClient) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.exchange(ctx, code, c.config.RedirectURL)
+}
+
+// exchange exchanges a code issued for redirectURL, which must match the
+// redirect_uri of the authorization request.
+func (c *AuthClient) exchange(ctx context.Context, code, redirectURL string) (*Token, error) {
 	data := url.Values{
 		"client_id":     {c.config.ClientID},
 		"client_secret": {c.config.ClientSecret},
 		"code":          {code},
 		"grant_type":    {"authorization_code"},
-		"redirect_uri":  {c.config.RedirectURL},
+		"redirect_uri":  {redirectURL},
 	}
 
 	token, err := c.doTokenRequest(ctx, data)
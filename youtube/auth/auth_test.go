@@ -281,6 +281,82 @@ func TestAuthClient_Refresh(t *testing.T) {
 	}
 }
 
+func TestNewAuthClientFromRefreshToken(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm error: %v", err)
+		}
+		if r.Form.Get("grant_type") != "refresh_token" {
+			t.Errorf("grant_type = %q, want refresh_token", r.Form.Get("grant_type"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Form.Get("refresh_token") == "revoked-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"error":             "invalid_grant",
+				"error_description": "Token has been expired or revoked.",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer server.Close()
+
+	config := Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		TokenURL:     server.URL,
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		requests = 0
+		var refreshed *Token
+		client, err := NewAuthClientFromRefreshToken(context.Background(), config, "stored-token",
+			WithOnTokenRefresh(func(tok *Token) { refreshed = tok }),
+		)
+		if err != nil {
+			t.Fatalf("NewAuthClientFromRefreshToken() error = %v", err)
+		}
+
+		token := client.Token()
+		if token.AccessToken != "access-token" || token.RefreshToken != "stored-token" {
+			t.Errorf("token = %q/%q, want access-token/stored-token", token.AccessToken, token.RefreshToken)
+		}
+		if refreshed == nil {
+			t.Error("onTokenRefresh not called for the initial refresh")
+		}
+
+		// The access token is cached until it expires
+		if got, err := client.AccessToken(context.Background()); err != nil || got != "access-token" {
+			t.Errorf("AccessToken() = %q, %v", got, err)
+		}
+		if requests != 1 {
+			t.Errorf("token requests = %d, want 1", requests)
+		}
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		_, err := NewAuthClientFromRefreshToken(context.Background(), config, "revoked-token")
+		var authErr *AuthError
+		if !errors.As(err, &authErr) || !authErr.IsInvalidGrant() {
+			t.Errorf("error = %v, want invalid_grant *AuthError", err)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if _, err := NewAuthClientFromRefreshToken(context.Background(), config, ""); err == nil {
+			t.Error("expected error for empty refresh token")
+		}
+	})
+}
+
 func TestAuthClient_Refresh_NoRefreshToken(t *testing.T) {
 	config := Config{
 		ClientID:     "client-id",
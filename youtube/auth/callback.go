@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultCallbackRedirectURL is the redirect URL RunLocalCallback uses when
+// the client's Config has none.
+const DefaultCallbackRedirectURL = "http://localhost:8080/callback"
+
+// defaultSuccessHTML is shown in the browser after a successful callback.
+const defaultSuccessHTML = `<!DOCTYPE html>
+<html><head><title>Authorization complete</title></head>
+<body><h1>Authorization complete</h1><p>You can close this window and return to the application.</p></body>
+</html>`
+
+// callbackConfig holds RunLocalCallback settings.
+type callbackConfig struct {
+	port           int // -1 to use the redirect URL's port
+	successHTML    string
+	openBrowser    func(authURL string) error
+	authURLOptions []AuthURLOption
+}
+
+// CallbackOption configures RunLocalCallback.
+type CallbackOption func(*callbackConfig)
+
+// WithCallbackPort sets the port the callback server listens on, replacing
+// the port in the client's redirect URL. Port 0 picks a free port.
+//
+// Google accepts any port for "Desktop app" clients registered with a
+// loopback redirect URI such as http://localhost. "Web application" clients
+// require the exact redirect URI, port included, to be registered.
+func WithCallbackPort(port int) CallbackOption {
+	return func(c *callbackConfig) { c.port = port }
+}
+
+// WithSuccessHTML sets the page shown in the browser once authorization
+// succeeds.
+func WithSuccessHTML(html string) CallbackOption {
+	return func(c *callbackConfig) { c.successHTML = html }
+}
+
+// WithBrowserOpener sets the function that sends the user to the
+// authorization URL. The default opens the system browser. Headless tools
+// can print the URL instead:
+//
+//	auth.WithBrowserOpener(func(authURL string) error {
+//		fmt.Println("Open this URL to authorize:", authURL)
+//		return nil
+//	})
+func WithBrowserOpener(fn func(authURL string) error) CallbackOption {
+	return func(c *callbackConfig) { c.openBrowser = fn }
+}
+
+// WithCallbackAuthURLOptions sets options for the authorization URL, such
+// as WithPrompt or WithLoginHint.
+func WithCallbackAuthURLOptions(opts ...AuthURLOption) CallbackOption {
+	return func(c *callbackConfig) { c.authURLOptions = opts }
+}
+
+// RunLocalCallback runs the authorization code flow for command-line tools.
+// It starts a temporary HTTP server on the loopback redirect URL from the
+// client's Config (DefaultCallbackRedirectURL if empty), opens the browser
+// at the authorization URL, waits for Google to redirect back with a code,
+// exchanges the code, and shuts the server down:
+//
+//	authClient := auth.NewAuthClient(config)
+//	token, err := auth.RunLocalCallback(ctx, authClient,
+//		auth.WithCallbackAuthURLOptions(auth.WithPrompt("consent")))
+//
+// The authorization request carries a state from GenerateState, and a
+// callback whose state fails VerifyState is rejected before the code is
+// exchanged. Only the first callback is handled; a denied authorization
+// is returned as an *AuthError (e.g., Code "access_denied").
+//
+// The redirect URL must use a loopback host (localhost, 127.0.0.1, or
+// [::1]). If it has no port, a free port is chosen. Cancel ctx to stop
+// waiting.
+func RunLocalCallback(ctx context.Context, c *AuthClient, opts ...CallbackOption) (*Token, error) {
+	cfg := callbackConfig{
+		port:        -1,
+		successHTML: defaultSuccessHTML,
+		openBrowser: openBrowser,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	redirect := c.config.RedirectURL
+	if redirect == "" {
+		redirect = DefaultCallbackRedirectURL
+	}
+	u, err := url.Parse(redirect)
+	if err != nil {
+		return nil, fmt.Errorf("parsing redirect URL: %w", err)
+	}
+	host := u.Hostname()
+	if host != "localhost" && !net.ParseIP(host).IsLoopback() {
+		return nil, fmt.Errorf("redirect URL %q is not a loopback address", redirect)
+	}
+	port := u.Port()
+	if cfg.port >= 0 {
+		port = strconv.Itoa(cfg.port)
+	}
+	if port == "" {
+		port = "0"
+	}
+
+	ln, err := net.Listen("tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, fmt.Errorf("starting callback server: %w", err)
+	}
+	u.Host = net.JoinHostPort(host, strconv.Itoa(ln.Addr().(*net.TCPAddr).Port))
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	redirectURL := u.String()
+
+	state := GenerateState()
+
+	type result struct {
+		token *Token
+		err   error
+	}
+	results := make(chan result, 1)
+	var once sync.Once
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(u.Path, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != u.Path {
+			http.NotFound(w, r)
+			return
+		}
+		handled := false
+		once.Do(func() {
+			handled = true
+			token, err := handleCallback(ctx, c, r.URL.Query(), state, redirectURL)
+			if err != nil {
+				http.Error(w, "Authorization failed: "+err.Error(), http.StatusBadRequest)
+			} else {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				_, _ = w.Write([]byte(cfg.successHTML))
+			}
+			results <- result{token, err}
+		})
+		if !handled {
+			http.Error(w, "Authorization already completed", http.StatusGone)
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = srv.Serve(ln) }()
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	authOpts := append(slices.Clip(cfg.authURLOptions), func(v url.Values) { v.Set("redirect_uri", redirectURL) })
+	if err := cfg.openBrowser(c.AuthorizationURL(state, authOpts...)); err != nil {
+		return nil, fmt.Errorf("opening browser: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		return res.token, res.err
+	}
+}
+
+// handleCallback validates the callback query and exchanges its code.
+func handleCallback(ctx context.Context, c *AuthClient, q url.Values, state, redirectURL string) (*Token, error) {
+	if !VerifyState(state, q.Get("state")) {
+		return nil, &AuthError{Code: "invalid_state", Message: "callback state does not match the authorization request"}
+	}
+	if code := q.Get("error"); code != "" {
+		return nil, &AuthError{Code: code, Message: q.Get("error_description")}
+	}
+	code := q.Get("code")
+	if code == "" {
+		return nil, &AuthError{Code: "invalid_request", Message: "callback has no authorization code"}
+	}
+	return c.exchange(ctx, code, redirectURL)
+}
+
+// openBrowser opens authURL in the system browser.
+func openBrowser(authURL string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", authURL)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", authURL)
+	default:
+		cmd = exec.Command("xdg-open", authURL)
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
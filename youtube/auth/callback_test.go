@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// callbackBrowser simulates a browser that is redirected back to the
+// callback with the given query, overriding the state if state is set.
+type callbackBrowser struct {
+	query url.Values
+	state string
+
+	authURL    *url.URL
+	status     int
+	body       string
+	visitError error
+	done       chan struct{}
+}
+
+func newCallbackBrowser(query url.Values) *callbackBrowser {
+	return &callbackBrowser{query: query, done: make(chan struct{})}
+}
+
+func (b *callbackBrowser) open(authURL string) error {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return err
+	}
+	b.authURL = u
+
+	q := url.Values{}
+	for k, v := range b.query {
+		q[k] = v
+	}
+	q.Set("state", u.Query().Get("state"))
+	if b.state != "" {
+		q.Set("state", b.state)
+	}
+
+	go func() {
+		defer close(b.done)
+		resp, err := http.Get(u.Query().Get("redirect_uri") + "?" + q.Encode())
+		if err != nil {
+			b.visitError = err
+			return
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, _ := io.ReadAll(resp.Body)
+		b.status, b.body = resp.StatusCode, string(body)
+	}()
+	return nil
+}
+
+func TestRunLocalCallback(t *testing.T) {
+	var exchanged url.Values
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		exchanged = r.Form
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access-token",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"refresh_token": "refresh-token",
+		})
+	}))
+	defer tokenServer.Close()
+
+	newClient := func(redirect string) *AuthClient {
+		return NewAuthClient(Config{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			RedirectURL:  redirect,
+			TokenURL:     tokenServer.URL,
+			Scopes:       []string{ScopeReadOnly},
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		exchanged = nil
+		browser := newCallbackBrowser(url.Values{"code": {"auth-code"}})
+		client := newClient("http://127.0.0.1/oauth/callback")
+
+		token, err := RunLocalCallback(context.Background(), client,
+			WithBrowserOpener(browser.open),
+			WithSuccessHTML("<p>done</p>"),
+			WithCallbackAuthURLOptions(WithPrompt("consent")),
+		)
+		if err != nil {
+			t.Fatalf("RunLocalCallback() error = %v", err)
+		}
+		<-browser.done
+
+		if token.AccessToken != "access-token" {
+			t.Errorf("AccessToken = %q, want access-token", token.AccessToken)
+		}
+		if client.Token() == nil {
+			t.Error("client token not set")
+		}
+		if browser.status != http.StatusOK || browser.body != "<p>done</p>" {
+			t.Errorf("callback page = %d %q, want 200 <p>done</p>", browser.status, browser.body)
+		}
+
+		authQuery := browser.authURL.Query()
+		redirect := authQuery.Get("redirect_uri")
+		if !strings.HasPrefix(redirect, "http://127.0.0.1:") || !strings.HasSuffix(redirect, "/oauth/callback") {
+			t.Errorf("redirect_uri = %q, want free port on 127.0.0.1", redirect)
+		}
+		if authQuery.Get("prompt") != "consent" {
+			t.Errorf("prompt = %q, want consent", authQuery.Get("prompt"))
+		}
+		if len(authQuery.Get("state")) < 32 {
+			t.Errorf("state = %q, want random value", authQuery.Get("state"))
+		}
+		if exchanged.Get("code") != "auth-code" || exchanged.Get("redirect_uri") != redirect {
+			t.Errorf("exchange form = %v, want code and matching redirect_uri", exchanged)
+		}
+	})
+
+	t.Run("callback errors", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			query    url.Values
+			state    string
+			wantCode string
+		}{
+			{"state mismatch", url.Values{"code": {"auth-code"}}, "forged", "invalid_state"},
+			{"access denied", url.Values{"error": {"access_denied"}}, "", "access_denied"},
+			{"missing code", url.Values{}, "", "invalid_request"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				exchanged = nil
+				browser := newCallbackBrowser(tt.query)
+				browser.state = tt.state
+
+				_, err := RunLocalCallback(context.Background(), newClient("http://localhost/callback"),
+					WithBrowserOpener(browser.open))
+				<-browser.done
+
+				var authErr *AuthError
+				if !errors.As(err, &authErr) || authErr.Code != tt.wantCode {
+					t.Errorf("error = %v, want AuthError %s", err, tt.wantCode)
+				}
+				if browser.status != http.StatusBadRequest {
+					t.Errorf("callback status = %d, want 400", browser.status)
+				}
+				if exchanged != nil {
+					t.Error("code exchanged after failed callback")
+				}
+			})
+		}
+	})
+
+	t.Run("non-loopback redirect", func(t *testing.T) {
+		opened := false
+		_, err := RunLocalCallback(context.Background(), newClient("https://example.com/callback"),
+			WithBrowserOpener(func(string) error { opened = true; return nil }))
+		if err == nil || !strings.Contains(err.Error(), "loopback") {
+			t.Errorf("error = %v, want loopback error", err)
+		}
+		if opened {
+			t.Error("browser opened for invalid redirect URL")
+		}
+	})
+
+	t.Run("browser error", func(t *testing.T) {
+		_, err := RunLocalCallback(context.Background(), newClient("http://localhost/callback"),
+			WithBrowserOpener(func(string) error { return errors.New("no browser") }))
+		if err == nil || !strings.Contains(err.Error(), "opening browser") {
+			t.Errorf("error = %v, want browser error", err)
+		}
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := RunLocalCallback(ctx, newClient("http://localhost/callback"),
+			WithCallbackPort(0),
+			WithBrowserOpener(func(string) error { return nil }))
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// MissingConfigError indicates that required OAuth settings were not found.
+// Missing lists every absent setting, so all of them can be fixed at once.
+type MissingConfigError struct {
+	Source  string   // Where the settings were read from, e.g. "environment"
+	Missing []string // Names of the missing settings, e.g. "YOUTUBE_CLIENT_ID"
+}
+
+// Error implements the error interface.
+func (e *MissingConfigError) Error() string {
+	return fmt.Sprintf("auth error: %s is missing %s", e.Source, strings.Join(e.Missing, ", "))
+}
+
+// ConfigFromEnv reads an OAuth configuration from environment variables
+// named with prefix:
+//
+//   - <prefix>_CLIENT_ID (required)
+//   - <prefix>_CLIENT_SECRET (required)
+//   - <prefix>_REDIRECT_URL
+//   - <prefix>_SCOPES: scopes separated by commas or spaces
+//
+// For example, ConfigFromEnv("YOUTUBE") reads YOUTUBE_CLIENT_ID. An empty
+// prefix reads CLIENT_ID and so on. If any required variable is unset or
+// empty, a *MissingConfigError names all of them.
+//
+// Settings not found in the environment are left empty, so callers can
+// fill them in before use:
+//
+//	config, err := auth.ConfigFromEnv("YOUTUBE")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	config.RedirectURL = "http://localhost:8080/callback"
+//	authClient := auth.NewAuthClient(config)
+func ConfigFromEnv(prefix string) (Config, error) {
+	if prefix != "" && !strings.HasSuffix(prefix, "_") {
+		prefix += "_"
+	}
+
+	var missing []string
+	required := func(name string) string {
+		v := strings.TrimSpace(os.Getenv(prefix + name))
+		if v == "" {
+			missing = append(missing, prefix+name)
+		}
+		return v
+	}
+
+	config := Config{
+		ClientID:     required("CLIENT_ID"),
+		ClientSecret: required("CLIENT_SECRET"),
+		RedirectURL:  strings.TrimSpace(os.Getenv(prefix + "REDIRECT_URL")),
+		Scopes: strings.FieldsFunc(os.Getenv(prefix+"SCOPES"), func(r rune) bool {
+			return r == ',' || r == ' '
+		}),
+	}
+	if len(missing) > 0 {
+		return Config{}, &MissingConfigError{Source: "environment", Missing: missing}
+	}
+	return config, nil
+}
+
+// clientSecrets is the OAuth client entry of a client_secret.json file.
+type clientSecrets struct {
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	RedirectURIs []string `json:"redirect_uris"`
+	AuthURI      string   `json:"auth_uri"`
+	TokenURI     string   `json:"token_uri"`
+}
+
+// ConfigFromClientSecretsJSON parses the client_secret.json file downloaded
+// from the Google Cloud Console for an OAuth client of type "Desktop app"
+// (the "installed" section) or "Web application" (the "web" section).
+//
+// RedirectURL is set to the first registered redirect URI, if any; the
+// endpoint URLs are taken from the file. Scopes are not part of the file
+// and must be set by the caller:
+//
+//	data, err := os.ReadFile("client_secret.json")
+//	config, err := auth.ConfigFromClientSecretsJSON(data)
+//	config.Scopes = []string{auth.ScopeReadOnly}
+//
+// A missing client_id or client_secret returns a *MissingConfigError.
+func ConfigFromClientSecretsJSON(data []byte) (Config, error) {
+	var file struct {
+		Installed *clientSecrets `json:"installed"`
+		Web       *clientSecrets `json:"web"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return Config{}, fmt.Errorf("parsing client secrets JSON: %w", err)
+	}
+
+	secrets := file.Installed
+	section := "installed"
+	if secrets == nil {
+		secrets, section = file.Web, "web"
+	}
+	if secrets == nil {
+		return Config{}, fmt.Errorf(`parsing client secrets JSON: no "installed" or "web" client found`)
+	}
+
+	var missing []string
+	if secrets.ClientID == "" {
+		missing = append(missing, section+".client_id")
+	}
+	if secrets.ClientSecret == "" {
+		missing = append(missing, section+".client_secret")
+	}
+	if len(missing) > 0 {
+		return Config{}, &MissingConfigError{Source: "client secrets JSON", Missing: missing}
+	}
+
+	config := Config{
+		ClientID:     secrets.ClientID,
+		ClientSecret: secrets.ClientSecret,
+		AuthURL:      secrets.AuthURI,
+		TokenURL:     secrets.TokenURI,
+	}
+	if len(secrets.RedirectURIs) > 0 {
+		config.RedirectURL = secrets.RedirectURIs[0]
+	}
+	return config, nil
+}
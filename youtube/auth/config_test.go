@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		env         map[string]string
+		want        Config
+		wantMissing []string
+	}{
+		{
+			name:   "all settings",
+			prefix: "YOUTUBE",
+			env: map[string]string{
+				"YOUTUBE_CLIENT_ID":     "id",
+				"YOUTUBE_CLIENT_SECRET": "secret",
+				"YOUTUBE_REDIRECT_URL":  "http://localhost:8080/callback",
+				"YOUTUBE_SCOPES":        ScopeReadOnly + ", " + ScopeLiveChat,
+			},
+			want: Config{
+				ClientID:     "id",
+				ClientSecret: "secret",
+				RedirectURL:  "http://localhost:8080/callback",
+				Scopes:       []string{ScopeReadOnly, ScopeLiveChat},
+			},
+		},
+		{
+			name:   "prefix with underscore",
+			prefix: "YT_",
+			env:    map[string]string{"YT_CLIENT_ID": "id", "YT_CLIENT_SECRET": "secret"},
+			want:   Config{ClientID: "id", ClientSecret: "secret"},
+		},
+		{
+			name:   "no prefix",
+			prefix: "",
+			env:    map[string]string{"CLIENT_ID": "id", "CLIENT_SECRET": "secret"},
+			want:   Config{ClientID: "id", ClientSecret: "secret"},
+		},
+		{
+			name:        "missing secret",
+			prefix:      "YOUTUBE",
+			env:         map[string]string{"YOUTUBE_CLIENT_ID": "id"},
+			wantMissing: []string{"YOUTUBE_CLIENT_SECRET"},
+		},
+		{
+			name:        "missing both",
+			prefix:      "YOUTUBE",
+			env:         map[string]string{"YOUTUBE_CLIENT_ID": "  "},
+			wantMissing: []string{"YOUTUBE_CLIENT_ID", "YOUTUBE_CLIENT_SECRET"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"CLIENT_ID", "CLIENT_SECRET", "REDIRECT_URL", "SCOPES"} {
+				t.Setenv(name, "")
+				t.Setenv("YOUTUBE_"+name, "")
+				t.Setenv("YT_"+name, "")
+			}
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			got, err := ConfigFromEnv(tt.prefix)
+			if tt.wantMissing != nil {
+				var missingErr *MissingConfigError
+				if !errors.As(err, &missingErr) {
+					t.Fatalf("error = %v, want *MissingConfigError", err)
+				}
+				if !slices.Equal(missingErr.Missing, tt.wantMissing) {
+					t.Errorf("Missing = %v, want %v", missingErr.Missing, tt.wantMissing)
+				}
+				for _, name := range tt.wantMissing {
+					if !strings.Contains(err.Error(), name) {
+						t.Errorf("error %q does not mention %s", err, name)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConfigFromEnv() error = %v", err)
+			}
+			if got.ClientID != tt.want.ClientID || got.ClientSecret != tt.want.ClientSecret ||
+				got.RedirectURL != tt.want.RedirectURL || !slices.Equal(got.Scopes, tt.want.Scopes) {
+				t.Errorf("ConfigFromEnv() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigFromClientSecretsJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		json        string
+		want        Config
+		wantMissing []string
+		wantErr     bool
+	}{
+		{
+			name: "installed app",
+			json: `{"installed":{"client_id":"id.apps.googleusercontent.com","project_id":"p","auth_uri":"https://accounts.google.com/o/oauth2/auth","token_uri":"https://oauth2.googleapis.com/token","client_secret":"secret","redirect_uris":["http://localhost"]}}`,
+			want: Config{
+				ClientID:     "id.apps.googleusercontent.com",
+				ClientSecret: "secret",
+				RedirectURL:  "http://localhost",
+				AuthURL:      "https://accounts.google.com/o/oauth2/auth",
+				TokenURL:     "https://oauth2.googleapis.com/token",
+			},
+		},
+		{
+			name: "web app without redirect URIs",
+			json: `{"web":{"client_id":"id","client_secret":"secret"}}`,
+			want: Config{ClientID: "id", ClientSecret: "secret"},
+		},
+		{
+			name:        "missing fields",
+			json:        `{"web":{"redirect_uris":["http://localhost"]}}`,
+			wantMissing: []string{"web.client_id", "web.client_secret"},
+		},
+		{
+			name:    "service account file",
+			json:    `{"type":"service_account","client_email":"svc@example.com"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			json:    `{`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConfigFromClientSecretsJSON([]byte(tt.json))
+			if tt.wantMissing != nil {
+				var missingErr *MissingConfigError
+				if !errors.As(err, &missingErr) {
+					t.Fatalf("error = %v, want *MissingConfigError", err)
+				}
+				if !slices.Equal(missingErr.Missing, tt.wantMissing) {
+					t.Errorf("Missing = %v, want %v", missingErr.Missing, tt.wantMissing)
+				}
+				return
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConfigFromClientSecretsJSON() error = %v", err)
+			}
+			if got.ClientID != tt.want.ClientID || got.ClientSecret != tt.want.ClientSecret ||
+				got.RedirectURL != tt.want.RedirectURL || got.AuthURL != tt.want.AuthURL ||
+				got.TokenURL != tt.want.TokenURL {
+				t.Errorf("ConfigFromClientSecretsJSON() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
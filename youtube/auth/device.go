@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
 )
 
 // Google Device Code endpoint.
@@ -151,14 +153,42 @@ func (c *DeviceClient) RequestDeviceCode(ctx context.Context) (*DeviceAuthRespon
 	return &authResp, nil
 }
 
+// slowDownIncrement is how much the polling interval grows on each slow_down
+// response (RFC 8628 section 3.5). A backoff may grow it further.
+const slowDownIncrement = 5 * time.Second
+
+// PollOption configures PollForToken.
+type PollOption func(*pollConfig)
+
+// pollConfig holds PollForToken settings.
+type pollConfig struct {
+	backoff *core.BackoffConfig
+}
+
+// WithPollBackoff sets the backoff used to lengthen the polling interval
+// when the server responds with slow_down, beyond the fixed 5 second
+// increment. Attempts are counted per slow_down response. As RFC 8628
+// requires, each slow_down still adds at least 5 seconds to the current
+// interval, whatever the backoff's base delay or jitter, and the increase
+// applies to all later polls.
+func WithPollBackoff(backoff *core.BackoffConfig) PollOption {
+	return func(p *pollConfig) { p.backoff = backoff }
+}
+
 // PollForToken polls the token endpoint until the user authorizes or the code expires.
 // This is a blocking call that handles the polling loop internally.
-func (c *DeviceClient) PollForToken(ctx context.Context, authResp *DeviceAuthResponse) (*Token, error) {
+func (c *DeviceClient) PollForToken(ctx context.Context, authResp *DeviceAuthResponse, opts ...PollOption) (*Token, error) {
 	if authResp == nil {
 		return nil, errors.New("device auth response is nil")
 	}
 
+	var cfg pollConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	interval := time.Duration(authResp.Interval) * time.Second
+	slowDowns := 0
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
@@ -184,7 +214,8 @@ func (c *DeviceClient) PollForToken(ctx context.Context, authResp *DeviceAuthRes
 					continue
 				case errSlowDown:
 					// Increase polling interval
-					interval += 5 * time.Second
+					interval = slowDownInterval(interval, cfg.backoff, slowDowns)
+					slowDowns++
 					ticker.Reset(interval)
 					continue
 				case errAccessDenied:
@@ -200,11 +231,22 @@ func (c *DeviceClient) PollForToken(ctx context.Context, authResp *DeviceAuthRes
 	}
 }
 
+// slowDownInterval returns the polling interval after the server asks the
+// client to slow down: the current interval plus slowDownIncrement, or the
+// backoff delay for the attempt if that is longer.
+func slowDownInterval(current time.Duration, backoff *core.BackoffConfig, attempt int) time.Duration {
+	next := current + slowDownIncrement
+	if backoff == nil {
+		return next
+	}
+	return max(backoff.Delay(attempt), next)
+}
+
 // PollForTokenAsync starts polling in a goroutine and returns channels for the result.
 // The token channel receives the token when authorized.
 // The error channel receives any error that occurs.
 // The cancel function stops the polling.
-func (c *DeviceClient) PollForTokenAsync(ctx context.Context, authResp *DeviceAuthResponse) (<-chan *Token, <-chan error, context.CancelFunc) {
+func (c *DeviceClient) PollForTokenAsync(ctx context.Context, authResp *DeviceAuthResponse, opts ...PollOption) (<-chan *Token, <-chan error, context.CancelFunc) {
 	tokenCh := make(chan *Token, 1)
 	errCh := make(chan error, 1)
 
@@ -214,7 +256,7 @@ func (c *DeviceClient) PollForTokenAsync(ctx context.Context, authResp *DeviceAu
 		defer close(tokenCh)
 		defer close(errCh)
 
-		token, err := c.PollForToken(pollCtx, authResp)
+		token, err := c.PollForToken(pollCtx, authResp, opts...)
 		if err != nil {
 			errCh <- err
 			return
@@ -9,6 +9,8 @@ import (
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
 )
 
 func TestNewDeviceClient(t *testing.T) {
@@ -285,6 +287,100 @@ func TestDeviceClient_PollForToken_SlowDown(t *testing.T) {
 	}
 }
 
+func TestDeviceClient_PollForToken_SlowDownBackoff(t *testing.T) {
+	var callCount atomic.Int32
+	var lastCall atomic.Int64
+	var minGap atomic.Int64
+	minGap.Store(int64(time.Hour))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now().UnixNano()
+		if prev := lastCall.Swap(now); prev != 0 && now-prev < minGap.Load() {
+			minGap.Store(now - prev)
+		}
+
+		if callCount.Add(1) <= 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "slow_down"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer server.Close()
+
+	client := NewDeviceClient(DeviceConfig{
+		ClientID: "test-client-id",
+		TokenURL: server.URL,
+	})
+
+	authResp := &DeviceAuthResponse{
+		DeviceCode: "test-device-code",
+		Interval:   1,
+		expiry:     time.Now().Add(30 * time.Minute),
+	}
+
+	// A backoff far faster than the slow_down increment must not speed up
+	// polling.
+	backoff := &core.BackoffConfig{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 2}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	token, err := client.PollForToken(ctx, authResp, WithPollBackoff(backoff))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.AccessToken != "test-access-token" {
+		t.Errorf("expected AccessToken %q, got %q", "test-access-token", token.AccessToken)
+	}
+	if callCount.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", callCount.Load())
+	}
+	if gap := time.Duration(minGap.Load()); gap < 5900*time.Millisecond {
+		t.Errorf("polled %v apart, want at least the 1s server interval plus 5s", gap)
+	}
+}
+
+func TestSlowDownInterval(t *testing.T) {
+	backoff := &core.BackoffConfig{
+		BaseDelay:  2 * time.Second,
+		MaxDelay:   20 * time.Second,
+		Multiplier: 2,
+		Jitter:     0.5,
+		RandFloat:  func() float64 { return 0 }, // maximum negative jitter
+	}
+
+	tests := []struct {
+		name    string
+		current time.Duration
+		backoff *core.BackoffConfig
+		attempt int
+		want    time.Duration
+	}{
+		{"default increment", 5 * time.Second, nil, 0, 10 * time.Second},
+		{"default increment accumulates", 10 * time.Second, nil, 1, 15 * time.Second},
+		{"backoff above floor", 1 * time.Second, backoff, 3, 8 * time.Second},
+		{"backoff capped by max delay", 1 * time.Second, backoff, 10, 10 * time.Second},
+		{"backoff below floor", 5 * time.Second, backoff, 0, 10 * time.Second},
+		{"backoff below accumulated floor", 15 * time.Second, backoff, 3, 20 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slowDownInterval(tt.current, tt.backoff, tt.attempt)
+			if got != tt.want {
+				t.Errorf("slowDownInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDeviceClient_PollForToken_AccessDenied(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
@@ -11,12 +11,30 @@
 //		Scopes:       []string{auth.ScopeLiveChat, auth.ScopeLiveChatModerate},
 //	})
 //
-//	// Generate authorization URL
-//	url := authClient.AuthorizationURL("state-token")
+//	// Generate authorization URL; keep state in the user's session
+//	state := auth.GenerateState()
+//	url := authClient.AuthorizationURL(state)
 //
-//	// Exchange code for token
+//	// In the callback, check the state before exchanging the code
+//	if !auth.VerifyState(state, r.URL.Query().Get("state")) {
+//		// reject: possible cross-site request forgery
+//	}
 //	token, err := authClient.Exchange(ctx, code)
 //
+// Load the client ID and secret from the environment (YOUTUBE_CLIENT_ID and
+// YOUTUBE_CLIENT_SECRET here) or from a downloaded client_secret.json file
+// with ConfigFromClientSecretsJSON:
+//
+//	config, err := auth.ConfigFromEnv("YOUTUBE")
+//	config.Scopes = []string{auth.ScopeLiveChat}
+//	authClient := auth.NewAuthClient(config)
+//
+// Command-line tools can run the whole flow with RunLocalCallback, which
+// serves the loopback redirect URL, opens the browser, verifies the state,
+// and exchanges the code:
+//
+//	token, err := auth.RunLocalCallback(ctx, authClient)
+//
 // # Device Code Flow
 //
 // For devices with limited input capabilities (TVs, consoles, CLI apps):
@@ -34,6 +52,12 @@
 //	// Poll for authorization
 //	token, err := deviceClient.PollForToken(ctx, authResp)
 //
+// When the server asks the client to slow down, the interval grows by 5
+// seconds. To back off exponentially when that is longer:
+//
+//	token, err := deviceClient.PollForToken(ctx, authResp,
+//		auth.WithPollBackoff(core.NewBackoffConfig()))
+//
 // # Service Account Authentication
 //
 // For server-to-server communication without user interaction:
@@ -65,6 +89,14 @@
 //		// Use token
 //	}
 //
+// A server that stored a refresh token can skip the interactive flow on
+// restart. The refresh token is checked with an initial refresh:
+//
+//	authClient, err := auth.NewAuthClientFromRefreshToken(ctx, config, storedRefreshToken)
+//	if err != nil {
+//		return err // e.g. revoked: an *AuthError with IsInvalidGrant
+//	}
+//
 // # Scopes
 //
 // Common YouTube API scopes:
@@ -74,4 +106,10 @@
 //   - ScopeReadOnly: Read-only access to YouTube data
 //   - ScopeUpload: Upload videos
 //   - ScopePartner: YouTube Analytics access
+//
+// When the granted scopes are known, CheckScopes reports a missing scope as
+// an InsufficientScopeError before a request is made:
+//
+//	err := auth.CheckScopes(authClient.GrantedScopes(), "liveChatBans.insert",
+//		auth.ScopeLiveChat, auth.ScopeLiveChatModerate)
 package auth
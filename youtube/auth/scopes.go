@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// InsufficientScopeError indicates that the granted OAuth scopes do not
+// include any scope required for an operation. It is returned before the
+// request is sent, instead of an opaque 403 from the server.
+type InsufficientScopeError struct {
+	Operation string   // API operation, e.g. "liveChatBans.insert"
+	Required  []string // Any one of these scopes is sufficient
+	Granted   []string // Scopes granted to the token
+}
+
+// Error implements the error interface.
+func (e *InsufficientScopeError) Error() string {
+	return fmt.Sprintf("auth error: %s requires one of scopes [%s], token has [%s]",
+		e.Operation, strings.Join(e.Required, " "), strings.Join(e.Granted, " "))
+}
+
+// CheckScopes reports whether granted includes at least one of the required
+// scopes for operation, returning an *InsufficientScopeError if not.
+//
+// The check is advisory: if granted is empty the scopes are treated as
+// unknown (some token sources don't report them) and nil is returned.
+func CheckScopes(granted []string, operation string, required ...string) error {
+	if len(granted) == 0 || len(required) == 0 {
+		return nil
+	}
+	for _, s := range required {
+		if slices.Contains(granted, s) {
+			return nil
+		}
+	}
+	return &InsufficientScopeError{
+		Operation: operation,
+		Required:  required,
+		Granted:   granted,
+	}
+}
+
+// GrantedScopes returns the scopes granted to the token, or nil if unknown.
+func (t *Token) GrantedScopes() []string {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return slices.Clone(t.Scopes)
+}
+
+// GrantedScopes returns the scopes granted to the current token, or nil if
+// there is no token or the token endpoint did not report scopes.
+func (c *AuthClient) GrantedScopes() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token.GrantedScopes()
+}
+
+// GrantedScopes returns the scopes granted to the current token, or nil if
+// no token has been fetched or the token endpoint did not report scopes.
+func (c *ServiceAccountClient) GrantedScopes() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token.GrantedScopes()
+}
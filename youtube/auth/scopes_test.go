@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCheckScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required []string
+		wantErr  bool
+	}{
+		{"exact scope", []string{ScopeLiveChat}, []string{ScopeLiveChat}, false},
+		{"any of required", []string{ScopeLiveChatModerate}, []string{ScopeLiveChat, ScopeLiveChatModerate}, false},
+		{"missing scope", []string{ScopeReadOnly}, []string{ScopeLiveChat, ScopeLiveChatModerate}, true},
+		{"unknown granted", nil, []string{ScopeLiveChat}, false},
+		{"nothing required", []string{ScopeReadOnly}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckScopes(tt.granted, "liveChatBans.insert", tt.required...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckScopes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+
+			var scopeErr *InsufficientScopeError
+			if !errors.As(err, &scopeErr) {
+				t.Fatalf("error type = %T, want *InsufficientScopeError", err)
+			}
+			if scopeErr.Operation != "liveChatBans.insert" {
+				t.Errorf("Operation = %q, want 'liveChatBans.insert'", scopeErr.Operation)
+			}
+			if !slices.Equal(scopeErr.Required, tt.required) {
+				t.Errorf("Required = %v, want %v", scopeErr.Required, tt.required)
+			}
+			if !strings.Contains(err.Error(), ScopeReadOnly) {
+				t.Errorf("Error() = %q, want granted scopes listed", err.Error())
+			}
+		})
+	}
+}
+
+func TestGrantedScopes(t *testing.T) {
+	t.Run("nil token", func(t *testing.T) {
+		var tok *Token
+		if got := tok.GrantedScopes(); got != nil {
+			t.Errorf("GrantedScopes() = %v, want nil", got)
+		}
+	})
+
+	t.Run("returns copy", func(t *testing.T) {
+		tok := &Token{Scopes: []string{ScopeLiveChat}}
+		got := tok.GrantedScopes()
+		got[0] = "modified"
+		if tok.Scopes[0] != ScopeLiveChat {
+			t.Error("GrantedScopes() should return a copy")
+		}
+	})
+
+	t.Run("auth client", func(t *testing.T) {
+		client := NewAuthClient(Config{ClientID: "client-id"})
+		if got := client.GrantedScopes(); got != nil {
+			t.Errorf("GrantedScopes() without token = %v, want nil", got)
+		}
+
+		client.SetToken(&Token{AccessToken: "token", Scopes: []string{ScopeLiveChat, ScopeReadOnly}})
+		want := []string{ScopeLiveChat, ScopeReadOnly}
+		if got := client.GrantedScopes(); !slices.Equal(got, want) {
+			t.Errorf("GrantedScopes() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("service account client", func(t *testing.T) {
+		client := &ServiceAccountClient{token: &Token{Scopes: []string{ScopeReadOnly}}}
+		if got := client.GrantedScopes(); !slices.Equal(got, []string{ScopeReadOnly}) {
+			t.Errorf("GrantedScopes() = %v, want [%s]", got, ScopeReadOnly)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+)
+
+// GenerateState returns a random value for the state parameter of an
+// authorization request: 32 bytes from crypto/rand, base64url-encoded.
+//
+// The state parameter protects the OAuth callback against cross-site
+// request forgery. Without it, an attacker can start a flow with their own
+// account and trick a user's browser into opening the callback with the
+// attacker's code; the application would then act on the attacker's
+// account while believing it is the user's (e.g., streaming to or
+// moderating the wrong channel). To prevent this, generate a fresh state
+// for each authorization request, store it where only that user's session
+// can read it (such as an HttpOnly cookie or server-side session), and
+// check the callback with VerifyState before exchanging the code. Discard
+// the state once it has been used.
+//
+// Predictable values such as timestamps or counters offer no protection.
+// GenerateState panics if the system's secure random source fails.
+func GenerateState() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic("auth: generating state: " + err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// VerifyState reports whether the state returned to the callback matches
+// the state sent with the authorization request. The comparison takes
+// constant time, so response timing does not reveal how much of a guess
+// was correct. An empty expected state never matches.
+func VerifyState(expected, got string) bool {
+	if expected == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(got)) == 1
+}
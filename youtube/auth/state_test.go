@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestGenerateState(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		state := GenerateState()
+		b, err := base64.RawURLEncoding.DecodeString(state)
+		if err != nil {
+			t.Fatalf("state %q is not base64url: %v", state, err)
+		}
+		if len(b) != 32 {
+			t.Errorf("state has %d random bytes, want 32", len(b))
+		}
+		if seen[state] {
+			t.Fatalf("duplicate state %q", state)
+		}
+		seen[state] = true
+	}
+}
+
+func TestVerifyState(t *testing.T) {
+	state := GenerateState()
+
+	tests := []struct {
+		name     string
+		expected string
+		got      string
+		want     bool
+	}{
+		{"match", state, state, true},
+		{"mismatch", state, GenerateState(), false},
+		{"prefix", state, state[:10], false},
+		{"longer", state, state + "x", false},
+		{"missing", state, "", false},
+		{"empty expected", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyState(tt.expected, tt.got); got != tt.want {
+				t.Errorf("VerifyState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
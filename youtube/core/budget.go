@@ -0,0 +1,125 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// QuotaBudgetExceededError is returned when a request would spend more
+// quota than the budget attached to its context allows. The request is not
+// sent.
+type QuotaBudgetExceededError struct {
+	Operation string // Operation that was refused (e.g., "search.list")
+	Cost      int    // Quota cost of the operation
+	Remaining int    // Units left in the budget
+	Limit     int    // Total units in the budget
+}
+
+// Error implements the error interface.
+func (e *QuotaBudgetExceededError) Error() string {
+	return fmt.Sprintf("youtube api: quota budget exceeded: %s costs %d units, %d of %d remaining",
+		e.Operation, e.Cost, e.Remaining, e.Limit)
+}
+
+// QuotaBudget is a hard cap on the quota units that requests made with a
+// context may spend. Create one with WithQuotaBudget.
+//
+// Costs are taken from QuotaCosts (1 unit for unknown operations) and are
+// charged atomically before each request is sent, including retries, so
+// concurrent requests sharing a context can never overspend.
+type QuotaBudget struct {
+	limit     int
+	remaining atomic.Int64
+	parent    *QuotaBudget
+}
+
+// quotaBudgetCtxKey is the context key for quota budgets.
+type quotaBudgetCtxKey struct{}
+
+// WithQuotaBudget returns a context that allows at most units quota units to
+// be spent by requests made with it. Requests that would exceed the budget
+// fail with *QuotaBudgetExceededError without being sent.
+//
+// Budgets nest: a budget derived from a context that already carries one
+// charges both, so a per-request budget cannot exceed a per-tenant budget.
+//
+//	ctx = core.WithQuotaBudget(ctx, 50)
+//	video, err := data.GetVideo(ctx, client, id) // spends 1 unit
+func WithQuotaBudget(ctx context.Context, units int) context.Context {
+	b := &QuotaBudget{
+		limit:  units,
+		parent: QuotaBudgetFromContext(ctx),
+	}
+	b.remaining.Store(int64(units))
+	return context.WithValue(ctx, quotaBudgetCtxKey{}, b)
+}
+
+// QuotaBudgetFromContext returns the innermost quota budget attached to ctx,
+// or nil if there is none.
+func QuotaBudgetFromContext(ctx context.Context) *QuotaBudget {
+	if ctx == nil {
+		return nil
+	}
+	b, _ := ctx.Value(quotaBudgetCtxKey{}).(*QuotaBudget)
+	return b
+}
+
+// Limit returns the total units in the budget.
+func (b *QuotaBudget) Limit() int {
+	return b.limit
+}
+
+// Remaining returns the units left in the budget.
+func (b *QuotaBudget) Remaining() int {
+	return int(b.remaining.Load())
+}
+
+// Used returns the units spent from the budget.
+func (b *QuotaBudget) Used() int {
+	return b.limit - b.Remaining()
+}
+
+// Spend charges the cost of operation to the budget and any enclosing
+// budgets. If any budget lacks the units, nothing is charged and a
+// *QuotaBudgetExceededError is returned.
+func (b *QuotaBudget) Spend(operation string) error {
+	cost := OperationCost(operation)
+
+	for cur := b; cur != nil; cur = cur.parent {
+		if !cur.take(int64(cost)) {
+			// Refund budgets already charged.
+			for r := b; r != cur; r = r.parent {
+				r.remaining.Add(int64(cost))
+			}
+			return &QuotaBudgetExceededError{
+				Operation: operation,
+				Cost:      cost,
+				Remaining: cur.Remaining(),
+				Limit:     cur.limit,
+			}
+		}
+	}
+	return nil
+}
+
+// take atomically removes n units if available.
+func (b *QuotaBudget) take(n int64) bool {
+	for {
+		remaining := b.remaining.Load()
+		if remaining < n {
+			return false
+		}
+		if b.remaining.CompareAndSwap(remaining, remaining-n) {
+			return true
+		}
+	}
+}
+
+// spendQuotaBudget charges the budget in ctx, if any, for operation.
+func spendQuotaBudget(ctx context.Context, operation string) error {
+	if b := QuotaBudgetFromContext(ctx); b != nil {
+		return b.Spend(operation)
+	}
+	return nil
+}
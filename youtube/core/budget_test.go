@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestQuotaBudget_Spend(t *testing.T) {
+	tests := []struct {
+		name          string
+		units         int
+		ops           []string
+		wantErrAt     int // index of first failing op, -1 for none
+		wantRemaining int
+	}{
+		{"within budget", 10, []string{"videos.list", "channels.list"}, -1, 8},
+		{"exact budget", 50, []string{"liveChatMessages.insert"}, -1, 0},
+		{"exceeds budget", 100, []string{"videos.list", "search.list"}, 1, 99},
+		{"unknown operation costs 1", 1, []string{"unknown.op", "unknown.op"}, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := QuotaBudgetFromContext(WithQuotaBudget(context.Background(), tt.units))
+
+			for i, op := range tt.ops {
+				err := b.Spend(op)
+				if i == tt.wantErrAt {
+					var budgetErr *QuotaBudgetExceededError
+					if !errors.As(err, &budgetErr) {
+						t.Fatalf("Spend(%s) error = %v, want *QuotaBudgetExceededError", op, err)
+					}
+					if budgetErr.Operation != op || budgetErr.Limit != tt.units {
+						t.Errorf("error = %+v", budgetErr)
+					}
+					break
+				}
+				if err != nil {
+					t.Fatalf("Spend(%s) unexpected error: %v", op, err)
+				}
+			}
+
+			if b.Remaining() != tt.wantRemaining {
+				t.Errorf("Remaining() = %d, want %d", b.Remaining(), tt.wantRemaining)
+			}
+			if b.Used() != tt.units-tt.wantRemaining {
+				t.Errorf("Used() = %d, want %d", b.Used(), tt.units-tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestQuotaBudget_Nested(t *testing.T) {
+	tenant := WithQuotaBudget(context.Background(), 60)
+	request := WithQuotaBudget(tenant, 100)
+
+	outer := QuotaBudgetFromContext(tenant)
+	inner := QuotaBudgetFromContext(request)
+
+	if err := inner.Spend("liveChatMessages.insert"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outer.Remaining() != 10 || inner.Remaining() != 50 {
+		t.Errorf("remaining = outer %d, inner %d; want 10, 50", outer.Remaining(), inner.Remaining())
+	}
+
+	// Inner has room but the enclosing budget does not; nothing is charged.
+	err := inner.Spend("liveChatMessages.insert")
+	var budgetErr *QuotaBudgetExceededError
+	if !errors.As(err, &budgetErr) || budgetErr.Limit != 60 {
+		t.Fatalf("error = %v, want outer budget exceeded", err)
+	}
+	if outer.Remaining() != 10 || inner.Remaining() != 50 {
+		t.Errorf("failed spend changed budgets: outer %d, inner %d", outer.Remaining(), inner.Remaining())
+	}
+}
+
+func TestQuotaBudget_Concurrent(t *testing.T) {
+	b := QuotaBudgetFromContext(WithQuotaBudget(context.Background(), 100))
+
+	var ok atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if b.Spend("videos.list") == nil {
+				ok.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if ok.Load() != 100 {
+		t.Errorf("successful spends = %d, want 100", ok.Load())
+	}
+	if b.Remaining() != 0 {
+		t.Errorf("Remaining() = %d, want 0", b.Remaining())
+	}
+}
+
+func TestClient_QuotaBudget(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := WithQuotaBudget(context.Background(), 101)
+
+	if err := client.Get(ctx, "search", nil, "search.list", nil); err != nil {
+		t.Fatalf("first search: unexpected error: %v", err)
+	}
+	if err := client.Get(ctx, "videos", nil, "videos.list", nil); err != nil {
+		t.Fatalf("videos.list: unexpected error: %v", err)
+	}
+
+	err := client.Get(ctx, "search", nil, "search.list", nil)
+	var budgetErr *QuotaBudgetExceededError
+	if !errors.As(err, &budgetErr) {
+		t.Fatalf("error = %v, want *QuotaBudgetExceededError", err)
+	}
+	if budgetErr.Cost != 100 || budgetErr.Remaining != 0 {
+		t.Errorf("error = %+v, want cost 100, remaining 0", budgetErr)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests sent = %d, want 2", requests.Load())
+	}
+
+	// Requests without a budget are unaffected.
+	if err := client.Get(context.Background(), "search", nil, "search.list", nil); err != nil {
+		t.Errorf("unbudgeted request: unexpected error: %v", err)
+	}
+}
+
+func TestQuotaBudgetFromContext_None(t *testing.T) {
+	if QuotaBudgetFromContext(context.Background()) != nil {
+		t.Error("expected nil budget")
+	}
+}
@@ -30,13 +30,23 @@ const (
 
 // Client is an HTTP client for the YouTube API.
 type Client struct {
-	httpClient   *http.Client
-	baseURL      string
-	userAgent    string
-	quotaTracker *QuotaTracker
-	tokenMu      sync.RWMutex
-	accessToken  string
-	apiKey       string
+	httpClient    *http.Client
+	baseURL       string
+	userAgent     string
+	quotaTracker  *QuotaTracker
+	quotaObserver QuotaObserver
+	tokenMu       sync.RWMutex
+	accessToken   string
+	apiKey        string
+
+	autoIdempotencyKeys bool
+	middleware          Middleware
+	rawResponseCapture  func(method, path string, body []byte)
+	dryRun              bool
+	dryRunFunc          func(*http.Request)
+
+	compression      bool
+	compressionStats compressionCounters
 }
 
 // ClientOption configures a Client.
@@ -75,16 +85,60 @@ func WithQuotaTracker(qt *QuotaTracker) ClientOption {
 	return func(c *Client) { c.quotaTracker = qt }
 }
 
+// WithQuotaObserver sets an observer notified with the operation and quota
+// cost of every request, including SSE connections made by the streaming
+// package. See QuotaObserver.
+func WithQuotaObserver(o QuotaObserver) ClientOption {
+	return func(c *Client) { c.quotaObserver = o }
+}
+
 // WithAccessToken sets the OAuth access token for authentication.
 func WithAccessToken(token string) ClientOption {
 	return func(c *Client) { c.accessToken = token }
 }
 
-// WithAPIKey sets the API key for authentication.
+// WithAPIKey sets the API key for authentication. The key is sent as the
+// key query parameter on requests without an access token; once an access
+// token is set (WithAccessToken or SetAccessToken) the key is omitted.
+// LoggingMiddleware redacts the key by default (see DefaultRedactedFields).
 func WithAPIKey(key string) ClientOption {
 	return func(c *Client) { c.apiKey = key }
 }
 
+// WithAutoIdempotencyKeys enables automatic idempotency keys for write
+// requests. When enabled, POST and PUT requests without a key (on the
+// Request or in the context) are assigned a generated one.
+// See IdempotencyKeyHeader for the caveats on server-side deduplication.
+func WithAutoIdempotencyKeys(enabled bool) ClientOption {
+	return func(c *Client) { c.autoIdempotencyKeys = enabled }
+}
+
+// WithMiddleware sets middleware that wraps every request made by Do.
+// Multiple middlewares are composed with MiddlewareChain. To adjust
+// middleware at runtime, pass a MiddlewareStack's Middleware().
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) {
+		if len(middlewares) == 1 {
+			c.middleware = middlewares[0]
+		} else if len(middlewares) > 1 {
+			c.middleware = MiddlewareChain(middlewares...)
+		}
+	}
+}
+
+// WithRawResponseCapture calls fn with the raw body of every API response,
+// including error responses, before it is decoded. Use it to diagnose
+// unexpected response shapes.
+//
+// Response bodies can contain personal data (e.g., chat messages, channel
+// details), so only enable this while debugging and take care where the
+// bytes are logged. fn must not modify body and is called synchronously,
+// possibly from multiple goroutines. The path excludes the query string so
+// that API keys are not exposed.
+func WithRawResponseCapture(fn func(method, path string, body []byte)) ClientOption {
+	return func(c *Client) { c.rawResponseCapture = fn }
+}
+
 // SetAccessToken updates the access token (for token refresh).
 // This method is safe for concurrent use.
 func (c *Client) SetAccessToken(token string) {
@@ -105,6 +159,22 @@ func (c *Client) QuotaTracker() *QuotaTracker {
 	return c.quotaTracker
 }
 
+// RecordQuota records one call of operation on the client's quota tracker
+// and quota observer. Requests made with Do are recorded automatically;
+// packages that send their own HTTP requests (such as SSE streaming) call
+// RecordQuota directly.
+func (c *Client) RecordQuota(operation string) {
+	if operation == "" {
+		return
+	}
+	if c.quotaTracker != nil {
+		c.quotaTracker.Add(operation, 1)
+	}
+	if c.quotaObserver != nil {
+		c.quotaObserver.ObserveQuota(operation, OperationCost(operation))
+	}
+}
+
 // Request represents an API request.
 type Request struct {
 	Method    string
@@ -112,34 +182,73 @@ type Request struct {
 	Query     url.Values
 	Body      any
 	Operation string // For quota tracking (e.g., "videos.list")
+
+	// IdempotencyKey is sent in the Idempotency-Key header when set.
+	// Reuse the same Request across retries so every attempt carries the same key.
+	IdempotencyKey string
 }
 
 // Do executes an HTTP request and decodes the response.
 func (c *Client) Do(ctx context.Context, req *Request, result any) error {
+	ctx = ensureCorrelationID(ctx)
+
+	if mask := Fields(ctx); mask != "" {
+		if err := ValidateFields(mask); err != nil {
+			return err
+		}
+	}
+
+	if c.autoIdempotencyKeys || IdempotencyKeyFromContext(ctx) != "" {
+		ensureIdempotencyKey(ctx, req)
+	}
+
+	if c.middleware != nil {
+		return c.middleware(ctx, req, func(ctx context.Context, req *Request) error {
+			return c.do(ctx, req, result)
+		})
+	}
+	return c.do(ctx, req, result)
+}
+
+// do executes a single HTTP request attempt.
+func (c *Client) do(ctx context.Context, req *Request, result any) error {
+	if c.dryRun {
+		httpReq, err := c.newRequest(ctx, req)
+		if err != nil {
+			return fmt.Errorf("creating request: %w", err)
+		}
+		return c.dryRunRequest(httpReq)
+	}
+
+	if err := spendQuotaBudget(ctx, req.Operation); err != nil {
+		return err
+	}
+
+	ctx, httpClient, cancel := applyRequestTimeout(ctx, c.httpClient)
+	defer cancel()
+
 	httpReq, err := c.newRequest(ctx, req)
 	if err != nil {
 		return fmt.Errorf("creating request: %w", err)
 	}
 
-	resp, err := c.httpClient.Do(httpReq)
+	resp, err := httpClient.Do(httpReq)
 	if err != nil {
 		return fmt.Errorf("executing request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Track quota usage
-	if c.quotaTracker != nil && req.Operation != "" {
-		c.quotaTracker.Add(req.Operation, 1)
-	}
+	c.RecordQuota(req.Operation)
 
 	// Read response body with size limit to prevent memory exhaustion
-	limitedReader := io.LimitReader(resp.Body, MaxResponseBodySize+1)
-	body, err := io.ReadAll(limitedReader)
+	body, err := c.readBody(resp)
 	if err != nil {
-		return fmt.Errorf("reading response: %w", err)
+		return err
 	}
-	if len(body) > MaxResponseBodySize {
-		return fmt.Errorf("response body exceeds maximum size of %d bytes", MaxResponseBodySize)
+
+	if c.rawResponseCapture != nil {
+		c.rawResponseCapture(req.Method, req.Path, body)
 	}
 
 	// Handle error responses
@@ -215,6 +324,11 @@ func (c *Client) newRequest(ctx context.Context, req *Request) (*http.Request, e
 		}
 	}
 
+	// Add field mask from the context unless the request sets its own
+	if mask := Fields(ctx); mask != "" && !query.Has("fields") {
+		query.Set("fields", mask)
+	}
+
 	// Add API key if set and no access token
 	accessToken := c.getAccessToken()
 	if c.apiKey != "" && accessToken == "" {
@@ -250,6 +364,14 @@ func (c *Client) newRequest(ctx context.Context, req *Request) (*http.Request, e
 		httpReq.Header.Set("Authorization", "Bearer "+accessToken)
 	}
 
+	if req.IdempotencyKey != "" {
+		httpReq.Header.Set(IdempotencyKeyHeader, req.IdempotencyKey)
+	}
+
+	if c.compression {
+		httpReq.Header.Set("Accept-Encoding", "gzip")
+	}
+
 	return httpReq, nil
 }
 
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"slices"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -252,6 +254,36 @@ func TestClient_APIKeyNotAddedWithAccessToken(t *testing.T) {
 	}
 }
 
+func TestClient_APIKeyDroppedAfterSetAccessToken(t *testing.T) {
+	var gotKey, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.URL.Query().Get("key")
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithAPIKey("my-api-key"),
+	)
+
+	if err := c.Get(context.Background(), "/test", nil, "", nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if gotKey != "my-api-key" || gotAuth != "" {
+		t.Errorf("before SetAccessToken: key = %q, Authorization = %q", gotKey, gotAuth)
+	}
+
+	c.SetAccessToken("my-access-token")
+	if err := c.Get(context.Background(), "/test", nil, "", nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if gotKey != "" || gotAuth != "Bearer my-access-token" {
+		t.Errorf("after SetAccessToken: key = %q, Authorization = %q", gotKey, gotAuth)
+	}
+}
+
 func TestClient_QuotaTracking(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -277,6 +309,35 @@ func TestClient_QuotaTracking(t *testing.T) {
 	}
 }
 
+func TestClient_QuotaObserver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	type call struct {
+		operation string
+		cost      int
+	}
+	var calls []call
+	c := NewClient(
+		WithBaseURL(server.URL),
+		WithQuotaObserver(QuotaObserverFunc(func(operation string, cost int) {
+			calls = append(calls, call{operation, cost})
+		})),
+	)
+
+	_ = c.Get(context.Background(), "/search", nil, "search.list", nil)
+	_ = c.Get(context.Background(), "/custom", nil, "custom.op", nil)
+	_ = c.Get(context.Background(), "/untracked", nil, "", nil)
+	c.RecordQuota("liveChatMessages.streamList")
+
+	want := []call{{"search.list", 100}, {"custom.op", 1}, {"liveChatMessages.streamList", 5}}
+	if !slices.Equal(calls, want) {
+		t.Errorf("observed %v, want %v", calls, want)
+	}
+}
+
 func TestClient_ErrorResponse_APIError(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -452,3 +513,102 @@ func TestClient_quotaLimit_WithTracker(t *testing.T) {
 		t.Errorf("quotaLimit() = %d, want 5000", c.quotaLimit())
 	}
 }
+
+func TestClient_WithMiddleware(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":{"code":429,"message":"rate limited","errors":[{"reason":"rateLimitExceeded"}]}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"ok"}`))
+	}))
+	defer server.Close()
+
+	var seen []string
+	stack := NewMiddlewareStack()
+	_ = stack.Use("trace", func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
+		seen = append(seen, req.Operation)
+		return next(ctx, req)
+	})
+	_ = stack.Use("retry", NewRetryMiddleware(WithRetryBackoff(&BackoffConfig{
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   time.Millisecond,
+		Multiplier: 1,
+		RandFloat:  func() float64 { return 0.5 },
+	})))
+
+	c := NewClient(WithBaseURL(server.URL), WithMiddleware(stack.Middleware()))
+
+	var result struct{ ID string }
+	if err := c.Get(context.Background(), "/test", nil, "videos.list", &result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if result.ID != "ok" {
+		t.Errorf("ID = %q, want ok", result.ID)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("server calls = %d, want 2", calls.Load())
+	}
+	if len(seen) != 1 || seen[0] != "videos.list" {
+		t.Errorf("seen = %v, want [videos.list]", seen)
+	}
+
+	// Removing at runtime applies to the next request
+	stack.Remove("trace")
+	_ = c.Get(context.Background(), "/test", nil, "videos.list", nil)
+	if len(seen) != 1 {
+		t.Errorf("seen = %v after Remove, want 1 entry", seen)
+	}
+}
+
+func TestClient_WithRawResponseCapture(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{"success", http.StatusOK, `{"items":[{"id":"v1","unexpected":true}]}`, false},
+		{"api error", http.StatusBadRequest, `{"error":{"code":400,"message":"bad","errors":[{"reason":"invalid"}]}}`, true},
+		{"malformed", http.StatusOK, `{"items": [`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			var gotMethod, gotPath, gotBody string
+			client := NewClient(
+				WithBaseURL(server.URL),
+				WithAPIKey("secret-key"),
+				WithRawResponseCapture(func(method, path string, body []byte) {
+					gotMethod, gotPath, gotBody = method, path, string(body)
+				}),
+			)
+
+			var result map[string]any
+			err := client.Get(context.Background(), "videos", url.Values{"id": {"v1"}}, "videos.list", &result)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotMethod != http.MethodGet {
+				t.Errorf("method = %q, want GET", gotMethod)
+			}
+			if gotPath != "videos" {
+				t.Errorf("path = %q, want 'videos'", gotPath)
+			}
+			if gotBody != tt.body {
+				t.Errorf("body = %q, want %q", gotBody, tt.body)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// WithCompression requests gzip-compressed responses and decompresses them,
+// reducing bandwidth for large responses such as search results and
+// playlist pages. Savings are reported by Client.CompressionStats.
+//
+// Go's default transport already negotiates gzip on its own, but only when
+// the request sets no Accept-Encoding header, and it does not report the
+// bytes saved. With this option the client sets the header itself, so the
+// transport leaves decompression to the client; responses the transport
+// has decompressed anyway (e.g., a custom transport) are not decompressed
+// twice.
+func WithCompression(enabled bool) ClientOption {
+	return func(c *Client) { c.compression = enabled }
+}
+
+// CompressionStats reports bandwidth used by responses received with
+// WithCompression enabled.
+type CompressionStats struct {
+	// Responses is the number of responses read.
+	Responses int64
+
+	// CompressedResponses is the number of responses that arrived gzipped.
+	CompressedResponses int64
+
+	// WireBytes is the number of body bytes received over the network.
+	WireBytes int64
+
+	// DecodedBytes is the number of body bytes after decompression.
+	DecodedBytes int64
+}
+
+// SavedBytes returns the bytes saved by compression.
+func (s CompressionStats) SavedBytes() int64 {
+	return s.DecodedBytes - s.WireBytes
+}
+
+// compressionCounters accumulates CompressionStats.
+type compressionCounters struct {
+	responses           atomic.Int64
+	compressedResponses atomic.Int64
+	wireBytes           atomic.Int64
+	decodedBytes        atomic.Int64
+}
+
+// CompressionStats returns the bandwidth used by responses since the client
+// was created. All counts are zero unless WithCompression is enabled.
+func (c *Client) CompressionStats() CompressionStats {
+	return CompressionStats{
+		Responses:           c.compressionStats.responses.Load(),
+		CompressedResponses: c.compressionStats.compressedResponses.Load(),
+		WireBytes:           c.compressionStats.wireBytes.Load(),
+		DecodedBytes:        c.compressionStats.decodedBytes.Load(),
+	}
+}
+
+// readBody reads a response body up to MaxResponseBodySize, decompressing
+// it if compression is enabled and the response is gzipped. The size limit
+// applies to the decompressed body.
+func (c *Client) readBody(resp *http.Response) ([]byte, error) {
+	var wire countingReader
+	wire.r = resp.Body
+	var r io.Reader = &wire
+
+	gzipped := c.compression && !resp.Uncompressed &&
+		strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip")
+	if gzipped {
+		zr, err := gzip.NewReader(&wire)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing response: %w", err)
+		}
+		defer func() { _ = zr.Close() }()
+		r = zr
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r, MaxResponseBodySize+1))
+	if err != nil {
+		if gzipped {
+			return nil, fmt.Errorf("decompressing response: %w", err)
+		}
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+	if len(body) > MaxResponseBodySize {
+		return nil, fmt.Errorf("response body exceeds maximum size of %d bytes", MaxResponseBodySize)
+	}
+
+	if c.compression {
+		c.compressionStats.responses.Add(1)
+		if gzipped {
+			c.compressionStats.compressedResponses.Add(1)
+		}
+		c.compressionStats.wireBytes.Add(wire.n)
+		c.compressionStats.decodedBytes.Add(int64(len(body)))
+	}
+	return body, nil
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// gzipJSON compresses body.
+func gzipJSON(t *testing.T, body string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write([]byte(body))
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
+func TestWithCompression(t *testing.T) {
+	payload := `{"items":["` + strings.Repeat("abcdefgh", 1000) + `"]}`
+
+	tests := []struct {
+		name           string
+		compression    bool
+		serverGzips    bool
+		wantHeader     string
+		wantCompressed int64
+		wantResponses  int64
+	}{
+		{"enabled, gzipped response", true, true, "gzip", 1, 1},
+		{"enabled, plain response", true, false, "gzip", 0, 1},
+		{"disabled", false, true, "gzip", 0, 0}, // transport negotiates on its own
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Accept-Encoding"); got != tt.wantHeader {
+					t.Errorf("Accept-Encoding = %q, want %q", got, tt.wantHeader)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if tt.serverGzips && strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+					w.Header().Set("Content-Encoding", "gzip")
+					_, _ = w.Write(gzipJSON(t, payload))
+					return
+				}
+				_, _ = io.WriteString(w, payload)
+			}))
+			defer server.Close()
+
+			client := NewClient(WithBaseURL(server.URL), WithCompression(tt.compression))
+
+			var result struct{ Items []string }
+			if err := client.Get(context.Background(), "search", nil, "search.list", &result); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if len(result.Items) != 1 || len(result.Items[0]) != 8000 {
+				t.Errorf("decoded %d items, want 1 item of 8000 bytes", len(result.Items))
+			}
+
+			stats := client.CompressionStats()
+			if stats.Responses != tt.wantResponses || stats.CompressedResponses != tt.wantCompressed {
+				t.Errorf("stats = %+v, want %d responses, %d compressed", stats, tt.wantResponses, tt.wantCompressed)
+			}
+			if tt.wantCompressed > 0 {
+				if stats.DecodedBytes != int64(len(payload)) || stats.SavedBytes() <= 0 {
+					t.Errorf("stats = %+v, want %d decoded bytes and savings", stats, len(payload))
+				}
+			}
+			if tt.compression && tt.wantCompressed == 0 && stats.SavedBytes() != 0 {
+				t.Errorf("SavedBytes() = %d for plain response, want 0", stats.SavedBytes())
+			}
+		})
+	}
+}
+
+func TestWithCompression_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write(gzipJSON(t, `{"error":{"code":404,"message":"Video not found"}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithCompression(true))
+	err := client.Get(context.Background(), "videos", nil, "videos.list", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "Video not found" {
+		t.Errorf("error = %v, want decoded APIError", err)
+	}
+}
+
+func TestWithCompression_CorruptBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = io.WriteString(w, "not gzip")
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithCompression(true))
+	err := client.Get(context.Background(), "videos", nil, "videos.list", nil)
+	if err == nil || !strings.Contains(err.Error(), "decompressing response") {
+		t.Errorf("error = %v, want decompression error", err)
+	}
+}
+
+// decompressingTransport decompresses responses itself, like a transport
+// that handles gzip on its own.
+type decompressingTransport struct{}
+
+func (decompressingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode:   http.StatusOK,
+		Header:       http.Header{"Content-Encoding": {"gzip"}},
+		Body:         io.NopCloser(strings.NewReader(`{"id":"abc"}`)),
+		Uncompressed: true,
+		Request:      req,
+	}, nil
+}
+
+func TestWithCompression_AlreadyDecompressed(t *testing.T) {
+	client := NewClient(
+		WithHTTPClient(&http.Client{Transport: decompressingTransport{}}),
+		WithCompression(true),
+	)
+
+	var result struct{ ID string }
+	if err := client.Get(context.Background(), "videos", nil, "videos.list", &result); err != nil {
+		t.Fatalf("Get() error = %v (decompressed twice?)", err)
+	}
+	if result.ID != "abc" {
+		t.Errorf("ID = %q, want abc", result.ID)
+	}
+	if stats := client.CompressionStats(); stats.CompressedResponses != 0 {
+		t.Errorf("CompressedResponses = %d, want 0", stats.CompressedResponses)
+	}
+}
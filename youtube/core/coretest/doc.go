@@ -0,0 +1,40 @@
+// Package coretest provides a fake HTTP transport for testing code built on
+// core.Client, without a network connection or an httptest server.
+//
+// # Fake Transport
+//
+// Register canned responses by method and API path, then build a client
+// that sends its requests to the transport:
+//
+//	transport := coretest.NewTransport()
+//	transport.Handle("GET", "videos", func(req *coretest.Request) *coretest.Response {
+//		return coretest.JSON(http.StatusOK, data.VideoListResponse{
+//			Items: []*data.Video{{ID: req.Query.Get("id")}},
+//		})
+//	})
+//	client := transport.Client()
+//
+//	video, err := data.GetVideo(ctx, client, "abc123")
+//
+// Paths are the API paths passed to core.Client methods, such as "videos"
+// or "liveChat/messages". Requests without a handler get a 404 API error.
+//
+// # Errors
+//
+// Error builds a response in the YouTube API's error format, so the client
+// returns the same typed errors it would for the real API:
+//
+//	transport.Handle("POST", "liveChat/messages", func(*coretest.Request) *coretest.Response {
+//		return coretest.Error(http.StatusForbidden, "liveChatDisabled", "Live chat is disabled.")
+//	})
+//
+// # Assertions
+//
+// Every request is recorded. Inspect them with Requests, or only those sent
+// to one endpoint with RequestsTo:
+//
+//	reqs := transport.RequestsTo("DELETE", "liveChat/messages")
+//	if len(reqs) != 1 || reqs[0].Query.Get("id") != "msg1" {
+//		t.Errorf("deleted %v", reqs)
+//	}
+package coretest
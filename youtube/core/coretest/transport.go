@@ -0,0 +1,245 @@
+package coretest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// BaseURL is the base URL of clients created by Transport.Client.
+const BaseURL = "https://youtube.test/youtube/v3"
+
+// Request is an API request received by a Transport.
+type Request struct {
+	// Method is the HTTP method.
+	Method string
+
+	// Path is the API path, e.g. "liveChat/messages".
+	Path string
+
+	// Query contains the query parameters.
+	Query url.Values
+
+	// Header contains the request headers.
+	Header http.Header
+
+	// Body is the request body, or nil if there is none.
+	Body []byte
+
+	ctx context.Context
+}
+
+// Context returns the request's context. Handlers that block, e.g. to
+// simulate a slow endpoint, should return when it is done.
+func (r *Request) Context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+// DecodeBody decodes the JSON request body into v.
+func (r *Request) DecodeBody(v any) error {
+	if len(r.Body) == 0 {
+		return fmt.Errorf("request has no body")
+	}
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		return fmt.Errorf("decoding request body: %w", err)
+	}
+	return nil
+}
+
+// Response is a canned response returned by a Handler.
+type Response struct {
+	// StatusCode is the HTTP status code. Zero means 200 OK.
+	StatusCode int
+
+	// Header contains extra response headers, e.g. Retry-After.
+	Header http.Header
+
+	// Body is the response body. []byte and string values are sent as is;
+	// other values are encoded as JSON. Nil sends an empty body.
+	Body any
+}
+
+// Handler returns the response to a request.
+type Handler func(req *Request) *Response
+
+// JSON returns a response with body encoded as JSON.
+func JSON(status int, body any) *Response {
+	return &Response{StatusCode: status, Body: body}
+}
+
+// Error returns a response in the YouTube API's error format, with reason
+// as the error's reason code (e.g. "quotaExceeded" or "liveChatEnded").
+func Error(status int, reason, message string) *Response {
+	body := core.ErrorResponse{Error: &core.ErrorBody{
+		Code:    status,
+		Message: message,
+	}}
+	if reason != "" {
+		body.Error.Errors = []core.ErrorItem{{Reason: reason, Message: message}}
+	}
+	return JSON(status, body)
+}
+
+// Transport is a fake http.RoundTripper that answers API requests with
+// registered handlers and records every request. It is safe for concurrent
+// use.
+type Transport struct {
+	mu       sync.Mutex
+	handlers map[string]Handler
+	requests []*Request
+}
+
+// NewTransport creates a Transport with no handlers.
+func NewTransport() *Transport {
+	return &Transport{handlers: make(map[string]Handler)}
+}
+
+// Handle registers the handler for requests with the given method and API
+// path, replacing any previous handler.
+func (t *Transport) Handle(method, path string, h Handler) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.handlers[routeKey(method, path)] = h
+}
+
+// Client creates a core.Client that sends its requests to the transport.
+// opts are applied after the transport is installed, so WithHTTPClient
+// overrides it.
+func (t *Transport) Client(opts ...core.ClientOption) *core.Client {
+	opts = append([]core.ClientOption{
+		core.WithBaseURL(BaseURL),
+		core.WithHTTPClient(&http.Client{Transport: t}),
+	}, opts...)
+	return core.NewClient(opts...)
+}
+
+// Requests returns the requests received so far, oldest first.
+func (t *Transport) Requests() []*Request {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*Request(nil), t.requests...)
+}
+
+// RequestsTo returns the requests received for the given method and API
+// path, oldest first.
+func (t *Transport) RequestsTo(method, path string) []*Request {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var reqs []*Request
+	for _, r := range t.requests {
+		if r.Method == strings.ToUpper(method) && r.Path == strings.Trim(path, "/") {
+			reqs = append(reqs, r)
+		}
+	}
+	return reqs
+}
+
+// Reset forgets the recorded requests. Handlers are kept.
+func (t *Transport) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.requests = nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(httpReq *http.Request) (*http.Response, error) {
+	req := &Request{
+		Method: httpReq.Method,
+		Path:   apiPath(httpReq.URL.Path),
+		Query:  httpReq.URL.Query(),
+		Header: httpReq.Header.Clone(),
+		ctx:    httpReq.Context(),
+	}
+	if httpReq.Body != nil {
+		body, err := io.ReadAll(httpReq.Body)
+		_ = httpReq.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+		if len(body) > 0 {
+			req.Body = body
+		}
+	}
+
+	t.mu.Lock()
+	t.requests = append(t.requests, req)
+	h := t.handlers[routeKey(req.Method, req.Path)]
+	t.mu.Unlock()
+
+	var resp *Response
+	if h != nil {
+		resp = h(req)
+	}
+	if resp == nil {
+		resp = Error(http.StatusNotFound, "notFound", fmt.Sprintf("coretest: no handler for %s %s", req.Method, req.Path))
+	}
+	if err := httpReq.Context().Err(); err != nil {
+		return nil, err
+	}
+	return resp.httpResponse(httpReq)
+}
+
+// httpResponse converts r to an HTTP response to req.
+func (r *Response) httpResponse(req *http.Request) (*http.Response, error) {
+	var body []byte
+	switch b := r.Body.(type) {
+	case nil:
+	case []byte:
+		body = b
+	case string:
+		body = []byte(b)
+	default:
+		var err error
+		if body, err = json.Marshal(b); err != nil {
+			return nil, fmt.Errorf("encoding response body: %w", err)
+		}
+	}
+
+	status := r.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "application/json")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// routeKey returns the handler map key for a method and path.
+func routeKey(method, path string) string {
+	return strings.ToUpper(method) + " " + strings.Trim(path, "/")
+}
+
+// apiPath returns the API path of a request URL path, without the
+// "/youtube/v3" prefix of the API's base URL.
+func apiPath(p string) string {
+	p = strings.TrimPrefix(p, "/youtube/v3")
+	return strings.Trim(p, "/")
+}
@@ -0,0 +1,132 @@
+package coretest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestTransport(t *testing.T) {
+	transport := NewTransport()
+	transport.Handle("GET", "videos", func(req *Request) *Response {
+		return JSON(http.StatusOK, map[string]any{"items": []map[string]string{{"id": req.Query.Get("id")}}})
+	})
+	transport.Handle("post", "/liveChat/messages", func(req *Request) *Response {
+		var body map[string]string
+		if err := req.DecodeBody(&body); err != nil {
+			t.Errorf("DecodeBody() error = %v", err)
+		}
+		return &Response{Body: `{"id":"` + body["text"] + `"}`}
+	})
+
+	client := transport.Client(core.WithAccessToken("token"))
+	ctx := context.Background()
+
+	var list struct {
+		Items []struct{ ID string } `json:"items"`
+	}
+	if err := client.Get(ctx, "videos", url.Values{"id": {"abc"}}, "videos.list", &list); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].ID != "abc" {
+		t.Errorf("response = %+v", list)
+	}
+
+	var msg struct{ ID string }
+	if err := client.Post(ctx, "liveChat/messages", nil, map[string]string{"text": "hi"}, "liveChatMessages.insert", &msg); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if msg.ID != "hi" {
+		t.Errorf("response ID = %q, want hi", msg.ID)
+	}
+
+	err := client.Delete(ctx, "liveChat/messages", url.Values{"id": {"m1"}}, "liveChatMessages.delete")
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Code != "notFound" {
+		t.Errorf("unhandled request error = %v, want 404 notFound", err)
+	}
+
+	reqs := transport.Requests()
+	if len(reqs) != 3 {
+		t.Fatalf("recorded %d requests, want 3", len(reqs))
+	}
+	if reqs[0].Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("Authorization = %q", reqs[0].Header.Get("Authorization"))
+	}
+	if got := transport.RequestsTo("DELETE", "liveChat/messages"); len(got) != 1 || got[0].Query.Get("id") != "m1" {
+		t.Errorf("RequestsTo() = %+v", got)
+	}
+	if reqs[0].Body != nil {
+		t.Errorf("GET body = %q, want nil", reqs[0].Body)
+	}
+
+	transport.Reset()
+	if len(transport.Requests()) != 0 {
+		t.Error("Reset() kept requests")
+	}
+}
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name  string
+		resp  *Response
+		check func(error) bool
+	}{
+		{
+			name: "rate limited",
+			resp: &Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"7"}},
+				Body: Error(http.StatusTooManyRequests, "rateLimitExceeded", "slow down").Body},
+			check: func(err error) bool {
+				var rateErr *core.RateLimitError
+				return errors.As(err, &rateErr) && rateErr.RetryAfter == 7*time.Second
+			},
+		},
+		{
+			name: "chat ended",
+			resp: Error(http.StatusForbidden, "liveChatEnded", "The live chat has ended."),
+			check: func(err error) bool {
+				var apiErr *core.APIError
+				return errors.As(err, &apiErr) && apiErr.IsChatEnded()
+			},
+		},
+		{
+			name: "server error",
+			resp: Error(http.StatusInternalServerError, "", "Backend Error"),
+			check: func(err error) bool {
+				return core.IsRetryable(err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := NewTransport()
+			transport.Handle("GET", "liveChat/messages", func(*Request) *Response { return tt.resp })
+
+			err := transport.Client().Get(context.Background(), "liveChat/messages", nil, "liveChatMessages.list", nil)
+			if !tt.check(err) {
+				t.Errorf("error = %#v", err)
+			}
+		})
+	}
+}
+
+func TestTransport_BlockingHandler(t *testing.T) {
+	transport := NewTransport()
+	transport.Handle("GET", "liveChat/messages", func(req *Request) *Response {
+		<-req.Context().Done()
+		return JSON(http.StatusOK, nil)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := transport.Client().Get(ctx, "liveChat/messages", nil, "liveChatMessages.list", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,44 @@
+package core
+
+import "context"
+
+// correlationIDCtxKey is the context key for correlation IDs.
+type correlationIDCtxKey struct{}
+
+// WithCorrelationID returns a context carrying a correlation ID. Every API
+// call made with the context, including each retry attempt, reports the ID
+// to LoggingMiddleware and MetricsMiddleware, so calls serving one incoming
+// request can be grouped in logs and metrics. If id is empty, a random ID is
+// generated; read it back with CorrelationID.
+//
+// Requests made without a correlation ID are assigned a fresh one by
+// Client.Do, visible to middleware through CorrelationID.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		id = NewCorrelationID()
+	}
+	return context.WithValue(ctx, correlationIDCtxKey{}, id)
+}
+
+// CorrelationID returns the correlation ID stored in ctx, or "" if none.
+func CorrelationID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(correlationIDCtxKey{}).(string)
+	return id
+}
+
+// NewCorrelationID generates a random correlation ID.
+func NewCorrelationID() string {
+	return randomHex()
+}
+
+// ensureCorrelationID returns ctx with a correlation ID, generating one if
+// ctx doesn't already carry an ID.
+func ensureCorrelationID(ctx context.Context) context.Context {
+	if CorrelationID(ctx) != "" {
+		return ctx
+	}
+	return WithCorrelationID(ctx, "")
+}
@@ -0,0 +1,135 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// formattedLogger records fully formatted log lines.
+type formattedLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *formattedLogger) Printf(format string, v ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+// correlatedCollector records correlation IDs passed to the metrics collector.
+type correlatedCollector struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (c *correlatedCollector) RecordRequest(method, path string, duration time.Duration, err error) {
+	panic("RecordRequest called on a CorrelatedMetricsCollector")
+}
+
+func (c *correlatedCollector) RecordCorrelatedRequest(correlationID, method, path string, duration time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids = append(c.ids, correlationID)
+}
+
+func TestCorrelationID(t *testing.T) {
+	if got := CorrelationID(context.Background()); got != "" {
+		t.Errorf("CorrelationID() = %q, want empty", got)
+	}
+
+	ctx := WithCorrelationID(context.Background(), "req-42")
+	if got := CorrelationID(ctx); got != "req-42" {
+		t.Errorf("CorrelationID() = %q, want req-42", got)
+	}
+
+	generated := CorrelationID(WithCorrelationID(context.Background(), ""))
+	if len(generated) != 32 {
+		t.Errorf("generated ID = %q, want 32 hex characters", generated)
+	}
+	if generated == NewCorrelationID() {
+		t.Error("expected unique IDs")
+	}
+}
+
+func TestClient_CorrelationID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	logger := &formattedLogger{}
+	collector := &correlatedCollector{}
+	_, metricsMW := NewMetricsMiddleware(WithMetricsCollector(collector))
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithMiddleware(NewLoggingMiddleware(WithLogger(logger)), metricsMW),
+	)
+
+	t.Run("caller ID groups calls", func(t *testing.T) {
+		logger.lines, collector.ids = nil, nil
+		ctx := WithCorrelationID(context.Background(), "req-42")
+
+		for range 2 {
+			if err := client.Get(ctx, "videos", nil, "videos.list", nil); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+		}
+
+		if len(collector.ids) != 2 || collector.ids[0] != "req-42" || collector.ids[1] != "req-42" {
+			t.Errorf("collected IDs = %v, want [req-42 req-42]", collector.ids)
+		}
+		for _, line := range logger.lines {
+			if !strings.HasPrefix(line, "[youtube] [req-42] ") {
+				t.Errorf("log line %q missing correlation ID", line)
+			}
+		}
+	})
+
+	t.Run("generated per call when absent", func(t *testing.T) {
+		logger.lines, collector.ids = nil, nil
+
+		for range 2 {
+			if err := client.Get(context.Background(), "videos", nil, "videos.list", nil); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+		}
+
+		if len(collector.ids) != 2 || collector.ids[0] == "" || collector.ids[0] == collector.ids[1] {
+			t.Errorf("collected IDs = %v, want two distinct generated IDs", collector.ids)
+		}
+	})
+}
+
+func TestRetryMiddleware_CorrelationID(t *testing.T) {
+	mw := NewRetryMiddleware(
+		WithMaxRetries(2),
+		WithRetryBackoff(&BackoffConfig{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 1}),
+		WithShouldRetry(func(error) bool { return true }),
+	)
+
+	var ids []string
+	handler := func(ctx context.Context, req *Request) error {
+		ids = append(ids, CorrelationID(ctx))
+		return fmt.Errorf("attempt %d failed", len(ids))
+	}
+
+	ctx := WithCorrelationID(context.Background(), "req-42")
+	_ = mw(ctx, &Request{Method: http.MethodGet, Path: "videos"}, handler)
+
+	if len(ids) != 3 {
+		t.Fatalf("got %d attempts, want 3", len(ids))
+	}
+	for i, id := range ids {
+		if id != "req-42" {
+			t.Errorf("attempt %d correlation ID = %q, want req-42", i, id)
+		}
+	}
+}
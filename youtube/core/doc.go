@@ -11,6 +11,25 @@
 //		core.WithBaseURL("https://www.googleapis.com/youtube/v3"),
 //	)
 //
+// To debug unexpected responses, capture raw bodies before decoding. This is
+// opt-in because responses may contain personal data:
+//
+//	client := core.NewClient(core.WithRawResponseCapture(func(method, path string, body []byte) {
+//		log.Printf("%s %s: %s", method, path, body)
+//	}))
+//
+// Ping verifies credentials and connectivity with a 1-unit call, e.g. as a
+// readiness probe. It returns an *AuthError if the credentials are missing
+// or rejected and a *ConnectivityError if the API cannot be reached:
+//
+//	if err := client.Ping(ctx); err != nil {
+//		var authErr *core.AuthError
+//		if errors.As(err, &authErr) {
+//			log.Fatalf("bad credentials: %v", err)
+//		}
+//		return err // not ready yet; retry later
+//	}
+//
 // # Error Types
 //
 // The package defines several error types for different failure scenarios:
@@ -20,6 +39,13 @@
 //   - RateLimitError: Per-second rate limit exceeded
 //   - AuthError: Authentication and authorization failures
 //   - NotFoundError: Resource not found
+//   - QuotaBudgetExceededError: Request would exceed a context quota budget
+//   - ValidationError: Missing or invalid request field, caught before sending
+//   - DryRunError: Request built but not sent (see WithDryRun)
+//   - ConnectivityError: API unreachable or returning 5xx (see Client.Ping)
+//
+// IsRetryable reports whether an error is likely transient (rate limits,
+// 5xx responses, network failures) and worth retrying after a delay.
 //
 // # Quota Tracking
 //
@@ -30,6 +56,38 @@
 //	tracker.Add("liveChatMessages.list", 5)
 //	remaining := tracker.Remaining()
 //
+// Estimate a job's cost before running it, with a per-operation breakdown:
+//
+//	est := core.EstimateOperations([]core.Operation{
+//		{Name: "search.list", Count: 20},
+//		{Name: "videos.list", Count: 500},
+//	})
+//	if !est.Fits(tracker.Remaining()) {
+//		log.Fatalf("needs %d units: %v", est.Total, est.ByOperation)
+//	}
+//
+// A QuotaObserver sees the operation and cost of every request, including
+// Analytics requests. A QuotaTracker is itself an observer that keeps a
+// per-operation breakdown. The Data and Analytics APIs have separate
+// quotas, so give each its own tracker:
+//
+//	dataQuota := core.NewQuotaTracker(core.DefaultDailyQuota)
+//	analyticsQuota := core.NewQuotaTracker(analyticsDailyQuota)
+//	client := core.NewClient(core.WithQuotaObserver(dataQuota))
+//	analyticsClient := analytics.NewClient(analytics.WithQuotaObserver(analyticsQuota))
+//	// ...
+//	fmt.Println(dataQuota.UsageByOperation()) // map[search.list:200 videos.list:3]
+//
+// To see every API in one place, e.g. to export metrics, pass the same
+// QuotaObserverFunc to both clients instead.
+//
+// To enforce a hard cap instead, attach a budget to the request context.
+// Requests that would exceed it fail with QuotaBudgetExceededError before
+// being sent:
+//
+//	ctx = core.WithQuotaBudget(ctx, 50) // this handler may spend 50 units
+//	_, err := data.Search(ctx, client, params) // 100 units: refused
+//
 // # Cache
 //
 // The Cache provides in-memory caching with TTL support:
@@ -48,6 +106,104 @@
 //		return computeExpensiveValue()
 //	})
 //
+// # Idempotency Keys
+//
+// Write requests (POST/PUT) can carry an Idempotency-Key header so that a
+// retried insert is recognizable as the same operation. Supply a key per call
+// through the context, or let the client generate one:
+//
+//	ctx = core.ContextWithIdempotencyKey(ctx, core.NewIdempotencyKey())
+//	broadcast, err := streaming.InsertBroadcast(ctx, client, b, "snippet", "status")
+//
+//	client := core.NewClient(core.WithAutoIdempotencyKeys(true))
+//
+// RetryMiddleware assigns a key before the first attempt and reuses it on
+// every retry. YouTube's support for the header varies by endpoint, so
+// deduplication is best-effort rather than guaranteed.
+//
+// # Request Timeouts
+//
+// Requests use the HTTP client's timeout (DefaultTimeout by default).
+// Override it for calls made with a context, e.g. to give a slow call
+// longer; a deadline already on the context still applies:
+//
+//	slowCtx := core.WithRequestTimeout(ctx, 5*time.Minute)
+//
+// # Field Masks
+//
+// WithFields sends a fields mask with calls made with a context, so the API
+// returns only the selected fields. Combine it with explicit parts rather
+// than the defaults to fetch as little as possible:
+//
+//	titlesCtx := core.WithFields(ctx, "items(id,snippet/title)")
+//	resp, err := data.GetVideos(titlesCtx, client, &data.GetVideosParams{
+//		IDs:   ids,
+//		Parts: data.PartsBasic(),
+//	})
+//
+// Masks are syntax-checked by ValidateFields before the request is sent.
+//
+// # Pagination
+//
+// Paginate turns any list call into an iterator over the items of every
+// page. Pages are fetched as the loop advances, and breaking out stops
+// further calls:
+//
+//	items := core.Paginate(ctx, func(token string) ([]*data.PlaylistItem, string, error) {
+//		resp, err := data.GetPlaylistItems(ctx, client, &data.GetPlaylistItemsParams{
+//			PlaylistID: playlistID,
+//			PageToken:  token,
+//		})
+//		if err != nil {
+//			return nil, "", err
+//		}
+//		return resp.Items, resp.NextPageToken, nil
+//	})
+//	for item, err := range items {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(item.Snippet.Title)
+//	}
+//
+// # Compression
+//
+// WithCompression requests gzip responses and decompresses them, reporting
+// bandwidth saved through CompressionStats:
+//
+//	client := core.NewClient(core.WithCompression(true))
+//	// ...
+//	saved := client.CompressionStats().SavedBytes()
+//
+// # Dry Run
+//
+// WithDryRun shows the exact request a call would make without sending it.
+// Each request is built and passed to the function, then the call returns a
+// *DryRunError; no network call occurs and no quota is recorded:
+//
+//	client := core.NewClient(core.WithDryRun(func(r *http.Request) {
+//		fmt.Println(r.Method, r.URL)
+//	}))
+//
+// # Run Groups
+//
+// RunGroup manages several background loops (chat pollers, SSE streams,
+// statistics watchers) with one shared context. A fatal error, or a member
+// implementing Finisher (such as a chat poller) stopping on its own, cancels
+// the rest, and Wait or Stop shuts everything down and returns every fatal
+// error:
+//
+//	group := core.NewRunGroup(ctx)
+//	err := group.Add(poller, stream, watcher,
+//		core.NewRunnable(bot.Connect, func() { _ = bot.Close() }),
+//	)
+//	_ = group.Go(func(ctx context.Context) error {
+//		return serveDashboard(ctx) // blocking; an error stops the group
+//	})
+//	if err := group.Wait(); err != nil {
+//		log.Printf("shut down: %v", err)
+//	}
+//
 // # Middleware
 //
 // Middleware wraps request execution with additional behavior. Chain multiple
@@ -58,12 +214,24 @@
 //		core.NewRetryMiddleware(core.WithMaxRetries(3)),
 //	)
 //
+// Attach middleware to a client with WithMiddleware. A MiddlewareStack holds
+// named middlewares that can be added, removed, or replaced at runtime;
+// they run in registration order (first added is outermost):
+//
+//	stack := core.NewMiddlewareStack()
+//	stack.Use("logging", core.NewLoggingMiddleware())
+//	stack.Use("retry", core.NewRetryMiddleware())
+//	client := core.NewClient(core.WithMiddleware(stack.Middleware()))
+//
+//	// Later, e.g. on a signal:
+//	stack.Remove("logging")
+//
 // Available middleware:
 //
 //   - LoggingMiddleware: Logs requests and response times
 //   - RetryMiddleware: Retries failed requests with exponential backoff
 //   - MetricsMiddleware: Tracks request counts and durations
-//   - RateLimitingMiddleware: Limits requests per second
+//   - RateLimitingMiddleware: Token-bucket limits, globally, per endpoint, or by quota units
 //   - CachingMiddleware: Provides cache key generation
 //
 // Example with retry and logging:
@@ -73,6 +241,10 @@
 //		core.WithLogTiming(true),
 //	)
 //
+// LoggingMiddleware redacts API keys, access and refresh tokens, client
+// secrets, and Authorization headers from its output; see
+// DefaultRedactedFields, WithRedactedFields, and WithUnredactedFields.
+//
 //	retryMW := core.NewRetryMiddleware(
 //		core.WithMaxRetries(3),
 //		core.WithRetryBackoff(&core.BackoffConfig{
@@ -82,10 +254,38 @@
 //		}),
 //	)
 //
+// To keep retries from amplifying an outage, cap them at a fraction of all
+// requests; once the budget is spent, errors are returned without retrying:
+//
+//	retryMW := core.NewRetryMiddleware(core.WithRetryBudget(0.1)) // ~1 retry per 10 requests
+//
+// Example throttling search separately and everything else by quota units:
+//
+//	rateMW := core.NewRateLimitingMiddleware(
+//		core.WithQuotaRate(50),                      // 50 quota units/sec overall
+//		core.WithEndpointRate("search.list", 0.2),   // one search every 5 seconds
+//		core.WithRateLimitBurst(10),
+//	)
+//
+// Example grouping the calls made for one incoming request. Logging and
+// metrics middleware (including every retry attempt) report the same ID;
+// calls without one are assigned a generated ID:
+//
+//	ctx = core.WithCorrelationID(ctx, r.Header.Get("X-Request-ID"))
+//	// logs "[youtube] [<id>] GET videos ..."
+//
+// Metrics collectors that implement CorrelatedMetricsCollector receive the ID.
+//
 // Example with metrics:
 //
 //	metrics, metricsMW := core.NewMetricsMiddleware()
 //	// ... use middleware ...
 //	fmt.Printf("Total requests: %d\n", metrics.TotalRequests())
 //	fmt.Printf("Average duration: %v\n", metrics.AverageDuration())
+//
+// # Testing
+//
+// The coretest package provides a fake transport that answers requests
+// with canned responses and records them, so code built on Client can be
+// tested without an httptest server.
 package core
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DryRunError is returned by every request made through a Client created
+// with WithDryRun. It carries the request that would have been sent.
+type DryRunError struct {
+	// Request is the built request. Its body, if any, can be read with
+	// Request.GetBody.
+	Request *http.Request
+}
+
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("youtube: dry run: %s %s not sent", e.Request.Method, e.Request.URL.Path)
+}
+
+// WithDryRun builds each request as usual (URL, query, headers, and JSON
+// body) and passes it to fn instead of sending it. No network call is made,
+// no quota is recorded or spent from a QuotaBudget, and the call returns a
+// *DryRunError. Use it to check the exact request a function would make,
+// without a mock server:
+//
+//	var sent *http.Request
+//	client := core.NewClient(core.WithDryRun(func(r *http.Request) { sent = r }))
+//	_, err := data.Search(ctx, client, params) // err is a *core.DryRunError
+//	fmt.Println(sent.Method, sent.URL.Query())
+//
+// fn may be nil when the DryRunError is enough. Middleware still runs, so
+// fn is called once per attempt; retry middleware does not retry a
+// DryRunError by default. Requests that bypass Do, such as the streaming
+// package's SSE connection, are not affected.
+//
+// The request includes credentials (the Authorization header or key query
+// parameter), so take care when logging it.
+func WithDryRun(fn func(*http.Request)) ClientOption {
+	return func(c *Client) {
+		c.dryRun = true
+		c.dryRunFunc = fn
+	}
+}
+
+// dryRunRequest reports httpReq to the dry-run function.
+func (c *Client) dryRunRequest(httpReq *http.Request) error {
+	if c.dryRunFunc != nil {
+		c.dryRunFunc(httpReq)
+	}
+	return &DryRunError{Request: httpReq}
+}
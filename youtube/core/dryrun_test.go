@@ -0,0 +1,116 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestWithDryRun(t *testing.T) {
+	sent := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+	}))
+	defer server.Close()
+
+	t.Run("GET", func(t *testing.T) {
+		var captured *http.Request
+		tracker := NewQuotaTracker(10000)
+		client := NewClient(
+			WithBaseURL(server.URL),
+			WithAPIKey("api-key"),
+			WithQuotaTracker(tracker),
+			WithDryRun(func(r *http.Request) { captured = r }),
+		)
+
+		ctx := WithQuotaBudget(context.Background(), 1)
+		var result map[string]any
+		err := client.Get(ctx, "videos", url.Values{"id": {"abc"}, "part": {"snippet"}}, "videos.list", &result)
+
+		var dryRun *DryRunError
+		if !errors.As(err, &dryRun) {
+			t.Fatalf("error = %v, want *DryRunError", err)
+		}
+		if dryRun.Request != captured {
+			t.Error("DryRunError.Request is not the captured request")
+		}
+		if captured.Method != http.MethodGet || captured.URL.Path != "/videos" {
+			t.Errorf("request = %s %s, want GET /videos", captured.Method, captured.URL.Path)
+		}
+		if q := captured.URL.Query(); q.Get("id") != "abc" || q.Get("part") != "snippet" || q.Get("key") != "api-key" {
+			t.Errorf("query = %v", q)
+		}
+		if captured.Header.Get("User-Agent") != DefaultUserAgent {
+			t.Errorf("User-Agent = %q", captured.Header.Get("User-Agent"))
+		}
+		if tracker.Used() != 0 {
+			t.Errorf("quota used = %d, want 0", tracker.Used())
+		}
+		if result != nil {
+			t.Errorf("result = %v, want untouched", result)
+		}
+
+		// The budget was not spent, so a real call could still be made.
+		if err := spendQuotaBudget(ctx, "videos.list"); err != nil {
+			t.Errorf("budget spent by dry run: %v", err)
+		}
+	})
+
+	t.Run("POST body", func(t *testing.T) {
+		var captured *http.Request
+		client := NewClient(
+			WithBaseURL(server.URL),
+			WithAccessToken("token"),
+			WithDryRun(func(r *http.Request) { captured = r }),
+		)
+
+		err := client.Post(context.Background(), "liveChat/messages", url.Values{"part": {"snippet"}},
+			map[string]string{"text": "hi"}, "liveChatMessages.insert", nil)
+		var dryRun *DryRunError
+		if !errors.As(err, &dryRun) {
+			t.Fatalf("error = %v, want *DryRunError", err)
+		}
+		if err.Error() != "youtube: dry run: POST /liveChat/messages not sent" {
+			t.Errorf("Error() = %q", err.Error())
+		}
+
+		if captured.Header.Get("Authorization") != "Bearer token" || captured.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("headers = %v", captured.Header)
+		}
+		body, _ := captured.GetBody()
+		data, _ := io.ReadAll(body)
+		if string(data) != `{"text":"hi"}` {
+			t.Errorf("body = %s", data)
+		}
+	})
+
+	t.Run("nil func with retry middleware", func(t *testing.T) {
+		calls := 0
+		counter := func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
+			calls++
+			return next(ctx, req)
+		}
+		client := NewClient(
+			WithBaseURL(server.URL),
+			WithDryRun(nil),
+			WithMiddleware(NewRetryMiddleware(), counter),
+		)
+
+		err := client.Delete(context.Background(), "playlists", url.Values{"id": {"PL1"}}, "playlists.delete")
+		var dryRun *DryRunError
+		if !errors.As(err, &dryRun) || dryRun.Request.Method != http.MethodDelete {
+			t.Errorf("error = %v, want DELETE *DryRunError", err)
+		}
+		if calls != 1 {
+			t.Errorf("attempts = %d, want 1", calls)
+		}
+	})
+
+	if sent {
+		t.Error("dry run sent a request to the server")
+	}
+}
@@ -1,10 +1,14 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/rand"
+	"net"
+	"syscall"
 	"time"
 )
 
@@ -118,6 +122,7 @@ func (e *RateLimitError) Error() string {
 type AuthError struct {
 	Code    string // e.g., "invalid_grant", "expired_token"
 	Message string
+	Err     error // underlying error, e.g. the *APIError from Client.Ping
 }
 
 func (e *AuthError) Error() string {
@@ -127,6 +132,11 @@ func (e *AuthError) Error() string {
 	return fmt.Sprintf("youtube auth: %s", e.Message)
 }
 
+// Unwrap returns the underlying error.
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
 // IsExpiredToken returns true if the token has expired.
 func (e *AuthError) IsExpiredToken() bool {
 	return e.Code == "expired_token" || e.Code == "invalid_grant"
@@ -187,6 +197,46 @@ func (e *StreamNotBoundError) Error() string {
 	return fmt.Sprintf("youtube api: broadcast %s has no bound stream", e.BroadcastID)
 }
 
+// ValidationError indicates a request field is missing or invalid. It is
+// returned before the request is sent, so no quota is used.
+type ValidationError struct {
+	Field   string // API field path, e.g., "snippet.scheduledStartTime"
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("youtube: invalid %s: %s", e.Field, e.Message)
+}
+
+// IsRetryable reports whether err is likely transient, so the same request
+// may succeed if it is retried after a delay: rate limiting, server errors
+// (5xx), request timeouts (408), and network failures such as a refused or
+// reset connection. It returns false for nil, context cancellation, an
+// exhausted quota, and other client errors such as 403 Forbidden or a live
+// chat that has ended.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.IsQuotaExceeded() || apiErr.IsChatEnded() || apiErr.IsChatDisabled() {
+			return false
+		}
+		return apiErr.StatusCode >= 500 || apiErr.StatusCode == 408 || apiErr.IsRateLimited()
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
 // BackoffConfig configures exponential backoff with jitter for retry logic.
 type BackoffConfig struct {
 	BaseDelay  time.Duration  // Initial delay (default: 1s)
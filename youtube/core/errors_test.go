@@ -1,7 +1,14 @@
 package core
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math/rand"
+	"net"
+	"net/url"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -187,6 +194,51 @@ func TestNotFoundError_Error(t *testing.T) {
 	}
 }
 
+func TestValidationError_Error(t *testing.T) {
+	err := &ValidationError{Field: "status.privacyStatus", Message: "privacy status is required"}
+	if got, want := err.Error(), "youtube: invalid status.privacyStatus: privacy status is required"; got != want {
+		t.Errorf("ValidationError.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"server error", &APIError{StatusCode: 500, Message: "backend error"}, true},
+		{"unavailable", &APIError{StatusCode: 503}, true},
+		{"request timeout", &APIError{StatusCode: 408}, true},
+		{"rate limit status", &APIError{StatusCode: 429}, true},
+		{"rate limit error", &RateLimitError{RetryAfter: time.Second}, true},
+		{"wrapped server error", fmt.Errorf("poll: %w", &APIError{StatusCode: 502}), true},
+		{"forbidden", &APIError{StatusCode: 403, Code: "forbidden"}, false},
+		{"not found", &APIError{StatusCode: 404}, false},
+		{"chat ended code", &APIError{StatusCode: 500, Code: "liveChatEnded"}, false},
+		{"quota code", &APIError{StatusCode: 403, Code: "quotaExceeded"}, false},
+		{"quota error", &QuotaError{Used: 10000, Limit: 10000}, false},
+		{"chat ended", &ChatEndedError{LiveChatID: "chat1"}, false},
+		{"auth", &AuthError{Code: "invalid_grant"}, false},
+		{"validation", &ValidationError{Field: "id", Message: "required"}, false},
+		{"network", &url.Error{Op: "Get", URL: "http://x", Err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}}, true},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"truncated body", fmt.Errorf("decode: %w", io.ErrUnexpectedEOF), true},
+		{"canceled", &url.Error{Op: "Get", URL: "http://x", Err: context.Canceled}, false},
+		{"deadline", context.DeadlineExceeded, false},
+		{"unknown", errors.New("something else"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestBackoffConfig_Delay(t *testing.T) {
 	// Use fixed random for deterministic tests
 	backoff := &BackoffConfig{
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		mask    string
+		wantErr string
+	}{
+		{mask: "items"},
+		{mask: "items(id,snippet/title)"},
+		{mask: "nextPageToken,items(id,snippet(title,thumbnails/default/url))"},
+		{mask: "items/snippet/*"},
+		{mask: "", wantErr: "empty"},
+		{mask: "items(id,,snippet)", wantErr: "missing field name before ','"},
+		{mask: "items()", wantErr: "missing field name before ')'"},
+		{mask: "/items", wantErr: "missing field name before '/'"},
+		{mask: "items/", wantErr: "missing field name at end"},
+		{mask: "items(id", wantErr: "unclosed '('"},
+		{mask: "items)", wantErr: "unmatched ')'"},
+		{mask: "items(id, snippet)", wantErr: "unexpected character ' '"},
+		{mask: "items&key=x", wantErr: "unexpected character '&'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mask, func(t *testing.T) {
+			err := ValidateFields(tt.mask)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateFields() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateFields() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	var gotFields []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFields = append(gotFields, r.URL.Query().Get("fields"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := WithFields(context.Background(), "items(id,snippet/title)")
+
+	if err := client.Get(ctx, "videos", url.Values{"part": {"snippet"}}, "videos.list", nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := client.Get(ctx, "videos", url.Values{"fields": {"items/id"}}, "videos.list", nil); err != nil {
+		t.Fatalf("Get() with fields query error = %v", err)
+	}
+	if err := client.Get(WithFields(ctx, ""), "videos", nil, "videos.list", nil); err != nil {
+		t.Fatalf("Get() with cleared mask error = %v", err)
+	}
+
+	want := []string{"items(id,snippet/title)", "items/id", ""}
+	if strings.Join(gotFields, "|") != strings.Join(want, "|") {
+		t.Errorf("fields sent = %q, want %q", gotFields, want)
+	}
+
+	err := client.Get(WithFields(context.Background(), "items("), "videos", nil, "videos.list", nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid fields mask") {
+		t.Errorf("Get() with malformed mask error = %v", err)
+	}
+	if len(gotFields) != 3 {
+		t.Errorf("malformed mask was sent to the server")
+	}
+
+	if got := Fields(context.Background()); got != "" {
+		t.Errorf("Fields() = %q, want empty", got)
+	}
+	if got := Fields(ctx); got != "items(id,snippet/title)" {
+		t.Errorf("Fields() = %q", got)
+	}
+}
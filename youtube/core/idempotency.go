@@ -0,0 +1,67 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// IdempotencyKeyHeader is the HTTP header used to carry idempotency keys.
+//
+// YouTube support for this header varies by endpoint and is not documented,
+// so deduplication is best-effort: the key lets a server (or an intermediate
+// proxy) recognize a retried write, but it does not guarantee that a retried
+// insert will never create a duplicate resource.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKeyCtxKey is the context key for caller-supplied idempotency keys.
+type idempotencyKeyCtxKey struct{}
+
+// ContextWithIdempotencyKey returns a context carrying an idempotency key.
+// Write requests made with this context send the key in the
+// Idempotency-Key header. This is how callers supply a key to high-level
+// helpers (e.g., streaming.InsertBroadcast) that build the Request internally.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key stored in ctx, if any.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	key, _ := ctx.Value(idempotencyKeyCtxKey{}).(string)
+	return key
+}
+
+// NewIdempotencyKey generates a random idempotency key.
+func NewIdempotencyKey() string {
+	return randomHex()
+}
+
+// randomHex returns 16 random bytes encoded as hex.
+func randomHex() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// isWriteMethod reports whether a request method may create duplicates when retried.
+func isWriteMethod(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut
+}
+
+// ensureIdempotencyKey assigns an idempotency key to a write request that
+// doesn't already have one. The key is taken from ctx if present, otherwise
+// generated. The key is stored on req so that every attempt reuses it.
+func ensureIdempotencyKey(ctx context.Context, req *Request) {
+	if req == nil || req.IdempotencyKey != "" || !isWriteMethod(req.Method) {
+		return
+	}
+	if key := IdempotencyKeyFromContext(ctx); key != "" {
+		req.IdempotencyKey = key
+		return
+	}
+	req.IdempotencyKey = NewIdempotencyKey()
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewIdempotencyKey(t *testing.T) {
+	a := NewIdempotencyKey()
+	b := NewIdempotencyKey()
+
+	if len(a) != 32 {
+		t.Errorf("len(key) = %d, want 32", len(a))
+	}
+	if a == b {
+		t.Error("expected unique keys")
+	}
+}
+
+func TestIdempotencyKeyFromContext(t *testing.T) {
+	if got := IdempotencyKeyFromContext(context.Background()); got != "" {
+		t.Errorf("IdempotencyKeyFromContext() = %q, want empty", got)
+	}
+
+	ctx := ContextWithIdempotencyKey(context.Background(), "key-1")
+	if got := IdempotencyKeyFromContext(ctx); got != "key-1" {
+		t.Errorf("IdempotencyKeyFromContext() = %q, want key-1", got)
+	}
+}
+
+func TestClient_IdempotencyKeyHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []ClientOption
+		ctxKey  string
+		req     *Request
+		want    string
+		wantAny bool
+	}{
+		{
+			name: "explicit key",
+			req:  &Request{Method: http.MethodPost, Path: "/test", IdempotencyKey: "explicit"},
+			want: "explicit",
+		},
+		{
+			name:   "key from context",
+			ctxKey: "from-ctx",
+			req:    &Request{Method: http.MethodPost, Path: "/test"},
+			want:   "from-ctx",
+		},
+		{
+			name:   "explicit key wins over context",
+			ctxKey: "from-ctx",
+			req:    &Request{Method: http.MethodPut, Path: "/test", IdempotencyKey: "explicit"},
+			want:   "explicit",
+		},
+		{
+			name: "no key by default",
+			req:  &Request{Method: http.MethodPost, Path: "/test"},
+			want: "",
+		},
+		{
+			name:    "auto key for POST",
+			opts:    []ClientOption{WithAutoIdempotencyKeys(true)},
+			req:     &Request{Method: http.MethodPost, Path: "/test"},
+			wantAny: true,
+		},
+		{
+			name: "no auto key for GET",
+			opts: []ClientOption{WithAutoIdempotencyKeys(true)},
+			req:  &Request{Method: http.MethodGet, Path: "/test"},
+			want: "",
+		},
+		{
+			name:   "context key ignored for DELETE",
+			ctxKey: "from-ctx",
+			req:    &Request{Method: http.MethodDelete, Path: "/test"},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get(IdempotencyKeyHeader)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			c := NewClient(append([]ClientOption{WithBaseURL(server.URL)}, tt.opts...)...)
+
+			ctx := context.Background()
+			if tt.ctxKey != "" {
+				ctx = ContextWithIdempotencyKey(ctx, tt.ctxKey)
+			}
+
+			if err := c.Do(ctx, tt.req, nil); err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+
+			if tt.wantAny {
+				if got == "" {
+					t.Error("expected generated Idempotency-Key header")
+				}
+				if got != tt.req.IdempotencyKey {
+					t.Errorf("header = %q, req.IdempotencyKey = %q", got, tt.req.IdempotencyKey)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Idempotency-Key = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -30,11 +32,119 @@ func MiddlewareChain(middlewares ...Middleware) Middleware {
 	}
 }
 
+// namedMiddleware is a middleware registered in a MiddlewareStack.
+type namedMiddleware struct {
+	name string
+	mw   Middleware
+}
+
+// MiddlewareStack is an ordered, named collection of middlewares that can be
+// changed at runtime. Middlewares run in registration order: the first one
+// added is the outermost and sees the request first. Replace keeps a
+// middleware's position; Remove closes the gap.
+//
+// The stack is read once at the start of each request, so changes apply to
+// subsequent requests only. It is safe for concurrent use.
+type MiddlewareStack struct {
+	mu      sync.RWMutex
+	entries []namedMiddleware
+}
+
+// NewMiddlewareStack creates an empty middleware stack.
+func NewMiddlewareStack() *MiddlewareStack {
+	return &MiddlewareStack{}
+}
+
+// Use appends a named middleware to the end of the stack.
+// Returns an error if the name is empty or already registered.
+func (s *MiddlewareStack) Use(name string, mw Middleware) error {
+	if name == "" {
+		return fmt.Errorf("middleware name cannot be empty")
+	}
+	if mw == nil {
+		return fmt.Errorf("middleware cannot be nil")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.index(name) >= 0 {
+		return fmt.Errorf("middleware %q already registered", name)
+	}
+	s.entries = append(s.entries, namedMiddleware{name: name, mw: mw})
+	return nil
+}
+
+// Remove removes the named middleware. Returns false if it was not registered.
+func (s *MiddlewareStack) Remove(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.index(name)
+	if i < 0 {
+		return false
+	}
+	s.entries = slices.Delete(s.entries, i, i+1)
+	return true
+}
+
+// Replace swaps the named middleware in place, preserving its position.
+// Returns false if it was not registered.
+func (s *MiddlewareStack) Replace(name string, mw Middleware) bool {
+	if mw == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.index(name)
+	if i < 0 {
+		return false
+	}
+	s.entries[i].mw = mw
+	return true
+}
+
+// Names returns the registered middleware names in execution order.
+func (s *MiddlewareStack) Names() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, len(s.entries))
+	for i, e := range s.entries {
+		names[i] = e.name
+	}
+	return names
+}
+
+// Middleware returns a Middleware that runs the stack's current contents.
+// Pass it to WithMiddleware to make a Client follow runtime changes.
+func (s *MiddlewareStack) Middleware() Middleware {
+	return func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
+		s.mu.RLock()
+		mws := make([]Middleware, len(s.entries))
+		for i, e := range s.entries {
+			mws[i] = e.mw
+		}
+		s.mu.RUnlock()
+
+		return MiddlewareChain(mws...)(ctx, req, next)
+	}
+}
+
+// index returns the position of the named middleware, or -1.
+// Must be called with s.mu held.
+func (s *MiddlewareStack) index(name string) int {
+	return slices.IndexFunc(s.entries, func(e namedMiddleware) bool { return e.name == name })
+}
+
 // LoggingMiddleware logs request details.
 type LoggingMiddleware struct {
 	logger    Logger
 	logBody   bool
 	logTiming bool
+	redacted  map[string]bool // Lowercased field names; see WithRedactedFields
 }
 
 // Logger is the interface for logging.
@@ -68,22 +178,35 @@ func WithLogTiming(enabled bool) LoggingOption {
 }
 
 // NewLoggingMiddleware creates a logging middleware.
+// Requests with a correlation ID (see WithCorrelationID) are logged with the
+// ID after the "[youtube]" prefix.
+//
+// Secrets are redacted from paths, bodies, and errors (which can include
+// the request URL with its API key): by default the DefaultRedactedFields,
+// adjusted with WithRedactedFields and WithUnredactedFields.
 func NewLoggingMiddleware(opts ...LoggingOption) Middleware {
 	m := &LoggingMiddleware{
 		logger:    defaultLogger{},
 		logTiming: true,
+		redacted:  make(map[string]bool, len(DefaultRedactedFields)),
+	}
+	for _, f := range DefaultRedactedFields {
+		m.redacted[f] = true
 	}
 	for _, opt := range opts {
 		opt(m)
 	}
+	r := newRedactor(m.redacted)
 
 	return func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
 		start := time.Now()
+		prefix := logPrefix(ctx)
+		path := r.redact(req.Path)
 
 		// Log request
-		m.logger.Printf("[youtube] %s %s", req.Method, req.Path)
+		m.logger.Printf("%s %s %s", prefix, req.Method, path)
 		if m.logBody && req.Body != nil {
-			m.logger.Printf("[youtube] body: %+v", req.Body)
+			m.logger.Printf("%s body: %s", prefix, r.redact(fmt.Sprintf("%+v", req.Body)))
 		}
 
 		// Execute request
@@ -93,9 +216,9 @@ func NewLoggingMiddleware(opts ...LoggingOption) Middleware {
 		if m.logTiming {
 			duration := time.Since(start)
 			if err != nil {
-				m.logger.Printf("[youtube] %s %s failed after %v: %v", req.Method, req.Path, duration, err)
+				m.logger.Printf("%s %s %s failed after %v: %s", prefix, req.Method, path, duration, r.redact(err.Error()))
 			} else {
-				m.logger.Printf("[youtube] %s %s completed in %v", req.Method, req.Path, duration)
+				m.logger.Printf("%s %s %s completed in %v", prefix, req.Method, path, duration)
 			}
 		}
 
@@ -103,11 +226,20 @@ func NewLoggingMiddleware(opts ...LoggingOption) Middleware {
 	}
 }
 
+// logPrefix returns the log line prefix, including the correlation ID if set.
+func logPrefix(ctx context.Context) string {
+	if id := CorrelationID(ctx); id != "" {
+		return "[youtube] [" + id + "]"
+	}
+	return "[youtube]"
+}
+
 // RetryMiddleware retries failed requests with exponential backoff.
 type RetryMiddleware struct {
 	maxRetries int
 	backoff    *BackoffConfig
 	shouldRetry func(error) bool
+	budget     *retryBudget
 }
 
 // RetryOption configures RetryMiddleware.
@@ -128,7 +260,63 @@ func WithShouldRetry(fn func(error) bool) RetryOption {
 	return func(m *RetryMiddleware) { m.shouldRetry = fn }
 }
 
+// WithRetryBudget caps retries at ratio times the number of requests, so
+// that when many requests fail at once (e.g., during an outage) retries
+// cannot multiply the load on the API. With a ratio of 0.1, at most one
+// retry is sent per ten requests over time, plus a small reserve so
+// occasional failures can always be retried.
+//
+// The budget is shared by every request passing through the middleware.
+// When it is exhausted, the failed request's error is returned as-is
+// instead of being retried. A ratio of 0 or less leaves retries unbudgeted.
+func WithRetryBudget(ratio float64) RetryOption {
+	return func(m *RetryMiddleware) {
+		if ratio <= 0 {
+			m.budget = nil
+			return
+		}
+		m.budget = newRetryBudget(ratio)
+	}
+}
+
+// retryBudgetReserve is the number of retries a retry budget starts with
+// and can accumulate, in the style of gRPC retry throttling.
+const retryBudgetReserve = 10
+
+// retryBudget is a token bucket: each request deposits ratio tokens and
+// each retry withdraws one.
+type retryBudget struct {
+	mu     sync.Mutex
+	ratio  float64
+	tokens float64
+}
+
+func newRetryBudget(ratio float64) *retryBudget {
+	return &retryBudget{ratio: ratio, tokens: retryBudgetReserve}
+}
+
+// deposit credits the budget for a new request.
+func (b *retryBudget) deposit() {
+	b.mu.Lock()
+	b.tokens = min(b.tokens+b.ratio, retryBudgetReserve)
+	b.mu.Unlock()
+}
+
+// withdraw spends one retry, reporting false if none are left.
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
 // NewRetryMiddleware creates a retry middleware.
+//
+// POST and PUT requests without an idempotency key are assigned one before
+// the first attempt, so every retry carries the same Idempotency-Key header.
 func NewRetryMiddleware(opts ...RetryOption) Middleware {
 	m := &RetryMiddleware{
 		maxRetries: 3,
@@ -148,6 +336,13 @@ func NewRetryMiddleware(opts ...RetryOption) Middleware {
 	return func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
 		var lastErr error
 
+		// Reuse one key across attempts so a retried write can be deduplicated
+		ensureIdempotencyKey(ctx, req)
+
+		if m.budget != nil {
+			m.budget.deposit()
+		}
+
 		for attempt := 0; attempt <= m.maxRetries; attempt++ {
 			// Check context before each attempt
 			if ctx.Err() != nil {
@@ -156,6 +351,11 @@ func NewRetryMiddleware(opts ...RetryOption) Middleware {
 
 			// Wait before retry (skip on first attempt)
 			if attempt > 0 {
+				// Give up with the original error once the budget is spent
+				if m.budget != nil && !m.budget.withdraw() {
+					return lastErr
+				}
+
 				delay := m.backoff.Delay(attempt - 1)
 
 				// If we have a rate limit error, use its retry-after
@@ -198,6 +398,17 @@ type MetricsCollector interface {
 	RecordRequest(method, path string, duration time.Duration, err error)
 }
 
+// CorrelatedMetricsCollector is implemented by collectors that also want the
+// request's correlation ID (see WithCorrelationID). MetricsMiddleware calls
+// RecordCorrelatedRequest instead of RecordRequest when it is implemented.
+type CorrelatedMetricsCollector interface {
+	MetricsCollector
+
+	// RecordCorrelatedRequest records a completed request and its
+	// correlation ID ("" if none).
+	RecordCorrelatedRequest(correlationID, method, path string, duration time.Duration, err error)
+}
+
 // MetricsOption configures MetricsMiddleware.
 type MetricsOption func(*MetricsMiddleware)
 
@@ -226,7 +437,9 @@ func NewMetricsMiddleware(opts ...MetricsOption) (*MetricsMiddleware, Middleware
 			m.failedRequests.Add(1)
 		}
 
-		if m.collector != nil {
+		if c, ok := m.collector.(CorrelatedMetricsCollector); ok {
+			c.RecordCorrelatedRequest(CorrelationID(ctx), req.Method, req.Path, duration, err)
+		} else if m.collector != nil {
 			m.collector.RecordRequest(req.Method, req.Path, duration, err)
 		}
 
@@ -332,22 +545,60 @@ func NewCachingMiddleware(cache *Cache, opts ...CachingOption) Middleware {
 	}
 }
 
-// RateLimitingMiddleware limits request rate.
+// RateLimitingMiddleware limits request rate using token buckets.
+//
+// By default a single global bucket limits requests per second. Operations
+// configured with WithEndpointRate get their own bucket instead, so expensive
+// calls such as search.list can be throttled separately from cheap ones.
+// With WithQuotaRate, the global bucket is measured in quota units per second
+// (using QuotaCosts) rather than requests.
 type RateLimitingMiddleware struct {
 	requestsPerSecond float64
-	lastRequest       atomic.Int64 // UnixNano
-	minInterval       time.Duration
+	burst             float64
+	quotaPerSecond    float64
+	endpointRates     map[string]float64
 }
 
 // RateLimitOption configures RateLimitingMiddleware.
 type RateLimitOption func(*RateLimitingMiddleware)
 
-// WithRequestsPerSecond sets the maximum requests per second.
+// WithRequestsPerSecond sets the maximum requests per second for the global
+// limiter. Default is 10.
 func WithRequestsPerSecond(rps float64) RateLimitOption {
 	return func(m *RateLimitingMiddleware) {
 		m.requestsPerSecond = rps
-		if rps > 0 {
-			m.minInterval = time.Duration(float64(time.Second) / rps)
+	}
+}
+
+// WithEndpointRate sets a dedicated requests-per-second limit for an API
+// operation (e.g., "search.list"). Requests for that operation use their own
+// bucket and do not consume the global limit. Operations without a rule fall
+// back to the global limiter.
+func WithEndpointRate(operation string, rps float64) RateLimitOption {
+	return func(m *RateLimitingMiddleware) {
+		if m.endpointRates == nil {
+			m.endpointRates = make(map[string]float64)
+		}
+		m.endpointRates[operation] = rps
+	}
+}
+
+// WithQuotaRate switches the global limiter to quota-aware mode, allowing
+// the given number of quota units per second. Each request consumes its
+// operation's cost from QuotaCosts (1 for unknown operations), so a
+// search.list call waits as long as 100 videos.list calls.
+func WithQuotaRate(unitsPerSecond float64) RateLimitOption {
+	return func(m *RateLimitingMiddleware) {
+		m.quotaPerSecond = unitsPerSecond
+	}
+}
+
+// WithRateLimitBurst sets how many tokens each bucket can accumulate while
+// idle, allowing short bursts above the steady rate. Default is 1 (no burst).
+func WithRateLimitBurst(burst float64) RateLimitOption {
+	return func(m *RateLimitingMiddleware) {
+		if burst >= 1 {
+			m.burst = burst
 		}
 	}
 }
@@ -356,36 +607,94 @@ func WithRequestsPerSecond(rps float64) RateLimitOption {
 func NewRateLimitingMiddleware(opts ...RateLimitOption) Middleware {
 	m := &RateLimitingMiddleware{
 		requestsPerSecond: 10, // Default: 10 requests per second
-		minInterval:       100 * time.Millisecond,
+		burst:             1,
 	}
 	for _, opt := range opts {
 		opt(m)
 	}
 
-	return func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
-		now := time.Now().UnixNano()
+	var global *tokenBucket
+	switch {
+	case m.quotaPerSecond > 0:
+		global = newTokenBucket(m.quotaPerSecond, m.burst)
+	case m.requestsPerSecond > 0:
+		global = newTokenBucket(m.requestsPerSecond, m.burst)
+	}
 
-		for {
-			last := m.lastRequest.Load()
-			nextAllowed := last + int64(m.minInterval)
+	endpoints := make(map[string]*tokenBucket, len(m.endpointRates))
+	for op, rps := range m.endpointRates {
+		if rps > 0 {
+			endpoints[op] = newTokenBucket(rps, m.burst)
+		}
+	}
 
-			if now >= nextAllowed {
-				if m.lastRequest.CompareAndSwap(last, now) {
-					break
-				}
-				continue // Another goroutine updated, retry
-			}
+	return func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
+		bucket, tokens := global, 1.0
+		if b, ok := endpoints[req.Operation]; ok {
+			bucket = b
+		} else if m.quotaPerSecond > 0 {
+			tokens = float64(OperationCost(req.Operation))
+		}
 
-			// Need to wait
-			waitTime := time.Duration(nextAllowed - now)
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(waitTime):
-				now = time.Now().UnixNano()
+		if bucket != nil {
+			if err := bucket.wait(ctx, tokens); err != nil {
+				return err
 			}
 		}
 
 		return next(ctx, req)
 	}
 }
+
+// tokenBucket is a token bucket rate limiter. Requests larger than the
+// bucket's capacity are allowed by borrowing against future tokens, so a
+// single expensive request is delayed rather than rejected.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64 // tokens per second
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// newTokenBucket creates a full bucket.
+func newTokenBucket(rate, capacity float64) *tokenBucket {
+	return &tokenBucket{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     time.Now(),
+	}
+}
+
+// wait takes n tokens, blocking until they are available or ctx is done.
+// Tokens are returned to the bucket if ctx ends before the wait completes.
+func (b *tokenBucket) wait(ctx context.Context, n float64) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.capacity, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens -= n
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens += n
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
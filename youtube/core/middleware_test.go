@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"log"
+	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -51,6 +53,87 @@ func TestMiddlewareChain(t *testing.T) {
 	}
 }
 
+func TestMiddlewareStack(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(ctx context.Context, req *Request, next func(context.Context, *Request) error) error {
+			order = append(order, name)
+			return next(ctx, req)
+		}
+	}
+	handler := func(ctx context.Context, req *Request) error { return nil }
+
+	stack := NewMiddlewareStack()
+	mw := stack.Middleware()
+
+	run := func() []string {
+		order = nil
+		if err := mw(context.Background(), &Request{}, handler); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return order
+	}
+
+	if got := run(); len(got) != 0 {
+		t.Errorf("empty stack order = %v, want []", got)
+	}
+
+	for _, name := range []string{"logging", "retry", "metrics"} {
+		if err := stack.Use(name, record(name)); err != nil {
+			t.Fatalf("Use(%q) error = %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func()
+		want   []string
+	}{
+		{"registration order", func() {}, []string{"logging", "retry", "metrics"}},
+		{"replace keeps position", func() {
+			if !stack.Replace("retry", record("retry2")) {
+				t.Error("Replace(retry) = false, want true")
+			}
+		}, []string{"logging", "retry2", "metrics"}},
+		{"remove", func() {
+			if !stack.Remove("logging") {
+				t.Error("Remove(logging) = false, want true")
+			}
+		}, []string{"retry2", "metrics"}},
+		{"use appends", func() {
+			_ = stack.Use("logging", record("logging"))
+		}, []string{"retry2", "metrics", "logging"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.change()
+			if got := run(); !slices.Equal(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := stack.Names(); !slices.Equal(got, []string{"retry", "metrics", "logging"}) {
+		t.Errorf("Names() = %v", got)
+	}
+	if err := stack.Use("retry", record("dup")); err == nil {
+		t.Error("Use() with duplicate name should fail")
+	}
+	if err := stack.Use("", record("x")); err == nil {
+		t.Error("Use() with empty name should fail")
+	}
+	if err := stack.Use("nil", nil); err == nil {
+		t.Error("Use() with nil middleware should fail")
+	}
+	if stack.Remove("missing") {
+		t.Error("Remove(missing) = true, want false")
+	}
+	if stack.Replace("missing", record("x")) {
+		t.Error("Replace(missing) = true, want false")
+	}
+}
+
 type testLogger struct {
 	mu   sync.Mutex
 	logs []string
@@ -192,6 +275,53 @@ func TestRetryMiddleware(t *testing.T) {
 		}
 	})
 
+	t.Run("reuses idempotency key across attempts", func(t *testing.T) {
+		var keys []string
+		mw := NewRetryMiddleware(
+			WithMaxRetries(2),
+			WithRetryBackoff(&BackoffConfig{
+				BaseDelay:  1 * time.Millisecond,
+				MaxDelay:   10 * time.Millisecond,
+				Multiplier: 2.0,
+				RandFloat:  func() float64 { return 0.5 },
+			}),
+		)
+
+		handler := func(ctx context.Context, req *Request) error {
+			keys = append(keys, req.IdempotencyKey)
+			if len(keys) < 3 {
+				return &RateLimitError{}
+			}
+			return nil
+		}
+
+		err := mw(context.Background(), &Request{Method: http.MethodPost}, handler)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(keys) != 3 {
+			t.Fatalf("attempts = %d, want 3", len(keys))
+		}
+		if keys[0] == "" {
+			t.Fatal("expected generated idempotency key")
+		}
+		for i, k := range keys {
+			if k != keys[0] {
+				t.Errorf("attempt %d key = %q, want %q", i, k, keys[0])
+			}
+		}
+	})
+
+	t.Run("no idempotency key for GET", func(t *testing.T) {
+		mw := NewRetryMiddleware()
+		req := &Request{Method: http.MethodGet}
+
+		_ = mw(context.Background(), req, func(ctx context.Context, req *Request) error { return nil })
+		if req.IdempotencyKey != "" {
+			t.Errorf("IdempotencyKey = %q, want empty", req.IdempotencyKey)
+		}
+	})
+
 	t.Run("no retry on non-retryable error", func(t *testing.T) {
 		callCount := 0
 		mw := NewRetryMiddleware(WithMaxRetries(3))
@@ -285,6 +415,72 @@ func TestRetryMiddleware(t *testing.T) {
 			t.Errorf("callCount = %d, want 2", callCount)
 		}
 	})
+
+	t.Run("retry budget", func(t *testing.T) {
+		mw := NewRetryMiddleware(
+			WithMaxRetries(3),
+			WithRetryBudget(0.5),
+			WithRetryBackoff(&BackoffConfig{RandFloat: func() float64 { return 0.5 }}),
+		)
+
+		callCount := 0
+		rateLimited := &RateLimitError{}
+		failing := func(ctx context.Context, req *Request) error {
+			callCount++
+			return rateLimited
+		}
+
+		// The reserve of 10 retries (topped up by 0.5 per request) covers
+		// three fully retried requests.
+		for i := 0; i < 3; i++ {
+			err := mw(context.Background(), &Request{}, failing)
+			if err == nil || !strings.Contains(err.Error(), "max retries") {
+				t.Fatalf("request %d: error = %v, want max retries", i, err)
+			}
+		}
+		if callCount != 12 {
+			t.Fatalf("callCount = %d, want 12", callCount)
+		}
+
+		// 2 tokens left + 0.5 deposit: two retries, then the budget is spent.
+		callCount = 0
+		err := mw(context.Background(), &Request{}, failing)
+		if err != rateLimited {
+			t.Errorf("error = %v, want original error", err)
+		}
+		if callCount != 3 {
+			t.Errorf("callCount = %d, want 3", callCount)
+		}
+
+		// Successful requests refill the budget: 0.5 + 2*0.5 + 0.5 = 2 retries.
+		for i := 0; i < 2; i++ {
+			_ = mw(context.Background(), &Request{}, func(ctx context.Context, req *Request) error { return nil })
+		}
+		callCount = 0
+		_ = mw(context.Background(), &Request{}, failing)
+		if callCount != 3 {
+			t.Errorf("callCount after refill = %d, want 3", callCount)
+		}
+	})
+
+	t.Run("retry budget disabled", func(t *testing.T) {
+		mw := NewRetryMiddleware(
+			WithMaxRetries(2),
+			WithRetryBudget(0),
+			WithRetryBackoff(&BackoffConfig{RandFloat: func() float64 { return 0.5 }}),
+		)
+
+		callCount := 0
+		for i := 0; i < 10; i++ {
+			_ = mw(context.Background(), &Request{}, func(ctx context.Context, req *Request) error {
+				callCount++
+				return &RateLimitError{}
+			})
+		}
+		if callCount != 30 {
+			t.Errorf("callCount = %d, want 30", callCount)
+		}
+	})
 }
 
 func TestMetricsMiddleware(t *testing.T) {
@@ -459,6 +655,74 @@ func TestRateLimitingMiddleware(t *testing.T) {
 	})
 }
 
+func TestRateLimitingMiddleware_Buckets(t *testing.T) {
+	noop := func(ctx context.Context, req *Request) error { return nil }
+
+	// timeCalls returns how long n calls for the given operation take.
+	timeCalls := func(t *testing.T, mw Middleware, op string, n int) time.Duration {
+		t.Helper()
+		start := time.Now()
+		for i := 0; i < n; i++ {
+			if err := mw(context.Background(), &Request{Operation: op}, noop); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		return time.Since(start)
+	}
+
+	t.Run("endpoint rate uses its own bucket", func(t *testing.T) {
+		mw := NewRateLimitingMiddleware(
+			WithRequestsPerSecond(1000),
+			WithEndpointRate("search.list", 50), // 20ms between calls
+		)
+
+		if elapsed := timeCalls(t, mw, "search.list", 3); elapsed < 30*time.Millisecond {
+			t.Errorf("search.list elapsed = %v, expected >= 30ms", elapsed)
+		}
+		// Cheap calls are unaffected by the search bucket.
+		if elapsed := timeCalls(t, mw, "videos.list", 3); elapsed > 20*time.Millisecond {
+			t.Errorf("videos.list elapsed = %v, expected < 20ms", elapsed)
+		}
+	})
+
+	t.Run("quota mode weights by cost", func(t *testing.T) {
+		// 5000 units/sec: videos.list (1 unit) is effectively free,
+		// search.list (100 units) takes 20ms each after the first.
+		mw := NewRateLimitingMiddleware(WithQuotaRate(5000), WithRateLimitBurst(100))
+
+		if elapsed := timeCalls(t, mw, "videos.list", 5); elapsed > 20*time.Millisecond {
+			t.Errorf("videos.list elapsed = %v, expected < 20ms", elapsed)
+		}
+		if elapsed := timeCalls(t, mw, "search.list", 3); elapsed < 30*time.Millisecond {
+			t.Errorf("search.list elapsed = %v, expected >= 30ms", elapsed)
+		}
+	})
+
+	t.Run("burst allows immediate requests", func(t *testing.T) {
+		mw := NewRateLimitingMiddleware(WithRequestsPerSecond(1), WithRateLimitBurst(5))
+
+		if elapsed := timeCalls(t, mw, "videos.list", 5); elapsed > 50*time.Millisecond {
+			t.Errorf("elapsed = %v, expected burst of 5 to be immediate", elapsed)
+		}
+	})
+
+	t.Run("cancelled wait returns tokens", func(t *testing.T) {
+		mw := NewRateLimitingMiddleware(WithRequestsPerSecond(20)) // 50ms between calls
+		_ = timeCalls(t, mw, "", 1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := mw(ctx, &Request{}, noop); !errors.Is(err, context.Canceled) {
+			t.Fatalf("error = %v, want context.Canceled", err)
+		}
+
+		// The cancelled request must not delay the next one by an extra interval.
+		if elapsed := timeCalls(t, mw, "", 1); elapsed > 90*time.Millisecond {
+			t.Errorf("elapsed = %v, expected <= one interval", elapsed)
+		}
+	})
+}
+
 func TestCachingMiddleware(t *testing.T) {
 	cache := NewCache()
 	mw := NewCachingMiddleware(cache,
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// MaxPageSize is the largest page most YouTube Data API list methods
+// return. Methods with a different limit document it on their MaxResults
+// parameter.
+const MaxPageSize = 50
+
+// SetMaxResults sets the maxResults query parameter to n, clamped to limit.
+// A value of zero or less leaves the parameter unset so the API default
+// applies.
+//
+// The API rejects or silently truncates larger page sizes, so list calls
+// clamp instead: asking for 100 items from a method capped at 50 returns a
+// page of at most 50 items and a NextPageToken for the rest.
+func SetMaxResults(query url.Values, n, limit int) {
+	if n <= 0 {
+		return
+	}
+	query.Set("maxResults", strconv.Itoa(min(n, limit)))
+}
+
+// Paginate returns an iterator over the items of every page of a list call.
+// fetch is called with the empty string for the first page and with each
+// returned next page token after that; it should return the page's items
+// and the token for the following page:
+//
+//	items := core.Paginate(ctx, func(token string) ([]*data.PlaylistItem, string, error) {
+//		resp, err := data.GetPlaylistItems(ctx, client, &data.GetPlaylistItemsParams{
+//			PlaylistID: playlistID,
+//			MaxResults: core.MaxPageSize,
+//			PageToken:  token,
+//		})
+//		if err != nil {
+//			return nil, "", err
+//		}
+//		return resp.Items, resp.NextPageToken, nil
+//	})
+//	for item, err := range items {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(item.Snippet.Title)
+//	}
+//
+// Pages are fetched lazily, so breaking out of the loop stops further API
+// calls. Iteration ends after a page with an empty next page token, or one
+// that repeats the current token. If fetch fails, or ctx is done before a
+// page is fetched, the iterator yields the error with a zero item and
+// stops.
+func Paginate[T any](ctx context.Context, fetch func(pageToken string) (items []T, nextPageToken string, err error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		token := ""
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, next, err := fetch(token)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if next == "" || next == token {
+				return
+			}
+			token = next
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"testing"
+)
+
+func TestSetMaxResults(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		limit int
+		want  string // "" for unset
+	}{
+		{"zero", 0, MaxPageSize, ""},
+		{"negative", -5, MaxPageSize, ""},
+		{"minimum", 1, MaxPageSize, "1"},
+		{"in range", 25, MaxPageSize, "25"},
+		{"at limit", 50, MaxPageSize, "50"},
+		{"over limit", 100, MaxPageSize, "50"},
+		{"custom limit", 150, 100, "100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := url.Values{}
+			SetMaxResults(query, tt.n, tt.limit)
+			if got := query.Get("maxResults"); got != tt.want {
+				t.Errorf("maxResults = %q, want %q", got, tt.want)
+			}
+			if tt.want == "" && query.Has("maxResults") {
+				t.Error("maxResults set, want unset")
+			}
+		})
+	}
+}
+
+// fakePages serves pages keyed by page token and records the tokens it was
+// called with.
+type fakePages struct {
+	pages  map[string][]int
+	next   map[string]string
+	errAt  string
+	tokens []string
+}
+
+func (f *fakePages) fetch(token string) ([]int, string, error) {
+	f.tokens = append(f.tokens, token)
+	if token == f.errAt && f.errAt != "" {
+		return nil, "", errors.New("fetch failed")
+	}
+	return f.pages[token], f.next[token], nil
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pages      map[string][]int
+		next       map[string]string
+		errAt      string
+		wantItems  []int
+		wantTokens []string
+		wantErr    bool
+	}{
+		{
+			name:       "single page",
+			pages:      map[string][]int{"": {1, 2}},
+			wantItems:  []int{1, 2},
+			wantTokens: []string{""},
+		},
+		{
+			name:       "multiple pages",
+			pages:      map[string][]int{"": {1, 2}, "p2": {3}, "p3": {4, 5}},
+			next:       map[string]string{"": "p2", "p2": "p3"},
+			wantItems:  []int{1, 2, 3, 4, 5},
+			wantTokens: []string{"", "p2", "p3"},
+		},
+		{
+			name:       "empty page in the middle",
+			pages:      map[string][]int{"": {1}, "p3": {2}},
+			next:       map[string]string{"": "p2", "p2": "p3"},
+			wantItems:  []int{1, 2},
+			wantTokens: []string{"", "p2", "p3"},
+		},
+		{
+			name:       "repeated token stops",
+			pages:      map[string][]int{"": {1}, "p2": {2}},
+			next:       map[string]string{"": "p2", "p2": "p2"},
+			wantItems:  []int{1, 2},
+			wantTokens: []string{"", "p2"},
+		},
+		{
+			name:       "error on later page",
+			pages:      map[string][]int{"": {1, 2}},
+			next:       map[string]string{"": "p2"},
+			errAt:      "p2",
+			wantItems:  []int{1, 2},
+			wantTokens: []string{"", "p2"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakePages{pages: tt.pages, next: tt.next, errAt: tt.errAt}
+			var items []int
+			var gotErr error
+			for item, err := range Paginate(context.Background(), f.fetch) {
+				if err != nil {
+					gotErr = err
+					continue
+				}
+				items = append(items, item)
+			}
+			if !slices.Equal(items, tt.wantItems) {
+				t.Errorf("items = %v, want %v", items, tt.wantItems)
+			}
+			if !slices.Equal(f.tokens, tt.wantTokens) {
+				t.Errorf("tokens = %q, want %q", f.tokens, tt.wantTokens)
+			}
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPaginate_Break(t *testing.T) {
+	f := &fakePages{
+		pages: map[string][]int{"": {1, 2}, "p2": {3}},
+		next:  map[string]string{"": "p2"},
+	}
+	for item, err := range Paginate(context.Background(), f.fetch) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item == 2 {
+			break
+		}
+	}
+	if !slices.Equal(f.tokens, []string{""}) {
+		t.Errorf("tokens = %q, want only the first page", f.tokens)
+	}
+}
+
+func TestPaginate_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := &fakePages{
+		pages: map[string][]int{"": {1, 2}, "p2": {3}},
+		next:  map[string]string{"": "p2"},
+	}
+
+	var items []int
+	var gotErr error
+	for item, err := range Paginate(ctx, f.fetch) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		items = append(items, item)
+		cancel()
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", gotErr)
+	}
+	if !slices.Equal(items, []int{1, 2}) {
+		t.Errorf("items = %v, want the first page only", items)
+	}
+	if !slices.Equal(f.tokens, []string{""}) {
+		t.Errorf("tokens = %q, want only the first page", f.tokens)
+	}
+}
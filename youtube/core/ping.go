@@ -0,0 +1,100 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ConnectivityError indicates the API could not be reached: the request
+// failed in transit (DNS, TLS, refused or dropped connection, timeout) or
+// the API answered with a server error.
+type ConnectivityError struct {
+	// StatusCode is the HTTP status for server errors, or 0 if no response
+	// was received.
+	StatusCode int
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ConnectivityError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("youtube api: unavailable (status %d): %v", e.StatusCode, e.Err)
+	}
+	return fmt.Sprintf("youtube api: unreachable: %v", e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ConnectivityError) Unwrap() error {
+	return e.Err
+}
+
+// Ping verifies the client's credentials and connectivity with the
+// cheapest authenticated call, e.g. as a readiness probe at startup. With
+// an access token it lists the token owner's channel ID
+// (channels.list?part=id&mine=true); with only an API key it lists video
+// category IDs. Either call costs 1 quota unit and goes through the
+// client's middleware.
+//
+// Ping returns nil if the API accepted the credentials. Otherwise the error
+// is one of:
+//
+//   - *AuthError: no credentials are set, or the API rejected them (401,
+//     or a 403 other than a quota or rate limit)
+//   - *ConnectivityError: the API could not be reached or returned a 5xx
+//   - *QuotaError or *RateLimitError: the credentials work but cannot be
+//     used right now
+//
+// Cancellation of ctx is returned as is.
+func (c *Client) Ping(ctx context.Context) error {
+	var (
+		path      string
+		query     url.Values
+		operation string
+	)
+	switch {
+	case c.getAccessToken() != "":
+		path, query, operation = "channels", url.Values{"part": {"id"}, "mine": {"true"}}, "channels.list"
+	case c.apiKey != "":
+		path, query, operation = "videoCategories", url.Values{"part": {"id"}, "regionCode": {"US"}}, "videoCategories.list"
+	default:
+		return &AuthError{Code: "no_credentials", Message: "no access token or API key set"}
+	}
+
+	err := c.Get(ctx, path, query, operation, nil)
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+	return classifyPingError(err)
+}
+
+// classifyPingError maps a failed Ping request to an auth or connectivity
+// error.
+func classifyPingError(err error) error {
+	var (
+		apiErr   *APIError
+		urlErr   *url.Error
+		quotaErr *QuotaError
+		rateErr  *RateLimitError
+	)
+	switch {
+	case errors.As(err, &quotaErr), errors.As(err, &rateErr):
+		return err
+	case errors.As(err, &apiErr):
+		switch {
+		case apiErr.StatusCode == http.StatusUnauthorized, apiErr.StatusCode == http.StatusForbidden:
+			return &AuthError{Code: apiErr.Code, Message: apiErr.Message, Err: err}
+		case apiErr.StatusCode >= 500:
+			return &ConnectivityError{StatusCode: apiErr.StatusCode, Err: err}
+		}
+		return err
+	case errors.As(err, &urlErr), IsRetryable(err):
+		return &ConnectivityError{Err: err}
+	default:
+		return err
+	}
+}
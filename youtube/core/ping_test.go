@@ -0,0 +1,146 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_Ping(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []ClientOption
+		status    int
+		body      string
+		wantPath  string
+		wantQuery string
+		check     func(error) bool
+	}{
+		{
+			name:      "healthy with token",
+			opts:      []ClientOption{WithAccessToken("token")},
+			status:    http.StatusOK,
+			body:      `{"items": [{"id": "UC123"}]}`,
+			wantPath:  "/channels",
+			wantQuery: "mine=true&part=id",
+			check:     func(err error) bool { return err == nil },
+		},
+		{
+			name:      "healthy with API key",
+			opts:      []ClientOption{WithAPIKey("key")},
+			status:    http.StatusOK,
+			body:      `{"items": []}`,
+			wantPath:  "/videoCategories",
+			wantQuery: "key=key&part=id&regionCode=US",
+			check:     func(err error) bool { return err == nil },
+		},
+		{
+			name:   "invalid token",
+			opts:   []ClientOption{WithAccessToken("expired")},
+			status: http.StatusUnauthorized,
+			body:   `{"error": {"code": 401, "message": "Invalid Credentials", "errors": [{"reason": "authError"}]}}`,
+			check: func(err error) bool {
+				var (
+					authErr *AuthError
+					apiErr  *APIError
+				)
+				return errors.As(err, &authErr) && authErr.Code == "authError" &&
+					errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized
+			},
+		},
+		{
+			name:   "forbidden",
+			opts:   []ClientOption{WithAccessToken("token")},
+			status: http.StatusForbidden,
+			body:   `{"error": {"code": 403, "message": "Insufficient Permission", "errors": [{"reason": "insufficientPermissions"}]}}`,
+			check: func(err error) bool {
+				var authErr *AuthError
+				return errors.As(err, &authErr)
+			},
+		},
+		{
+			name:   "server error",
+			opts:   []ClientOption{WithAccessToken("token")},
+			status: http.StatusServiceUnavailable,
+			body:   `{"error": {"code": 503, "message": "Backend Error"}}`,
+			check: func(err error) bool {
+				var connErr *ConnectivityError
+				var apiErr *APIError
+				return errors.As(err, &connErr) && connErr.StatusCode == http.StatusServiceUnavailable && errors.As(err, &apiErr)
+			},
+		},
+		{
+			name:   "quota exceeded",
+			opts:   []ClientOption{WithAccessToken("token")},
+			status: http.StatusForbidden,
+			body:   `{"error": {"code": 403, "message": "Quota exceeded", "errors": [{"reason": "quotaExceeded"}]}}`,
+			check: func(err error) bool {
+				var quotaErr *QuotaError
+				return errors.As(err, &quotaErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.wantPath != "" && r.URL.Path != tt.wantPath {
+					t.Errorf("path = %s, want %s", r.URL.Path, tt.wantPath)
+				}
+				if tt.wantQuery != "" && r.URL.RawQuery != tt.wantQuery {
+					t.Errorf("query = %s, want %s", r.URL.RawQuery, tt.wantQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient(append([]ClientOption{WithBaseURL(server.URL)}, tt.opts...)...)
+			if err := client.Ping(context.Background()); !tt.check(err) {
+				t.Errorf("Ping() error = %#v", err)
+			}
+		})
+	}
+}
+
+func TestClient_Ping_NoCredentials(t *testing.T) {
+	client := NewClient(WithBaseURL("http://127.0.0.1:0"))
+
+	var authErr *AuthError
+	if err := client.Ping(context.Background()); !errors.As(err, &authErr) || authErr.Code != "no_credentials" {
+		t.Errorf("Ping() error = %v, want no_credentials AuthError", err)
+	}
+}
+
+func TestClient_Ping_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	addr := server.URL
+	server.Close()
+
+	client := NewClient(WithBaseURL(addr), WithAccessToken("token"))
+	err := client.Ping(context.Background())
+
+	var connErr *ConnectivityError
+	if !errors.As(err, &connErr) || connErr.StatusCode != 0 {
+		t.Errorf("Ping() error = %v, want ConnectivityError", err)
+	}
+}
+
+func TestClient_Ping_Canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewClient(WithBaseURL(server.URL), WithAccessToken("token"))
+	err := client.Ping(ctx)
+
+	var connErr *ConnectivityError
+	if !errors.Is(err, context.Canceled) || errors.As(err, &connErr) {
+		t.Errorf("Ping() error = %v, want context.Canceled", err)
+	}
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"maps"
 	"sync"
 	"time"
 )
@@ -29,6 +30,9 @@ var QuotaCosts = map[string]int{
 	"subscriptions.list": 1,
 	"comments.list":      1,
 	"commentThreads.list": 1,
+	"membershipsLevels.list": 1,
+	"members.list":           2,
+	"videoCategories.list":   1,
 
 	// Data API - Search (expensive!)
 	"search.list": 100,
@@ -37,6 +41,8 @@ var QuotaCosts = map[string]int{
 	"videos.insert":        1600, // Video upload
 	"videos.update":        50,
 	"videos.delete":        50,
+	"videos.rate":          50,
+	"videos.getRating":     1,
 	"playlists.insert":     50,
 	"playlists.update":     50,
 	"playlists.delete":     50,
@@ -61,6 +67,9 @@ var QuotaCosts = map[string]int{
 	"liveStreams.insert":        50,
 	"liveStreams.update":        50,
 	"liveStreams.delete":        50,
+
+	// Analytics API
+	"reports.query": 1,
 }
 
 // DefaultDailyQuota is the default daily quota for YouTube Data API projects.
@@ -73,6 +82,7 @@ type QuotaTracker struct {
 	used          int
 	limit         int
 	resetAt       time.Time
+	byOperation   map[string]int
 	handlers      map[uint64]func(used, limit int)
 	nextHandlerID uint64
 }
@@ -80,45 +90,71 @@ type QuotaTracker struct {
 // NewQuotaTracker creates a new QuotaTracker with the specified daily limit.
 func NewQuotaTracker(limit int) *QuotaTracker {
 	return &QuotaTracker{
-		limit:    limit,
-		resetAt:  nextPacificMidnight(),
-		handlers: make(map[uint64]func(used, limit int)),
+		limit:       limit,
+		resetAt:     nextPacificMidnight(),
+		byOperation: make(map[string]int),
+		handlers:    make(map[uint64]func(used, limit int)),
 	}
 }
 
-// Add records quota usage for an operation.
-// Returns the total used quota after this operation.
-func (q *QuotaTracker) Add(operation string, count int) int {
-	cost, ok := QuotaCosts[operation]
-	if !ok {
-		cost = 1 // Default cost for unknown operations
-	}
+// QuotaObserver is notified after each API request with the operation name
+// (e.g., "videos.list") and its quota cost in units. Pass the same observer
+// to core.WithQuotaObserver and analytics.WithQuotaObserver to see quota
+// across the Data, Live Streaming, and Analytics APIs in one place. The
+// Analytics API has its own quota, so do not share one QuotaTracker that
+// way.
+//
+// Implementations must be safe for concurrent use.
+type QuotaObserver interface {
+	ObserveQuota(operation string, cost int)
+}
 
-	q.mu.Lock()
-	q.checkReset()
-	q.used += cost * count
-	used, limit := q.used, q.limit
-	// Snapshot handlers to call outside lock (prevents deadlock)
-	handlers := make([]func(int, int), 0, len(q.handlers))
-	for _, h := range q.handlers {
-		handlers = append(handlers, h)
-	}
-	q.mu.Unlock()
+// QuotaObserverFunc adapts an ordinary function to a QuotaObserver.
+type QuotaObserverFunc func(operation string, cost int)
 
-	// Notify handlers outside lock
-	for _, h := range handlers {
-		h(used, limit)
+// ObserveQuota calls f(operation, cost).
+func (f QuotaObserverFunc) ObserveQuota(operation string, cost int) {
+	f(operation, cost)
+}
+
+// ObserveQuota implements QuotaObserver, making a QuotaTracker the default
+// observer. The cost is recorded under operation (see UsageByOperation). Do
+// not also install the tracker with WithQuotaTracker on the same client, or
+// each request is counted twice.
+func (q *QuotaTracker) ObserveQuota(operation string, cost int) {
+	q.record(operation, cost)
+}
+
+// OperationCost returns the quota cost of one call of operation.
+// Unknown operations cost 1 unit.
+func OperationCost(operation string) int {
+	if cost, ok := QuotaCosts[operation]; ok {
+		return cost
 	}
+	return 1
+}
 
-	return used
+// Add records quota usage for an operation.
+// Returns the total used quota after this operation.
+func (q *QuotaTracker) Add(operation string, count int) int {
+	return q.record(operation, OperationCost(operation)*count)
 }
 
 // AddCost records a specific quota cost.
 // Returns the total used quota after this operation.
 func (q *QuotaTracker) AddCost(cost int) int {
+	return q.record("", cost)
+}
+
+// record adds cost to the usage, attributed to operation if not empty, and
+// notifies the usage handlers. Returns the total used quota.
+func (q *QuotaTracker) record(operation string, cost int) int {
 	q.mu.Lock()
 	q.checkReset()
 	q.used += cost
+	if operation != "" {
+		q.byOperation[operation] += cost
+	}
 	used, limit := q.used, q.limit
 	// Snapshot handlers to call outside lock (prevents deadlock)
 	handlers := make([]func(int, int), 0, len(q.handlers))
@@ -142,6 +178,16 @@ func (q *QuotaTracker) Used() int {
 	return q.used
 }
 
+// UsageByOperation returns today's quota usage per operation name (e.g.,
+// "videos.list"). Costs recorded with AddCost have no operation and are
+// counted only in Used.
+func (q *QuotaTracker) UsageByOperation() map[string]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.checkReset()
+	return maps.Clone(q.byOperation)
+}
+
 // Limit returns the daily quota limit.
 func (q *QuotaTracker) Limit() int {
 	q.mu.RLock()
@@ -180,6 +226,7 @@ func (q *QuotaTracker) Reset() {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 	q.used = 0
+	clear(q.byOperation)
 	q.resetAt = nextPacificMidnight()
 }
 
@@ -206,6 +253,7 @@ func (q *QuotaTracker) checkReset() {
 	now := time.Now()
 	if now.After(q.resetAt) {
 		q.used = 0
+		clear(q.byOperation)
 		q.resetAt = nextPacificMidnight()
 	}
 }
@@ -238,11 +286,52 @@ func nextPacificMidnight() time.Time {
 func EstimateCost(operations map[string]int) int {
 	total := 0
 	for op, count := range operations {
-		cost, ok := QuotaCosts[op]
-		if !ok {
-			cost = 1
-		}
-		total += cost * count
+		total += OperationCost(op) * count
 	}
 	return total
 }
+
+// Operation is a planned number of calls to one API operation.
+type Operation struct {
+	// Name is the operation name (e.g., "videos.list"), as in QuotaCosts.
+	Name string
+
+	// Count is the number of calls.
+	Count int
+}
+
+// CostEstimate is the projected quota cost of a set of planned operations.
+type CostEstimate struct {
+	// Total is the total cost in quota units.
+	Total int
+
+	// ByOperation is the cost in quota units per operation name.
+	ByOperation map[string]int
+}
+
+// Fits reports whether the estimate fits within the given quota units,
+// e.g. a QuotaTracker's Remaining.
+func (e *CostEstimate) Fits(units int) bool {
+	return e.Total <= units
+}
+
+// EstimateOperations calculates the quota cost of planned operations, using
+// the same costs as quota tracking (1 unit for unknown operations).
+// Operations listed more than once are combined in the breakdown.
+//
+//	est := core.EstimateOperations([]core.Operation{
+//		{Name: "search.list", Count: 20},
+//		{Name: "videos.list", Count: 500},
+//	})
+//	if !est.Fits(tracker.Remaining()) {
+//		log.Fatalf("job needs %d units: %v", est.Total, est.ByOperation)
+//	}
+func EstimateOperations(operations []Operation) *CostEstimate {
+	est := &CostEstimate{ByOperation: make(map[string]int, len(operations))}
+	for _, op := range operations {
+		cost := OperationCost(op.Name) * op.Count
+		est.ByOperation[op.Name] += cost
+		est.Total += cost
+	}
+	return est
+}
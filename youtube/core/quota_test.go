@@ -1,6 +1,7 @@
 package core
 
 import (
+	"maps"
 	"sync"
 	"testing"
 	"time"
@@ -210,6 +211,111 @@ func TestEstimateCost(t *testing.T) {
 	}
 }
 
+func TestEstimateOperations(t *testing.T) {
+	tests := []struct {
+		name     string
+		ops      []Operation
+		want     int
+		wantByOp map[string]int
+	}{
+		{
+			name: "mixed operations",
+			ops: []Operation{
+				{Name: "videos.list", Count: 10},
+				{Name: "search.list", Count: 2},
+				{Name: "unknown.op", Count: 5},
+			},
+			want:     215,
+			wantByOp: map[string]int{"videos.list": 10, "search.list": 200, "unknown.op": 5},
+		},
+		{
+			name: "repeated operation combined",
+			ops: []Operation{
+				{Name: "liveChatMessages.insert", Count: 2},
+				{Name: "liveChatMessages.insert", Count: 1},
+			},
+			want:     150,
+			wantByOp: map[string]int{"liveChatMessages.insert": 150},
+		},
+		{
+			name:     "empty",
+			want:     0,
+			wantByOp: map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			est := EstimateOperations(tt.ops)
+			if est.Total != tt.want {
+				t.Errorf("Total = %d, want %d", est.Total, tt.want)
+			}
+			if !maps.Equal(est.ByOperation, tt.wantByOp) {
+				t.Errorf("ByOperation = %v, want %v", est.ByOperation, tt.wantByOp)
+			}
+		})
+	}
+}
+
+func TestCostEstimate_Fits(t *testing.T) {
+	est := EstimateOperations([]Operation{{Name: "search.list", Count: 100}})
+
+	if !est.Fits(DefaultDailyQuota) {
+		t.Error("Fits(10000) = false for 10000 units, want true")
+	}
+	if est.Fits(DefaultDailyQuota - 1) {
+		t.Error("Fits(9999) = true for 10000 units, want false")
+	}
+}
+
+func TestQuotaTracker_ObserveQuota(t *testing.T) {
+	qt := NewQuotaTracker(10000)
+
+	var observer QuotaObserver = qt
+	observer.ObserveQuota("search.list", 100)
+	observer.ObserveQuota("reports.query", 1)
+
+	if qt.Used() != 101 {
+		t.Errorf("Used() = %d, want 101", qt.Used())
+	}
+
+	observer.ObserveQuota("search.list", 100)
+	qt.Add("videos.list", 2)
+	qt.AddCost(7) // unattributed
+	want := map[string]int{"search.list": 200, "reports.query": 1, "videos.list": 2}
+	if got := qt.UsageByOperation(); !maps.Equal(got, want) {
+		t.Errorf("UsageByOperation() = %v, want %v", got, want)
+	}
+	if qt.Used() != 210 {
+		t.Errorf("Used() = %d, want 210", qt.Used())
+	}
+
+	qt.Reset()
+	if got := qt.UsageByOperation(); len(got) != 0 {
+		t.Errorf("UsageByOperation() after Reset = %v, want empty", got)
+	}
+}
+
+func TestOperationCost(t *testing.T) {
+	tests := []struct {
+		operation string
+		want      int
+	}{
+		{"videos.list", 1},
+		{"search.list", 100},
+		{"liveChatMessages.insert", 50},
+		{"unknown.op", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			if got := OperationCost(tt.operation); got != tt.want {
+				t.Errorf("OperationCost(%q) = %d, want %d", tt.operation, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNextPacificMidnight(t *testing.T) {
 	midnight := nextPacificMidnight()
 
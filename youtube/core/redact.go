@@ -0,0 +1,88 @@
+package core
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// DefaultRedactedFields are the fields LoggingMiddleware redacts by default:
+// the Authorization header (and any bearer token), the API key query
+// parameter, and OAuth token and secret fields.
+var DefaultRedactedFields = []string{"authorization", "key", "access_token", "refresh_token", "client_secret"}
+
+// redactedValue replaces a redacted value in log output.
+const redactedValue = "REDACTED"
+
+// WithRedactedFields adds fields to redact from log output, on top of
+// DefaultRedactedFields. Field names are matched case-insensitively as
+// query or form parameters ("name=value"), JSON fields ("name": "value"),
+// and Go map keys (name:value).
+func WithRedactedFields(fields ...string) LoggingOption {
+	return func(m *LoggingMiddleware) {
+		for _, f := range fields {
+			m.redacted[strings.ToLower(f)] = true
+		}
+	}
+}
+
+// WithUnredactedFields logs the given fields in the clear, e.g.
+// WithUnredactedFields("key") while debugging API key problems. Do not
+// leave it enabled where logs are collected.
+func WithUnredactedFields(fields ...string) LoggingOption {
+	return func(m *LoggingMiddleware) {
+		for _, f := range fields {
+			delete(m.redacted, strings.ToLower(f))
+		}
+	}
+}
+
+// redactor removes secret values from log output.
+type redactor struct {
+	patterns []*regexp.Regexp
+}
+
+// newRedactor returns a redactor for the given field names, or nil if there
+// are none.
+func newRedactor(fields map[string]bool) *redactor {
+	names := make([]string, 0, len(fields))
+	for f := range fields {
+		if f != "" {
+			names = append(names, regexp.QuoteMeta(f))
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	slices.Sort(names)
+	alt := "(?:" + strings.Join(names, "|") + ")"
+
+	r := &redactor{}
+	if fields["authorization"] {
+		// Header values, with or without an auth scheme, and bare bearer tokens
+		r.patterns = append(r.patterns,
+			regexp.MustCompile(`(?i)(authorization["']?\s*[:=]\s*["']?(?:(?:bearer|basic)\s+)?)[^\s"',}\]]+`),
+			regexp.MustCompile(`(?i)(\bbearer\s+)[A-Za-z0-9\-._~+/]+=*`),
+		)
+	}
+	r.patterns = append(r.patterns,
+		// Query and form parameters: ?key=value, &access_token=value
+		regexp.MustCompile(`(?i)((?:^|[?&;\s"])`+alt+`=)[^&\s"'#]*`),
+		// JSON fields: "access_token": "value"
+		regexp.MustCompile(`(?i)("`+alt+`"\s*:\s*")(?:[^"\\]|\\.)*`),
+		// Go maps formatted with %v: map[access_token:value]
+		regexp.MustCompile(`(?i)((?:^|[\[\s{,])`+alt+`:)[^\s\]},]+`),
+	)
+	return r
+}
+
+// redact replaces the values of redacted fields in s.
+func (r *redactor) redact(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, p := range r.patterns {
+		s = p.ReplaceAllString(s, "${1}"+redactedValue)
+	}
+	return s
+}
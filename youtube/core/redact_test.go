@@ -0,0 +1,160 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactor(t *testing.T) {
+	r := newRedactor(map[string]bool{
+		"authorization": true, "key": true, "access_token": true,
+		"refresh_token": true, "client_secret": true,
+	})
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "query key",
+			in:   `Get "https://www.googleapis.com/youtube/v3/videos?id=abc&key=AIzaSecret&part=snippet": dial tcp: connection refused`,
+			want: `Get "https://www.googleapis.com/youtube/v3/videos?id=abc&key=REDACTED&part=snippet": dial tcp: connection refused`,
+		},
+		{
+			name: "first query parameter",
+			in:   "/videos?access_token=ya29.secret",
+			want: "/videos?access_token=REDACTED",
+		},
+		{
+			name: "form body",
+			in:   "client_secret=s3cret&grant_type=refresh_token&refresh_token=1//abc",
+			want: "client_secret=REDACTED&grant_type=refresh_token&refresh_token=REDACTED",
+		},
+		{
+			name: "JSON",
+			in:   `{"access_token": "ya29.secret", "expires_in": 3599, "refresh_token":"1//abc\"def"}`,
+			want: `{"access_token": "REDACTED", "expires_in": 3599, "refresh_token":"REDACTED"}`,
+		},
+		{
+			name: "Go map",
+			in:   "map[access_token:ya29.secret text:hello]",
+			want: "map[access_token:REDACTED text:hello]",
+		},
+		{
+			name: "authorization header",
+			in:   "Authorization: Bearer ya29.secret-token",
+			want: "Authorization: Bearer REDACTED",
+		},
+		{
+			name: "authorization without scheme",
+			in:   `"Authorization":"ya29.secret"`,
+			want: `"Authorization":"REDACTED"`,
+		},
+		{
+			name: "bare bearer token",
+			in:   "token rejected: bearer ya29.a0Af/x+y==",
+			want: "token rejected: bearer REDACTED",
+		},
+		{
+			name: "similar names untouched",
+			in:   "/search?keyword=cats&monkey=1&apikey=x&pageToken=CAUQAA",
+			want: "/search?keyword=cats&monkey=1&apikey=x&pageToken=CAUQAA",
+		},
+		{
+			name: "plain text untouched",
+			in:   "youtube api: forbidden (403): The request is missing a valid API key.",
+			want: "youtube api: forbidden (403): The request is missing a valid API key.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.redact(tt.in); got != tt.want {
+				t.Errorf("redact() =\n  %s\nwant\n  %s", got, tt.want)
+			}
+		})
+	}
+
+	var none *redactor
+	if got := none.redact("key=abc"); got != "key=abc" {
+		t.Errorf("nil redactor changed input: %q", got)
+	}
+	if newRedactor(map[string]bool{}) != nil {
+		t.Error("newRedactor() with no fields should return nil")
+	}
+}
+
+// formatLogger records formatted log lines.
+type formatLogger struct {
+	lines []string
+}
+
+func (l *formatLogger) Printf(format string, v ...any) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestLoggingMiddleware_Redaction(t *testing.T) {
+	failing := func(ctx context.Context, req *Request) error {
+		return fmt.Errorf("executing request: %w", &url.Error{
+			Op:  "Get",
+			URL: "https://www.googleapis.com/youtube/v3/videos?key=AIzaSecret",
+			Err: errors.New("connection refused"),
+		})
+	}
+	req := &Request{
+		Method: "POST",
+		Path:   "liveChat/messages",
+		Body:   map[string]string{"access_token": "ya29.secret", "session": "s1"},
+	}
+
+	tests := []struct {
+		name      string
+		opts      []LoggingOption
+		redacted  []string
+		unchanged []string
+	}{
+		{
+			name:     "default",
+			redacted: []string{"key=REDACTED", "access_token:REDACTED"},
+		},
+		{
+			name:     "extra field",
+			opts:     []LoggingOption{WithRedactedFields("Session")},
+			redacted: []string{"key=REDACTED", "access_token:REDACTED", "session:REDACTED"},
+		},
+		{
+			name:      "opted back in",
+			opts:      []LoggingOption{WithUnredactedFields("KEY")},
+			redacted:  []string{"access_token:REDACTED"},
+			unchanged: []string{"key=AIzaSecret"},
+		},
+		{
+			name:      "all opted back in",
+			opts:      []LoggingOption{WithUnredactedFields(DefaultRedactedFields...)},
+			unchanged: []string{"key=AIzaSecret", "access_token:ya29.secret"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &formatLogger{}
+			opts := append([]LoggingOption{WithLogger(logger), WithLogBody(true)}, tt.opts...)
+			_ = NewLoggingMiddleware(opts...)(context.Background(), req, failing)
+
+			out := strings.Join(logger.lines, "\n")
+			for _, s := range append(tt.redacted, tt.unchanged...) {
+				if !strings.Contains(out, s) {
+					t.Errorf("log missing %q:\n%s", s, out)
+				}
+			}
+			if len(tt.unchanged) == 0 && (strings.Contains(out, "AIzaSecret") || strings.Contains(out, "ya29.secret")) {
+				t.Errorf("secret leaked to log:\n%s", out)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Runnable is a background loop with a start/stop lifecycle, such as a
+// streaming.LiveChatPoller, streaming.LiveChatStream, or data.StatsWatcher.
+//
+// Start launches the loop and returns without blocking; the loop runs until
+// Stop is called or ctx is cancelled. Stop shuts the loop down and waits for
+// it to exit.
+type Runnable interface {
+	Start(ctx context.Context) error
+	Stop()
+}
+
+// Finisher is implemented by Runnables that can stop on their own, such as
+// a streaming.LiveChatPoller that stops when the chat ends. RunGroup watches
+// Done and shuts the group down when a member finishes.
+//
+// Done returns a channel that is closed when the loop started by the last
+// Start exits, whatever the reason. Err returns the error that stopped the
+// loop, or nil if it was stopped or cancelled.
+type Finisher interface {
+	Done() <-chan struct{}
+	Err() error
+}
+
+// runnableFuncs adapts a start and stop function to a Runnable.
+type runnableFuncs struct {
+	start func(context.Context) error
+	stop  func()
+}
+
+func (r *runnableFuncs) Start(ctx context.Context) error { return r.start(ctx) }
+func (r *runnableFuncs) Stop()                           { r.stop() }
+
+// NewRunnable adapts a start and stop function to a Runnable, e.g. for types
+// with a different lifecycle method naming:
+//
+//	core.NewRunnable(bot.Connect, func() { _ = bot.Close() })
+func NewRunnable(start func(ctx context.Context) error, stop func()) Runnable {
+	return &runnableFuncs{start: start, stop: stop}
+}
+
+// RunGroup starts several Runnables with a shared context and shuts them all
+// down together. A fatal error (a failed Start, a member implementing
+// Finisher that stops with an error, or a function passed to Go returning
+// an error) cancels the group's context, stopping the rest. So does a
+// Finisher member stopping on its own without an error.
+//
+//	group := core.NewRunGroup(ctx)
+//	if err := group.Add(poller, stream, watcher); err != nil {
+//		log.Fatal(err)
+//	}
+//	err := group.Wait() // until ctx is cancelled or a member fails
+//
+// RunGroup is safe for concurrent use.
+type RunGroup struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu        sync.Mutex
+	runnables []Runnable
+	errs      []error
+	stopped   bool
+
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+}
+
+// NewRunGroup creates a RunGroup whose members run until ctx is cancelled,
+// Stop is called, or a member fails.
+func NewRunGroup(ctx context.Context) *RunGroup {
+	groupCtx, cancel := context.WithCancel(ctx)
+	return &RunGroup{
+		ctx:    groupCtx,
+		cancel: cancel,
+	}
+}
+
+// Context returns the context shared by the group's members. It is
+// cancelled when the group shuts down.
+func (g *RunGroup) Context() context.Context {
+	return g.ctx
+}
+
+// Add starts each runnable with the group's context, in order. If a Start
+// fails, the error is recorded as a fatal error, the group is cancelled, and
+// the error is returned without starting the remaining runnables. Returns
+// ErrNotRunning if the group has already shut down.
+func (g *RunGroup) Add(runnables ...Runnable) error {
+	for _, r := range runnables {
+		if err := g.start(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// start starts a single runnable and tracks it for shutdown.
+func (g *RunGroup) start(r Runnable) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.stopped || g.ctx.Err() != nil {
+		return ErrNotRunning
+	}
+	if err := r.Start(g.ctx); err != nil {
+		g.errs = append(g.errs, err)
+		g.cancel()
+		return err
+	}
+	g.runnables = append(g.runnables, r)
+
+	if f, ok := r.(Finisher); ok {
+		if done := f.Done(); done != nil {
+			g.wg.Add(1)
+			go g.watch(f, done)
+		}
+	}
+	return nil
+}
+
+// watch shuts the group down when a member finishes on its own.
+func (g *RunGroup) watch(f Finisher, done <-chan struct{}) {
+	defer g.wg.Done()
+	select {
+	case <-done:
+	case <-g.ctx.Done():
+		return
+	}
+	if err := f.Err(); err != nil && !errors.Is(err, context.Canceled) {
+		g.fail(err)
+		return
+	}
+	g.cancel()
+}
+
+// Go runs a blocking function in a new goroutine with the group's context.
+// A non-nil error other than context cancellation is recorded as a fatal
+// error and cancels the group. Returns ErrNotRunning if the group has
+// already shut down.
+func (g *RunGroup) Go(fn func(ctx context.Context) error) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.stopped || g.ctx.Err() != nil {
+		return ErrNotRunning
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := fn(g.ctx); err != nil && !errors.Is(err, context.Canceled) {
+			g.fail(err)
+		}
+	}()
+	return nil
+}
+
+// fail records a fatal error and cancels the group.
+func (g *RunGroup) fail(err error) {
+	g.mu.Lock()
+	g.errs = append(g.errs, err)
+	g.mu.Unlock()
+	g.cancel()
+}
+
+// Wait blocks until the group's context is cancelled (by the parent context,
+// Stop, a fatal error, or a Finisher member stopping), then stops every
+// member and returns the fatal errors joined with errors.Join, or nil if
+// there were none.
+func (g *RunGroup) Wait() error {
+	<-g.ctx.Done()
+	return g.shutdown()
+}
+
+// Stop cancels the group, stops every member, and waits for them to exit.
+// Returns the fatal errors joined with errors.Join, if any. Safe to call
+// multiple times.
+func (g *RunGroup) Stop() error {
+	g.cancel()
+	return g.shutdown()
+}
+
+// shutdown stops the runnables in reverse start order and waits for
+// functions passed to Go to return.
+func (g *RunGroup) shutdown() error {
+	g.stopOnce.Do(func() {
+		g.mu.Lock()
+		g.stopped = true
+		runnables := g.runnables
+		g.mu.Unlock()
+
+		for i := len(runnables) - 1; i >= 0; i-- {
+			runnables[i].Stop()
+		}
+		g.wg.Wait()
+	})
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return errors.Join(g.errs...)
+}
@@ -0,0 +1,267 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRunnable runs a loop until its context is cancelled or Stop is called.
+type fakeRunnable struct {
+	name     string
+	startErr error
+	log      *eventLog
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type eventLog struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (l *eventLog) add(event string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, event)
+}
+
+func (l *eventLog) list() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.events)
+}
+
+func (f *fakeRunnable) Start(ctx context.Context) error {
+	if f.startErr != nil {
+		return f.startErr
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	loopCtx, cancel := context.WithCancel(ctx)
+	f.cancel = cancel
+	f.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		<-loopCtx.Done()
+	}(f.done)
+	f.log.add("start " + f.name)
+	return nil
+}
+
+func (f *fakeRunnable) Stop() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.cancel == nil {
+		return
+	}
+	f.cancel()
+	<-f.done
+	f.cancel = nil
+	f.log.add("stop " + f.name)
+}
+
+func TestRunGroup_StopOrder(t *testing.T) {
+	log := &eventLog{}
+	a := &fakeRunnable{name: "a", log: log}
+	b := &fakeRunnable{name: "b", log: log}
+
+	group := NewRunGroup(context.Background())
+	if err := group.Add(a, b); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := group.Stop(); err != nil {
+		t.Errorf("Stop() error = %v", err)
+	}
+	if err := group.Stop(); err != nil { // idempotent
+		t.Errorf("second Stop() error = %v", err)
+	}
+
+	want := []string{"start a", "start b", "stop b", "stop a"}
+	if got := log.list(); !slices.Equal(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+	if group.Context().Err() == nil {
+		t.Error("group context not cancelled after Stop()")
+	}
+	if err := group.Add(&fakeRunnable{name: "c", log: log}); !errors.Is(err, ErrNotRunning) {
+		t.Errorf("Add() after Stop() error = %v, want ErrNotRunning", err)
+	}
+	if err := group.Go(func(context.Context) error { return nil }); !errors.Is(err, ErrNotRunning) {
+		t.Errorf("Go() after Stop() error = %v, want ErrNotRunning", err)
+	}
+}
+
+func TestRunGroup_StartError(t *testing.T) {
+	log := &eventLog{}
+	startErr := errors.New("bad config")
+	a := &fakeRunnable{name: "a", log: log}
+	b := &fakeRunnable{name: "b", log: log, startErr: startErr}
+	c := &fakeRunnable{name: "c", log: log}
+
+	group := NewRunGroup(context.Background())
+	if err := group.Add(a, b, c); !errors.Is(err, startErr) {
+		t.Fatalf("Add() error = %v, want %v", err, startErr)
+	}
+	if err := group.Wait(); !errors.Is(err, startErr) {
+		t.Errorf("Wait() error = %v, want %v", err, startErr)
+	}
+
+	want := []string{"start a", "stop a"}
+	if got := log.list(); !slices.Equal(got, want) {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+func TestRunGroup_GoFatalError(t *testing.T) {
+	log := &eventLog{}
+	a := &fakeRunnable{name: "a", log: log}
+	fatal := errors.New("chat ended")
+
+	group := NewRunGroup(context.Background())
+	_ = group.Add(a)
+
+	cancelled := make(chan struct{})
+	_ = group.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		close(cancelled)
+		return ctx.Err() // not treated as fatal
+	})
+	_ = group.Go(func(ctx context.Context) error {
+		return fatal
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- group.Wait() }()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, fatal) {
+			t.Errorf("Wait() error = %v, want %v", err, fatal)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Wait() did not return after fatal error")
+	}
+
+	select {
+	case <-cancelled:
+	default:
+		t.Error("other Go function was not cancelled before Wait() returned")
+	}
+	if got := log.list(); !slices.Contains(got, "stop a") {
+		t.Errorf("events = %v, want runnable stopped", got)
+	}
+}
+
+// finishingRunnable is a fakeRunnable that can end on its own.
+type finishingRunnable struct {
+	fakeRunnable
+	finished chan struct{}
+	err      error
+}
+
+func (f *finishingRunnable) Done() <-chan struct{} { return f.finished }
+func (f *finishingRunnable) Err() error            { return f.err }
+
+func TestRunGroup_MemberFinishes(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{"fatal error", errors.New("chat ended"), true},
+		{"no error", nil, false},
+		{"cancelled", context.Canceled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := &eventLog{}
+			a := &fakeRunnable{name: "a", log: log}
+			b := &finishingRunnable{
+				fakeRunnable: fakeRunnable{name: "b", log: log},
+				finished:     make(chan struct{}),
+				err:          tt.err,
+			}
+
+			group := NewRunGroup(context.Background())
+			if err := group.Add(a, b); err != nil {
+				t.Fatalf("Add() error = %v", err)
+			}
+			close(b.finished)
+
+			done := make(chan error, 1)
+			go func() { done <- group.Wait() }()
+
+			select {
+			case err := <-done:
+				if tt.wantErr && !errors.Is(err, tt.err) || !tt.wantErr && err != nil {
+					t.Errorf("Wait() error = %v, want %v", err, tt.err)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("Wait() did not return after a member finished")
+			}
+			if got := log.list(); !slices.Contains(got, "stop a") {
+				t.Errorf("events = %v, want other members stopped", got)
+			}
+		})
+	}
+}
+
+func TestRunGroup_JoinsErrors(t *testing.T) {
+	first := errors.New("chat ended")
+	second := errors.New("dashboard failed")
+
+	group := NewRunGroup(context.Background())
+	_ = group.Go(func(ctx context.Context) error {
+		return first
+	})
+	_ = group.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		return second
+	})
+
+	err := group.Wait()
+	if !errors.Is(err, first) || !errors.Is(err, second) {
+		t.Errorf("Wait() error = %v, want both errors", err)
+	}
+}
+
+func TestRunGroup_ParentCancel(t *testing.T) {
+	log := &eventLog{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	group := NewRunGroup(ctx)
+	_ = group.Add(&fakeRunnable{name: "a", log: log})
+	cancel()
+
+	if err := group.Wait(); err != nil {
+		t.Errorf("Wait() error = %v, want nil", err)
+	}
+	if got := log.list(); !slices.Equal(got, []string{"start a", "stop a"}) {
+		t.Errorf("events = %v", got)
+	}
+}
+
+func TestNewRunnable(t *testing.T) {
+	var started, stopped bool
+	r := NewRunnable(
+		func(context.Context) error { started = true; return nil },
+		func() { stopped = true },
+	)
+
+	group := NewRunGroup(context.Background())
+	if err := group.Add(r); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	_ = group.Stop()
+
+	if !started || !stopped {
+		t.Errorf("started = %v, stopped = %v, want both true", started, stopped)
+	}
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// requestTimeoutCtxKey is the context key for request timeouts.
+type requestTimeoutCtxKey struct{}
+
+// WithRequestTimeout returns a context whose API calls use timeout d per
+// attempt instead of the client's HTTP timeout (DefaultTimeout, or the
+// Timeout of the client passed to WithHTTPClient). Use it to give slow calls
+// more time, or fast calls less, without changing the client:
+//
+//	slowCtx := core.WithRequestTimeout(ctx, 5*time.Minute)
+//	resp, err := data.Search(slowCtx, client, params)
+//
+// A deadline already on ctx still applies; each attempt ends at whichever
+// comes first. A d of zero or less removes the client timeout, leaving only
+// ctx's own deadline.
+func WithRequestTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutCtxKey{}, d)
+}
+
+// RequestTimeout returns the request timeout stored in ctx by
+// WithRequestTimeout. The bool is false if none is set.
+func RequestTimeout(ctx context.Context) (time.Duration, bool) {
+	if ctx == nil {
+		return 0, false
+	}
+	d, ok := ctx.Value(requestTimeoutCtxKey{}).(time.Duration)
+	return d, ok
+}
+
+// applyRequestTimeout returns the context and HTTP client for one attempt.
+// With a request timeout in ctx, the attempt is bounded by a context
+// deadline and hc's own Timeout is disabled so it cannot cut the attempt
+// short. The returned cancel func must be called when the attempt is done.
+func applyRequestTimeout(ctx context.Context, hc *http.Client) (context.Context, *http.Client, context.CancelFunc) {
+	d, ok := RequestTimeout(ctx)
+	if !ok {
+		return ctx, hc, func() {}
+	}
+	if hc.Timeout != 0 {
+		copied := *hc
+		copied.Timeout = 0
+		hc = &copied
+	}
+	if d <= 0 {
+		return ctx, hc, func() {}
+	}
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, hc, cancel
+}
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(80 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		clientTimeout time.Duration
+		ctx           func() (context.Context, context.CancelFunc)
+		wantErr       bool
+	}{
+		{
+			name:          "client timeout applies without override",
+			clientTimeout: 20 * time.Millisecond,
+			ctx:           func() (context.Context, context.CancelFunc) { return context.Background(), func() {} },
+			wantErr:       true,
+		},
+		{
+			name:          "longer request timeout overrides client timeout",
+			clientTimeout: 20 * time.Millisecond,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return WithRequestTimeout(context.Background(), 2*time.Second), func() {}
+			},
+		},
+		{
+			name:          "zero request timeout removes client timeout",
+			clientTimeout: 20 * time.Millisecond,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return WithRequestTimeout(context.Background(), 0), func() {}
+			},
+		},
+		{
+			name:          "shorter request timeout",
+			clientTimeout: 2 * time.Second,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return WithRequestTimeout(context.Background(), 20*time.Millisecond), func() {}
+			},
+			wantErr: true,
+		},
+		{
+			name:          "caller deadline shorter than request timeout",
+			clientTimeout: 2 * time.Second,
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+				return WithRequestTimeout(ctx, 2*time.Second), cancel
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := &http.Client{Timeout: tt.clientTimeout}
+			client := NewClient(WithBaseURL(server.URL), WithHTTPClient(hc))
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			start := time.Now()
+			err := client.Get(ctx, "videos", nil, "videos.list", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && time.Since(start) > time.Second {
+				t.Errorf("request took %v, want it cut short", time.Since(start))
+			}
+			if hc.Timeout != tt.clientTimeout {
+				t.Errorf("client Timeout changed to %v", hc.Timeout)
+			}
+		})
+	}
+}
+
+func TestWithRequestTimeout_DeadlineExceeded(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	ctx := WithRequestTimeout(context.Background(), 10*time.Millisecond)
+
+	err := client.Get(ctx, "videos", nil, "videos.list", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	if _, ok := RequestTimeout(context.Background()); ok {
+		t.Error("RequestTimeout() ok = true for plain context")
+	}
+
+	ctx := WithRequestTimeout(context.Background(), time.Minute)
+	if d, ok := RequestTimeout(ctx); !ok || d != time.Minute {
+		t.Errorf("RequestTimeout() = %v, %v; want 1m, true", d, ok)
+	}
+}
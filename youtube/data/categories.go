@@ -0,0 +1,154 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// VideoCategory represents a YouTube video category resource.
+type VideoCategory struct {
+	// Kind is the resource type (youtube#videoCategory).
+	Kind string `json:"kind,omitempty"`
+
+	// ETag is the entity tag.
+	ETag string `json:"etag,omitempty"`
+
+	// ID is the category's unique identifier, as found in
+	// VideoSnippet.CategoryID.
+	ID string `json:"id,omitempty"`
+
+	// Snippet contains basic details about the category.
+	Snippet *VideoCategorySnippet `json:"snippet,omitempty"`
+}
+
+// VideoCategorySnippet contains basic details about a video category.
+type VideoCategorySnippet struct {
+	// ChannelID is the channel that created the category (YouTube).
+	ChannelID string `json:"channelId,omitempty"`
+
+	// Title is the category's name, e.g. "Gaming".
+	Title string `json:"title,omitempty"`
+
+	// Assignable indicates if videos can be uploaded into the category.
+	Assignable bool `json:"assignable,omitempty"`
+}
+
+// VideoCategoryListResponse is the response from videoCategories.list.
+type VideoCategoryListResponse struct {
+	// Kind is the resource type.
+	Kind string `json:"kind,omitempty"`
+
+	// ETag is the entity tag.
+	ETag string `json:"etag,omitempty"`
+
+	// Items contains the video category resources.
+	Items []*VideoCategory `json:"items,omitempty"`
+}
+
+// categoryCacheTTL is how long cached category lists are kept. Categories
+// almost never change.
+const categoryCacheTTL = 24 * time.Hour
+
+// categoryCacheKeyPrefix namespaces GetVideoCategories entries in a shared
+// core.Cache.
+const categoryCacheKeyPrefix = "videoCategories:"
+
+// categoryConfig holds GetVideoCategories settings.
+type categoryConfig struct {
+	cache *core.Cache
+}
+
+// CategoryOption configures GetVideoCategories.
+type CategoryOption func(*categoryConfig)
+
+// WithCategoryCache caches category lists per region in cache for a day,
+// so repeated calls are free. The entries are namespaced, so the cache can
+// be shared with other uses.
+func WithCategoryCache(cache *core.Cache) CategoryOption {
+	return func(c *categoryConfig) { c.cache = cache }
+}
+
+// GetVideoCategories retrieves the video categories available in a region,
+// identified by its ISO 3166-1 alpha-2 country code (e.g. "US"). Use it with
+// Video.CategoryName to resolve category IDs:
+//
+//	categories, err := data.GetVideoCategories(ctx, client, "US")
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(video.CategoryName(categories)) // "Gaming"
+//
+// With WithCategoryCache, results are cached per region and each call
+// returns its own copy of the categories.
+//
+// Quota cost: 0-1 units.
+func GetVideoCategories(ctx context.Context, client *core.Client, regionCode string, opts ...CategoryOption) ([]*VideoCategory, error) {
+	if regionCode == "" {
+		return nil, fmt.Errorf("region code cannot be empty")
+	}
+	cfg := &categoryConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	region := strings.ToUpper(regionCode)
+	key := categoryCacheKeyPrefix + region
+	if cfg.cache != nil {
+		if categories, ok := cfg.cache.Get(key); ok {
+			return cloneVideoCategories(categories.([]*VideoCategory)), nil
+		}
+	}
+
+	query := url.Values{}
+	query.Set("part", "snippet")
+	query.Set("regionCode", region)
+
+	var resp VideoCategoryListResponse
+	err := client.Get(ctx, "videoCategories", query, "videoCategories.list", &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.cache != nil {
+		cfg.cache.SetWithTTL(key, cloneVideoCategories(resp.Items), categoryCacheTTL)
+	}
+	return resp.Items, nil
+}
+
+// cloneVideoCategories returns a deep copy of categories.
+func cloneVideoCategories(categories []*VideoCategory) []*VideoCategory {
+	clone := make([]*VideoCategory, len(categories))
+	for i, c := range categories {
+		if c == nil {
+			continue
+		}
+		cc := *c
+		if c.Snippet != nil {
+			snippet := *c.Snippet
+			cc.Snippet = &snippet
+		}
+		clone[i] = &cc
+	}
+	return clone
+}
+
+// CategoryName returns the name of the video's category, looked up in
+// categories (see GetVideoCategories). Requires the snippet part.
+// Returns empty string if the video has no category or it is not in
+// categories.
+func (v *Video) CategoryName(categories []*VideoCategory) string {
+	if v.Snippet == nil || v.Snippet.CategoryID == "" {
+		return ""
+	}
+	for _, c := range categories {
+		if c != nil && c.ID == v.Snippet.CategoryID && c.Snippet != nil {
+			return c.Snippet.Title
+		}
+	}
+	return ""
+}
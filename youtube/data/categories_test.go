@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestGetVideoCategories(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/videoCategories" {
+			t.Errorf("path = %s, want /videoCategories", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("part") != "snippet" {
+			t.Errorf("part = %q, want snippet", q.Get("part"))
+		}
+		resp := VideoCategoryListResponse{Items: []*VideoCategory{
+			{ID: "10", Snippet: &VideoCategorySnippet{Title: "Music", Assignable: true}},
+			{ID: "20", Snippet: &VideoCategorySnippet{Title: "Gaming", Assignable: true}},
+		}}
+		if q.Get("regionCode") == "JP" {
+			resp.Items[1].Snippet.Title = "ゲーム"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	ctx := context.Background()
+	cache := core.NewCache()
+
+	categories, err := GetVideoCategories(ctx, client, "us", WithCategoryCache(cache))
+	if err != nil {
+		t.Fatalf("GetVideoCategories() error = %v", err)
+	}
+	if len(categories) != 2 || categories[1].Snippet.Title != "Gaming" {
+		t.Errorf("categories = %+v", categories)
+	}
+
+	// Modifying the returned categories must not affect the cache.
+	categories[0] = nil
+	categories[1].Snippet.Title = "modified"
+	cached, err := GetVideoCategories(ctx, client, "US", WithCategoryCache(cache))
+	if err != nil {
+		t.Fatalf("second GetVideoCategories() error = %v", err)
+	}
+	if cached[0] == nil || cached[1].Snippet.Title != "Gaming" {
+		t.Error("cached categories were modified by the caller")
+	}
+	cached[1].ID = "modified"
+	again, _ := GetVideoCategories(ctx, client, "US", WithCategoryCache(cache))
+	if again[1].ID != "20" {
+		t.Error("categories returned from the cache share elements")
+	}
+	if calls != 1 {
+		t.Errorf("API calls = %d, want 1 (later calls cached)", calls)
+	}
+
+	jp, err := GetVideoCategories(ctx, client, "JP", WithCategoryCache(cache))
+	if err != nil {
+		t.Fatalf("GetVideoCategories(JP) error = %v", err)
+	}
+	if jp[1].Snippet.Title != "ゲーム" || calls != 2 {
+		t.Errorf("JP categories = %+v after %d calls, want per-region lookup", jp[1].Snippet, calls)
+	}
+
+	if _, err := GetVideoCategories(ctx, client, ""); err == nil {
+		t.Error("expected error for empty region code")
+	}
+
+	if _, err := GetVideoCategories(ctx, client, "US"); err != nil {
+		t.Fatalf("uncached GetVideoCategories() error = %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("API calls = %d, want 3 (no cache without WithCategoryCache)", calls)
+	}
+}
+
+func TestVideo_CategoryName(t *testing.T) {
+	categories := []*VideoCategory{
+		nil,
+		{ID: "1"},
+		{ID: "10", Snippet: &VideoCategorySnippet{Title: "Music"}},
+		{ID: "20", Snippet: &VideoCategorySnippet{Title: "Gaming"}},
+	}
+
+	tests := []struct {
+		name  string
+		video *Video
+		want  string
+	}{
+		{"found", &Video{Snippet: &VideoSnippet{CategoryID: "20"}}, "Gaming"},
+		{"unknown category", &Video{Snippet: &VideoSnippet{CategoryID: "99"}}, ""},
+		{"category without snippet", &Video{Snippet: &VideoSnippet{CategoryID: "1"}}, ""},
+		{"no category", &Video{Snippet: &VideoSnippet{}}, ""},
+		{"no snippet", &Video{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.video.CategoryName(categories); got != tt.want {
+				t.Errorf("CategoryName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
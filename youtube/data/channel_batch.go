@@ -0,0 +1,119 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// maxChannelIDs is the maximum number of channel IDs per channels.list call.
+const maxChannelIDs = 50
+
+// channelBatchConcurrency is how many channels.list calls GetChannelsByIDs
+// makes at once.
+const channelBatchConcurrency = 4
+
+// ChannelBatch is the result of GetChannelsByIDs.
+type ChannelBatch struct {
+	// Channels are the channels found, in the order their IDs were given.
+	Channels []*Channel
+
+	// Missing are the requested IDs the API returned no channel for, e.g.
+	// deleted or terminated channels, in the order they were given.
+	Missing []string
+}
+
+// GetChannelsByIDs retrieves any number of channels by ID, e.g. to enrich a
+// list of channel IDs from comments or chat. IDs are sent in batches of 50,
+// up to 4 batches at a time. Duplicate and empty IDs are ignored.
+//
+//	batch, err := data.GetChannelsByIDs(ctx, client, ids, "snippet", "statistics")
+//	for _, ch := range batch.Channels {
+//		fmt.Println(ch.Snippet.Title)
+//	}
+//	if len(batch.Missing) > 0 {
+//		log.Printf("not found: %v", batch.Missing)
+//	}
+//
+// Parts default to DefaultChannelParts. If any batch fails, the first error
+// is returned and the remaining batches are cancelled.
+// Quota cost: 1 unit per batch of 50 IDs.
+func GetChannelsByIDs(ctx context.Context, client *core.Client, ids []string, parts ...string) (*ChannelBatch, error) {
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	if len(unique) == 0 {
+		return nil, fmt.Errorf("at least one channel ID is required")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		found    = make(map[string]*Channel, len(unique))
+		firstErr error
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, channelBatchConcurrency)
+	)
+	for start := 0; start < len(unique); start += maxChannelIDs {
+		batch := unique[start:min(start+maxChannelIDs, len(unique))]
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if ctx.Err() != nil {
+				return
+			}
+			resp, err := GetChannels(ctx, client, &GetChannelsParams{
+				IDs:        batch,
+				Parts:      parts,
+				MaxResults: maxChannelIDs,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			for _, ch := range resp.Items {
+				if ch != nil && seen[ch.ID] {
+					found[ch.ID] = ch
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &ChannelBatch{Channels: make([]*Channel, 0, len(found))}
+	for _, id := range unique {
+		if ch, ok := found[id]; ok {
+			result.Channels = append(result.Channels, ch)
+		} else {
+			result.Missing = append(result.Missing, id)
+		}
+	}
+	return result, nil
+}
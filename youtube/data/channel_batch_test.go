@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestGetChannelsByIDs(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		batchSizes  []int
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Query().Get("part") != "snippet" {
+			t.Errorf("part = %q, want snippet", r.URL.Query().Get("part"))
+		}
+		ids := strings.Split(r.URL.Query().Get("id"), ",")
+		mu.Lock()
+		batchSizes = append(batchSizes, len(ids))
+		mu.Unlock()
+
+		// Reply in reverse order and omit deleted channels.
+		resp := ChannelListResponse{}
+		for _, id := range slices.Backward(ids) {
+			if !strings.HasPrefix(id, "gone") {
+				resp.Items = append(resp.Items, &Channel{ID: id})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	var ids []string
+	for i := range 260 {
+		if i%100 == 7 {
+			ids = append(ids, fmt.Sprintf("gone%d", i))
+		} else {
+			ids = append(ids, fmt.Sprintf("UC%03d", i))
+		}
+	}
+	ids = append(ids, "UC000", "") // duplicate and empty
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	batch, err := GetChannelsByIDs(context.Background(), client, ids, "snippet")
+	if err != nil {
+		t.Fatalf("GetChannelsByIDs() error = %v", err)
+	}
+
+	slices.Sort(batchSizes)
+	if fmt.Sprint(batchSizes) != "[10 50 50 50 50 50]" {
+		t.Errorf("batch sizes = %v, want five of 50 and one of 10", batchSizes)
+	}
+	if got := maxInFlight.Load(); got > channelBatchConcurrency || got < 2 {
+		t.Errorf("max concurrent requests = %d, want 2..%d", got, channelBatchConcurrency)
+	}
+
+	if len(batch.Channels) != 257 {
+		t.Fatalf("got %d channels, want 257", len(batch.Channels))
+	}
+	if batch.Channels[0].ID != "UC000" || batch.Channels[7].ID != "UC008" || batch.Channels[256].ID != "UC259" {
+		t.Errorf("channels not in request order: %s, %s, %s",
+			batch.Channels[0].ID, batch.Channels[7].ID, batch.Channels[256].ID)
+	}
+	if want := "[gone7 gone107 gone207]"; fmt.Sprint(batch.Missing) != want {
+		t.Errorf("Missing = %v, want %v", batch.Missing, want)
+	}
+}
+
+func TestGetChannelsByIDs_Errors(t *testing.T) {
+	t.Run("no IDs", func(t *testing.T) {
+		if _, err := GetChannelsByIDs(context.Background(), core.NewClient(), []string{""}); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("batch fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Query().Get("id"), "UC050") {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":{"code":500,"message":"backend error"}}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(ChannelListResponse{})
+		}))
+		defer server.Close()
+
+		var ids []string
+		for i := range 100 {
+			ids = append(ids, fmt.Sprintf("UC%03d", i))
+		}
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := GetChannelsByIDs(context.Background(), client, ids)
+
+		var apiErr *core.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("error = %v, want APIError 500", err)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := GetChannelsByIDs(ctx, core.NewClient(core.WithBaseURL("http://127.0.0.1:1")), []string{"UC1"})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error = %v, want context.Canceled", err)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -147,6 +148,10 @@ type GetChannelsParams struct {
 	// ForUsername retrieves the channel for the specified username.
 	ForUsername string
 
+	// ForHandle retrieves the channel for the specified handle
+	// (with or without the leading "@").
+	ForHandle string
+
 	// Mine retrieves the authenticated user's channel.
 	Mine bool
 
@@ -155,6 +160,7 @@ type GetChannelsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -172,8 +178,8 @@ func GetChannels(ctx context.Context, client *core.Client, params *GetChannelsPa
 	}
 
 	// Validate that at least one filter is provided
-	if len(params.IDs) == 0 && params.ForUsername == "" && !params.Mine {
-		return nil, fmt.Errorf("at least one of IDs, ForUsername, or Mine is required")
+	if len(params.IDs) == 0 && params.ForUsername == "" && params.ForHandle == "" && !params.Mine {
+		return nil, fmt.Errorf("at least one of IDs, ForUsername, ForHandle, or Mine is required")
 	}
 
 	parts := params.Parts
@@ -190,12 +196,13 @@ func GetChannels(ctx context.Context, client *core.Client, params *GetChannelsPa
 	if params.ForUsername != "" {
 		query.Set("forUsername", params.ForUsername)
 	}
+	if params.ForHandle != "" {
+		query.Set("forHandle", params.ForHandle)
+	}
 	if params.Mine {
 		query.Set("mine", "true")
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -239,6 +246,145 @@ func GetChannel(ctx context.Context, client *core.Client, channelID string, part
 	return resp.Items[0], nil
 }
 
+// GetChannelByHandle retrieves a channel by its handle (e.g., "@GoogleDevelopers").
+// The leading "@" is optional.
+// Quota cost: 1 unit.
+func GetChannelByHandle(ctx context.Context, client *core.Client, handle string, parts ...string) (*Channel, error) {
+	handle = strings.TrimSpace(handle)
+	if strings.TrimPrefix(handle, "@") == "" {
+		return nil, fmt.Errorf("handle cannot be empty")
+	}
+	if !strings.HasPrefix(handle, "@") {
+		handle = "@" + handle
+	}
+
+	if len(parts) == 0 {
+		parts = DefaultChannelParts
+	}
+
+	resp, err := GetChannels(ctx, client, &GetChannelsParams{
+		ForHandle: handle,
+		Parts:     parts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Items) == 0 {
+		return nil, &core.NotFoundError{
+			ResourceType: "channel",
+			ResourceID:   handle,
+		}
+	}
+
+	return resp.Items[0], nil
+}
+
+// resolveCacheTTL is how long cached ResolveChannelID results are kept.
+// Handles and usernames rarely change.
+const resolveCacheTTL = 24 * time.Hour
+
+// resolveCacheKeyPrefix namespaces ResolveChannelID entries in a shared
+// core.Cache.
+const resolveCacheKeyPrefix = "channelID:"
+
+// resolveConfig holds ResolveChannelID settings.
+type resolveConfig struct {
+	cache *core.Cache
+}
+
+// ResolveOption configures ResolveChannelID.
+type ResolveOption func(*resolveConfig)
+
+// WithResolveCache caches handle and username resolutions in cache for a
+// day, so repeated resolutions are free. The entries are namespaced, so the
+// cache can be shared with other uses. Without it every handle or username
+// resolution calls the API.
+func WithResolveCache(cache *core.Cache) ResolveOption {
+	return func(c *resolveConfig) { c.cache = cache }
+}
+
+// ResolveChannelID returns the canonical channel ID (UC...) for a channel
+// reference. The input may be a channel ID, a handle ("@name"), a legacy
+// username, or a channel URL such as:
+//
+//	https://www.youtube.com/channel/UC...
+//	https://www.youtube.com/@name
+//	https://www.youtube.com/c/name
+//	https://www.youtube.com/user/name
+//
+// Channel IDs are returned without an API call. Handles and usernames are
+// looked up, and the result is cached if WithResolveCache is set. Custom
+// URLs (/c/name) are resolved as handles first, then as usernames.
+//
+// Quota cost: 0-2 units.
+func ResolveChannelID(ctx context.Context, client *core.Client, input string, opts ...ResolveOption) (string, error) {
+	cfg := &resolveConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	ref, err := ParseChannelRef(input)
+	if err != nil {
+		// Bare names are treated as custom URLs, which try both handle
+		// and username lookups.
+		name := strings.TrimSpace(input)
+		if name == "" || strings.ContainsAny(name, "/?#") {
+			return "", fmt.Errorf("resolving channel: %w", err)
+		}
+		ref = ChannelRef{Type: ChannelRefCustom, Value: name}
+	}
+	if ref.Type == ChannelRefID {
+		return ref.Value, nil
+	}
+
+	key := resolveCacheKeyPrefix + string(ref.Type) + ":" + strings.ToLower(ref.Value)
+	if cfg.cache != nil {
+		if id, ok := cfg.cache.Get(key); ok {
+			return id.(string), nil
+		}
+	}
+
+	id, err := lookupChannelID(ctx, client, ref)
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.cache != nil {
+		cfg.cache.SetWithTTL(key, id, resolveCacheTTL)
+	}
+	return id, nil
+}
+
+// lookupChannelID resolves a handle, custom URL, or username via channels.list.
+func lookupChannelID(ctx context.Context, client *core.Client, ref ChannelRef) (string, error) {
+	if ref.Type == ChannelRefHandle || ref.Type == ChannelRefCustom {
+		ch, err := GetChannelByHandle(ctx, client, ref.Value, "id")
+		if err == nil {
+			return ch.ID, nil
+		}
+		var notFound *core.NotFoundError
+		if ref.Type == ChannelRefHandle || !errors.As(err, &notFound) {
+			return "", err
+		}
+	}
+
+	resp, err := GetChannels(ctx, client, &GetChannelsParams{
+		ForUsername: ref.Value,
+		Parts:       []string{"id"},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Items) == 0 {
+		return "", &core.NotFoundError{
+			ResourceType: "channel",
+			ResourceID:   ref.Value,
+		}
+	}
+	return resp.Items[0].ID, nil
+}
+
 // GetMyChannel retrieves the authenticated user's channel.
 // Requires OAuth authentication.
 // Quota cost: 1 unit.
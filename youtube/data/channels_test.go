@@ -387,3 +387,140 @@ func TestChannelListResponse_JSON(t *testing.T) {
 		t.Errorf("UploadsPlaylistID() = %q, want 'UU123'", channel.UploadsPlaylistID())
 	}
 }
+
+func TestGetChannelByHandle(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("forHandle") != "@testchannel" {
+				t.Errorf("unexpected forHandle: %s", r.URL.Query().Get("forHandle"))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(ChannelListResponse{Items: []*Channel{{ID: "UC123"}}})
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		for _, handle := range []string{"@testchannel", "testchannel"} {
+			ch, err := GetChannelByHandle(context.Background(), client, handle)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ch.ID != "UC123" {
+				t.Errorf("ID = %q, want UC123", ch.ID)
+			}
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(ChannelListResponse{})
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := GetChannelByHandle(context.Background(), client, "@missing")
+		notFoundErr, ok := err.(*core.NotFoundError)
+		if !ok {
+			t.Fatalf("expected NotFoundError, got %T", err)
+		}
+		if notFoundErr.ResourceID != "@missing" {
+			t.Errorf("ResourceID = %q, want '@missing'", notFoundErr.ResourceID)
+		}
+	})
+
+	t.Run("empty handle", func(t *testing.T) {
+		client := core.NewClient()
+		if _, err := GetChannelByHandle(context.Background(), client, "@"); err == nil {
+			t.Error("expected error for empty handle")
+		}
+	})
+}
+
+func TestResolveChannelID(t *testing.T) {
+	const channelID = "UCabcdefghijklmnopqrstuv"
+
+	tests := []struct {
+		name      string
+		input     string
+		handles   map[string]string
+		usernames map[string]string
+		want      string
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "channel ID", input: channelID, want: channelID},
+		{name: "channel URL", input: "https://www.youtube.com/channel/" + channelID + "/videos", want: channelID},
+		{name: "handle", input: "@creator", handles: map[string]string{"@creator": "UC1"}, want: "UC1", wantCalls: 1},
+		{name: "handle URL", input: "https://youtube.com/@creator/live?si=x", handles: map[string]string{"@creator": "UC1"}, want: "UC1", wantCalls: 1},
+		{name: "custom URL as handle", input: "youtube.com/c/creator", handles: map[string]string{"@creator": "UC1"}, want: "UC1", wantCalls: 1},
+		{name: "custom URL as username", input: "https://www.youtube.com/c/legacy", usernames: map[string]string{"legacy": "UC2"}, want: "UC2", wantCalls: 2},
+		{name: "user URL", input: "https://www.youtube.com/user/legacy", usernames: map[string]string{"legacy": "UC2"}, want: "UC2", wantCalls: 1},
+		{name: "bare name", input: "creator", handles: map[string]string{"@creator": "UC1"}, want: "UC1", wantCalls: 1},
+		{name: "unknown handle", input: "@missing", wantCalls: 1, wantErr: true},
+		{name: "empty", input: "  ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := core.NewCache()
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				var resp ChannelListResponse
+				q := r.URL.Query()
+				if id, ok := tt.handles[q.Get("forHandle")]; ok {
+					resp.Items = []*Channel{{ID: id}}
+				}
+				if id, ok := tt.usernames[q.Get("forUsername")]; ok {
+					resp.Items = []*Channel{{ID: id}}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			got, err := ResolveChannelID(context.Background(), client, tt.input, WithResolveCache(cache))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveChannelID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveChannelID() = %q, want %q", got, tt.want)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("API calls = %d, want %d", calls, tt.wantCalls)
+			}
+
+			// A second resolution must be served from the cache.
+			if !tt.wantErr {
+				if _, err := ResolveChannelID(context.Background(), client, tt.input, WithResolveCache(cache)); err != nil {
+					t.Fatalf("second ResolveChannelID() error = %v", err)
+				}
+				if calls != tt.wantCalls {
+					t.Errorf("API calls after cached lookup = %d, want %d", calls, tt.wantCalls)
+				}
+			}
+		})
+	}
+
+	t.Run("without cache", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(ChannelListResponse{Items: []*Channel{{ID: "UC1"}}})
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		for range 2 {
+			if _, err := ResolveChannelID(context.Background(), client, "@creator"); err != nil {
+				t.Fatalf("ResolveChannelID() error = %v", err)
+			}
+		}
+		if calls != 2 {
+			t.Errorf("API calls = %d, want 2 (no cache)", calls)
+		}
+	})
+}
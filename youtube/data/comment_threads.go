@@ -0,0 +1,182 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// FullThread is a top-level comment with all of its replies. YouTube
+// threads are one level deep: replies to a reply are listed under the
+// top-level comment.
+type FullThread struct {
+	// Thread is the commentThreads resource, including TotalReplyCount.
+	Thread *CommentThread
+
+	// Comment is the top-level comment.
+	Comment *Comment
+
+	// Replies are the thread's replies in the API's order. May be shorter
+	// than Thread.ReplyCount() if replies were deleted while fetching or
+	// the WithMaxComments cap was reached.
+	Replies []*Comment
+}
+
+// fullThreadConfig holds GetFullThread settings.
+type fullThreadConfig struct {
+	maxComments int
+	order       string
+}
+
+// FullThreadOption configures GetFullThread.
+type FullThreadOption func(*fullThreadConfig)
+
+// WithMaxComments caps the number of comments GetFullThread collects,
+// counting top-level comments and replies. Once the cap is reached no more
+// pages are requested, which bounds the quota cost on busy videos. Zero or
+// less means no cap.
+func WithMaxComments(n int) FullThreadOption {
+	return func(c *fullThreadConfig) { c.maxComments = n }
+}
+
+// WithThreadOrder sets the order of top-level comments: CommentOrderTime
+// (newest first, the default) or CommentOrderRelevance.
+func WithThreadOrder(order string) FullThreadOption {
+	return func(c *fullThreadConfig) { c.order = order }
+}
+
+// GetAllCommentReplies retrieves every reply to a comment, following page
+// tokens until the last page. Replies are deduplicated by ID and keep the
+// API's order. Cancelling ctx stops between pages.
+// Quota cost: 1 unit per page of up to 100 replies.
+func GetAllCommentReplies(ctx context.Context, client *core.Client, parentID string) ([]*Comment, error) {
+	if parentID == "" {
+		return nil, fmt.Errorf("parent ID cannot be empty")
+	}
+	return commentReplies(ctx, client, parentID, 0)
+}
+
+// commentReplies retrieves up to limit replies to parentID (0 for all).
+func commentReplies(ctx context.Context, client *core.Client, parentID string, limit int) ([]*Comment, error) {
+	pages := core.Paginate(ctx, func(token string) ([]*Comment, string, error) {
+		resp, err := GetComments(ctx, client, &GetCommentsParams{
+			ParentID:   parentID,
+			MaxResults: 100,
+			PageToken:  token,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+
+	var replies []*Comment
+	seen := make(map[string]bool)
+	for c, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		if c == nil || seen[c.ID] {
+			continue
+		}
+		seen[c.ID] = true
+		replies = append(replies, c)
+		if limit > 0 && len(replies) == limit {
+			break
+		}
+	}
+	return replies, nil
+}
+
+// GetFullThread retrieves a video's top-level comments with all of their
+// replies, e.g. for a moderation tool reviewing whole conversations:
+//
+//	threads, err := data.GetFullThread(ctx, client, "video-id", data.WithMaxComments(2000))
+//	for _, t := range threads {
+//		fmt.Println(t.Comment.Snippet.TextDisplay)
+//		for _, reply := range t.Replies {
+//			fmt.Println("  ", reply.Snippet.TextDisplay)
+//		}
+//	}
+//
+// Each page of up to 100 threads includes a few replies per thread; replies
+// are only fetched separately for threads with more, so the quota cost is
+// 1 unit per page of threads plus 1 unit per page of replies for those
+// threads. Use WithMaxComments to bound the cost on popular videos.
+//
+// Returns a *CommentsDisabledError or *PrivateVideoError if the video's
+// comments cannot be listed. Cancelling ctx stops between pages.
+func GetFullThread(ctx context.Context, client *core.Client, videoID string, opts ...FullThreadOption) ([]*FullThread, error) {
+	if videoID == "" {
+		return nil, fmt.Errorf("video ID cannot be empty")
+	}
+
+	cfg := fullThreadConfig{order: CommentOrderTime}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	count := 0
+	full := func() bool { return cfg.maxComments > 0 && count >= cfg.maxComments }
+
+	pages := core.Paginate(ctx, func(token string) ([]*CommentThread, string, error) {
+		resp, err := GetCommentThreads(ctx, client, &GetCommentThreadsParams{
+			VideoID:    videoID,
+			Order:      cfg.order,
+			Parts:      DefaultCommentThreadParts,
+			MaxResults: 100,
+			PageToken:  token,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+
+	var threads []*FullThread
+	seen := make(map[string]bool)
+	for ct, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		top := ct.TopLevelComment()
+		if top == nil || seen[ct.ID] {
+			continue
+		}
+		seen[ct.ID] = true
+		count++
+		thread := &FullThread{Thread: ct, Comment: top}
+		threads = append(threads, thread)
+
+		var inline []*Comment
+		if ct.Replies != nil {
+			inline = ct.Replies.Comments
+		}
+		if len(inline) >= ct.ReplyCount() {
+			// All replies came with the thread; no extra call needed.
+			for _, reply := range inline {
+				if full() {
+					break
+				}
+				thread.Replies = append(thread.Replies, reply)
+				count++
+			}
+		} else if !full() {
+			limit := 0
+			if cfg.maxComments > 0 {
+				limit = cfg.maxComments - count
+			}
+			replies, err := commentReplies(ctx, client, top.ID, limit)
+			if err != nil {
+				return nil, fmt.Errorf("fetching replies to %s: %w", top.ID, err)
+			}
+			thread.Replies = replies
+			count += len(replies)
+		}
+
+		if full() {
+			break
+		}
+	}
+	return threads, nil
+}
@@ -0,0 +1,185 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// threadServer serves a video with three threads over two pages: c1 with
+// two replies (all inline), c2 with 150 replies (5 inline), and c3 with
+// none. Page 2 repeats c1. Replies are paged 100 at a time.
+func threadServer(t *testing.T, calls *[]string) *httptest.Server {
+	t.Helper()
+
+	reply := func(parent string, i int) *Comment {
+		return &Comment{ID: fmt.Sprintf("%s.r%d", parent, i), Snippet: &CommentSnippet{ParentID: parent}}
+	}
+	thread := func(id string, replies, inline int) *CommentThread {
+		ct := &CommentThread{ID: id, Snippet: &CommentThreadSnippet{
+			TotalReplyCount: replies,
+			TopLevelComment: &Comment{ID: id},
+		}}
+		if inline > 0 {
+			ct.Replies = &CommentThreadReplies{}
+			for i := range inline {
+				ct.Replies.Comments = append(ct.Replies.Comments, reply(id, i))
+			}
+		}
+		return ct
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		*calls = append(*calls, r.URL.Path+"?"+q.Get("parentId")+q.Get("pageToken"))
+
+		switch r.URL.Path {
+		case "/commentThreads":
+			if q.Get("maxResults") != "100" || q.Get("order") != "time" {
+				t.Errorf("thread query = %v", q)
+			}
+			resp := CommentThreadListResponse{}
+			if q.Get("pageToken") == "" {
+				resp.Items = []*CommentThread{thread("c1", 2, 2), thread("c2", 150, 5)}
+				resp.NextPageToken = "p2"
+			} else {
+				resp.Items = []*CommentThread{thread("c1", 2, 2), thread("c3", 0, 0)}
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+
+		case "/comments":
+			parent := q.Get("parentId")
+			start, _ := strconv.Atoi(q.Get("pageToken"))
+			resp := CommentListResponse{}
+			for i := start; i < start+100 && i < 150; i++ {
+				resp.Items = append(resp.Items, reply(parent, i))
+			}
+			if start+100 < 150 {
+				resp.NextPageToken = strconv.Itoa(start + 100)
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		}
+	}))
+}
+
+func TestGetAllCommentReplies(t *testing.T) {
+	var calls []string
+	server := threadServer(t, &calls)
+	defer server.Close()
+	client := core.NewClient(core.WithBaseURL(server.URL))
+
+	replies, err := GetAllCommentReplies(context.Background(), client, "c2")
+	if err != nil {
+		t.Fatalf("GetAllCommentReplies() error = %v", err)
+	}
+	if len(replies) != 150 || replies[149].ID != "c2.r149" {
+		t.Errorf("got %d replies, want 150 ending in c2.r149", len(replies))
+	}
+	if want := "[/comments?c2 /comments?c2100]"; fmt.Sprint(calls) != want {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+
+	if _, err := GetAllCommentReplies(context.Background(), client, ""); err == nil {
+		t.Error("expected error for empty parent ID")
+	}
+}
+
+func TestGetFullThread(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []FullThreadOption
+		wantReplies map[string]int
+		wantCalls   int
+	}{
+		{
+			name:        "all comments",
+			wantReplies: map[string]int{"c1": 2, "c2": 150, "c3": 0},
+			// two thread pages, two reply pages for c2 only
+			wantCalls: 4,
+		},
+		{
+			name:        "cap within inline replies",
+			opts:        []FullThreadOption{WithMaxComments(2)},
+			wantReplies: map[string]int{"c1": 1},
+			wantCalls:   1,
+		},
+		{
+			name:        "cap within fetched replies",
+			opts:        []FullThreadOption{WithMaxComments(50)},
+			wantReplies: map[string]int{"c1": 2, "c2": 46},
+			wantCalls:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			server := threadServer(t, &calls)
+			defer server.Close()
+			client := core.NewClient(core.WithBaseURL(server.URL))
+
+			threads, err := GetFullThread(context.Background(), client, "video123", tt.opts...)
+			if err != nil {
+				t.Fatalf("GetFullThread() error = %v", err)
+			}
+
+			got := make(map[string]int)
+			for _, th := range threads {
+				got[th.Comment.ID] = len(th.Replies)
+				for _, r := range th.Replies {
+					if r.Snippet.ParentID != th.Comment.ID {
+						t.Errorf("reply %s under %s", r.ID, th.Comment.ID)
+					}
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.wantReplies) {
+				t.Errorf("replies = %v, want %v", got, tt.wantReplies)
+			}
+			if len(calls) != tt.wantCalls {
+				t.Errorf("calls = %v, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestGetFullThread_Errors(t *testing.T) {
+	t.Run("empty video ID", func(t *testing.T) {
+		if _, err := GetFullThread(context.Background(), core.NewClient(), ""); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("comments disabled", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"disabled","errors":[{"reason":"commentsDisabled"}]}}`))
+		}))
+		defer server.Close()
+
+		_, err := GetFullThread(context.Background(), core.NewClient(core.WithBaseURL(server.URL)), "video123")
+		var disabled *CommentsDisabledError
+		if !errors.As(err, &disabled) {
+			t.Errorf("error = %v, want *CommentsDisabledError", err)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		var calls []string
+		server := threadServer(t, &calls)
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := GetFullThread(ctx, core.NewClient(core.WithBaseURL(server.URL)), "video123")
+		if !errors.Is(err, context.Canceled) || len(calls) != 0 {
+			t.Errorf("error = %v, calls = %v; want context.Canceled and no calls", err, calls)
+		}
+	})
+}
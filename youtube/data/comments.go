@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -10,6 +11,47 @@ import (
 	"github.com/Its-donkey/yougopher/youtube/core"
 )
 
+// CommentsDisabledError is returned when listing comments for a video whose
+// comments have been turned off (API reason "commentsDisabled").
+type CommentsDisabledError struct {
+	// VideoID is the video whose comments were requested.
+	VideoID string
+
+	// Err is the underlying API error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *CommentsDisabledError) Error() string {
+	return fmt.Sprintf("comments are disabled for video %s", e.VideoID)
+}
+
+// Unwrap returns the underlying API error.
+func (e *CommentsDisabledError) Unwrap() error {
+	return e.Err
+}
+
+// PrivateVideoError is returned when listing comments for a video that is
+// private or otherwise inaccessible to the caller. The API reports this with
+// reason "forbidden".
+type PrivateVideoError struct {
+	// VideoID is the video whose comments were requested.
+	VideoID string
+
+	// Err is the underlying API error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *PrivateVideoError) Error() string {
+	return fmt.Sprintf("video %s is private or inaccessible", e.VideoID)
+}
+
+// Unwrap returns the underlying API error.
+func (e *PrivateVideoError) Unwrap() error {
+	return e.Err
+}
+
 // CommentThread represents a comment thread resource.
 type CommentThread struct {
 	// Kind is the resource type (youtube#commentThread).
@@ -185,6 +227,7 @@ type GetCommentThreadsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-100).
+	// Larger values are clamped to 100.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -195,6 +238,8 @@ type GetCommentThreadsParams struct {
 var DefaultCommentThreadParts = []string{"snippet", "replies"}
 
 // GetCommentThreads retrieves comment threads.
+// When filtering by VideoID, returns a *CommentsDisabledError or
+// *PrivateVideoError if the video's comments cannot be listed.
 // Quota cost: 1 unit per call.
 func GetCommentThreads(ctx context.Context, client *core.Client, params *GetCommentThreadsParams) (*CommentThreadListResponse, error) {
 	if params == nil {
@@ -235,9 +280,7 @@ func GetCommentThreads(ctx context.Context, client *core.Client, params *GetComm
 	if params.SearchTerms != "" {
 		query.Set("searchTerms", params.SearchTerms)
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, 100)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -245,13 +288,15 @@ func GetCommentThreads(ctx context.Context, client *core.Client, params *GetComm
 	var resp CommentThreadListResponse
 	err := client.Get(ctx, "commentThreads", query, "commentThreads.list", &resp)
 	if err != nil {
-		return nil, err
+		return nil, videoCommentsError(err, params.VideoID)
 	}
 
 	return &resp, nil
 }
 
 // GetVideoComments retrieves comment threads for a video.
+// Returns a *CommentsDisabledError or *PrivateVideoError if the video's
+// comments cannot be listed, so bulk callers can skip such videos.
 // Quota cost: 1 unit per call.
 func GetVideoComments(ctx context.Context, client *core.Client, videoID string, maxResults int) (*CommentThreadListResponse, error) {
 	if videoID == "" {
@@ -265,6 +310,24 @@ func GetVideoComments(ctx context.Context, client *core.Client, videoID string,
 	})
 }
 
+// videoCommentsError translates errors from listing a video's comments into
+// CommentsDisabledError or PrivateVideoError based on the API error reason.
+// Other errors, including quota errors, are returned unchanged.
+func videoCommentsError(err error, videoID string) error {
+	var apiErr *core.APIError
+	if videoID == "" || !errors.As(err, &apiErr) {
+		return err
+	}
+	switch apiErr.Code {
+	case "commentsDisabled":
+		return &CommentsDisabledError{VideoID: videoID, Err: err}
+	case "forbidden":
+		return &PrivateVideoError{VideoID: videoID, Err: err}
+	default:
+		return err
+	}
+}
+
 // CommentListResponse is the response from comments.list.
 type CommentListResponse struct {
 	// Kind is the resource type.
@@ -296,6 +359,7 @@ type GetCommentsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-100).
+	// Larger values are clamped to 100.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -331,9 +395,7 @@ func GetComments(ctx context.Context, client *core.Client, params *GetCommentsPa
 	if params.ParentID != "" {
 		query.Set("parentId", params.ParentID)
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, 100)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -3,6 +3,8 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -226,6 +228,107 @@ func TestGetVideoComments(t *testing.T) {
 			t.Fatal("expected error for empty video ID")
 		}
 	})
+
+	t.Run("max results clamped to 100", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("maxResults") != "100" {
+				t.Errorf("unexpected maxResults: %s", r.URL.Query().Get("maxResults"))
+			}
+			resp := CommentThreadListResponse{}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		if _, err := GetVideoComments(context.Background(), client, "video123", 500); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestGetVideoComments_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		reason    string
+		check     func(error) bool
+		wantError string
+	}{
+		{
+			name:   "comments disabled",
+			status: http.StatusForbidden,
+			reason: "commentsDisabled",
+			check: func(err error) bool {
+				var e *CommentsDisabledError
+				return errors.As(err, &e) && e.VideoID == "video123"
+			},
+			wantError: "*CommentsDisabledError",
+		},
+		{
+			name:   "private video",
+			status: http.StatusForbidden,
+			reason: "forbidden",
+			check: func(err error) bool {
+				var e *PrivateVideoError
+				return errors.As(err, &e) && e.VideoID == "video123"
+			},
+			wantError: "*PrivateVideoError",
+		},
+		{
+			name:   "quota exceeded unchanged",
+			status: http.StatusForbidden,
+			reason: "quotaExceeded",
+			check: func(err error) bool {
+				var e *core.QuotaError
+				return errors.As(err, &e)
+			},
+			wantError: "*core.QuotaError",
+		},
+		{
+			name:   "other errors unchanged",
+			status: http.StatusNotFound,
+			reason: "videoNotFound",
+			check: func(err error) bool {
+				var e *core.APIError
+				return errors.As(err, &e) && e.Code == "videoNotFound"
+			},
+			wantError: "*core.APIError",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprintf(w, `{"error":{"code":%d,"message":"test","errors":[{"reason":%q}]}}`, tt.status, tt.reason)
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			_, err := GetVideoComments(context.Background(), client, "video123", 20)
+			if !tt.check(err) {
+				t.Errorf("error = %v (%T), want %s", err, err, tt.wantError)
+			}
+		})
+	}
+
+	t.Run("without video ID", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"test","errors":[{"reason":"forbidden"}]}}`))
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := GetCommentThreads(context.Background(), client, &GetCommentThreadsParams{ChannelID: "UC123"})
+		var apiErr *core.APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("error = %v (%T), want *core.APIError", err, err)
+		}
+	})
 }
 
 func TestGetComments(t *testing.T) {
@@ -23,9 +23,35 @@
 //
 //	resp, err := data.GetVideos(ctx, client, &data.GetVideosParams{
 //		IDs:   []string{"video1", "video2"},
-//		Parts: []string{"snippet", "liveStreamingDetails"},
+//		Parts: data.PartsStatistics(),
 //	})
 //
+// Each part populates one Video field; parts that are not requested leave
+// their field nil. Presets cover common needs: PartsBasic (snippet),
+// PartsLiveStreaming (snippet, liveStreamingDetails), PartsStatistics
+// (snippet, statistics), and PartsAll. Part names videos.list does not accept
+// are rejected before the request is sent.
+//
+// Video.Duration parses contentDetails.duration ("PT1H2M3S"); ok is false
+// for live and upcoming broadcasts, which report "P0D":
+//
+//	if d, ok := video.Duration(); ok {
+//		fmt.Println("Length:", d)
+//	}
+//
+// Rate videos as the authenticated user (50 quota units), and read back
+// ratings for any number of videos (1 unit per 50 IDs):
+//
+//	err := data.RateVideo(ctx, client, "video-id", data.VideoRatingLike)
+//	ratings, err := data.GetRating(ctx, client, videoIDs)
+//
+// Resolve a video's category ID to its name with the region's category
+// list. With WithCategoryCache, lists are cached per region for a day:
+//
+//	categories, err := data.GetVideoCategories(ctx, client, "US",
+//		data.WithCategoryCache(cache))
+//	fmt.Println(video.CategoryName(categories)) // "Gaming"
+//
 // # Channels
 //
 // Retrieve channel information:
@@ -38,6 +64,40 @@
 //
 //	myChannel, err := data.GetMyChannel(ctx, client)
 //
+// Fetch any number of channels by ID, in batches of 50 fetched concurrently
+// (1 unit per batch). Channels keep the order of ids, and IDs with no
+// channel are reported:
+//
+//	batch, err := data.GetChannelsByIDs(ctx, client, ids, "snippet")
+//	fmt.Println(len(batch.Channels), "found; missing:", batch.Missing)
+//
+// Resolve a handle, custom URL, or username to a channel ID, caching the
+// results in a core.Cache:
+//
+//	cache := core.NewCache()
+//	channelID, err := data.ResolveChannelID(ctx, client, "@GoogleDevelopers",
+//		data.WithResolveCache(cache))
+//	channel, err := data.GetChannelByHandle(ctx, client, "@GoogleDevelopers")
+//
+// Watch a channel's statistics for changes, e.g. to announce milestones.
+// Subscriber deltas are not reported while the count is hidden:
+//
+//	watcher := data.NewStatsWatcher(client, channelID, data.WithInterval(time.Minute))
+//	watcher.OnSubscriberChange(func(delta int64) {
+//		log.Printf("subscribers changed by %+d", delta)
+//	})
+//	if err := watcher.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer watcher.Stop()
+//
+// # Parsing URLs
+//
+// Extract IDs from pasted YouTube links:
+//
+//	videoID, err := data.ParseVideoID("https://youtu.be/dQw4w9WgXcQ")
+//	ref, err := data.ParseChannelRef("https://www.youtube.com/@GoogleDevelopers")
+//
 // # Playlists
 //
 // Retrieve playlists and playlist items:
@@ -51,6 +111,13 @@
 //		MaxResults: 50,
 //	})
 //
+// Create, update, and delete playlists (requires OAuth, 50 quota units each):
+//
+//	created, err := data.CreatePlaylist(ctx, client, "Highlights", "Best moments", data.PrivacyUnlisted)
+//	created.Snippet.Title = "Stream Highlights"
+//	_, err = data.UpdatePlaylist(ctx, client, created, "snippet")
+//	err = data.DeletePlaylist(ctx, client, created.ID)
+//
 // # Search
 //
 // Search for videos, channels, and playlists.
@@ -70,6 +137,16 @@
 //		MaxResults: 25,
 //	})
 //
+// Search geotagged videos near a point. Location and LocationRadius must be
+// set together with Type "video"; they are checked before the request:
+//
+//	results, err := data.Search(ctx, client, &data.SearchParams{
+//		Query:          "street food",
+//		Type:           data.SearchTypeVideo,
+//		Location:       "37.42307,-122.08427",
+//		LocationRadius: "10km", // m, km, ft, or mi; up to 1000 km
+//	})
+//
 // # Comments
 //
 // Retrieve comment threads and replies:
@@ -86,6 +163,27 @@
 //	// Get replies
 //	replies, err := data.GetCommentReplies(ctx, client, "parent-id", 10)
 //
+//	// Every reply, following page tokens
+//	all, err := data.GetAllCommentReplies(ctx, client, "parent-id")
+//
+// GetFullThread fetches a video's top-level comments with all their replies.
+// Replies are only fetched separately for threads with more than the few
+// included in each thread, and WithMaxComments bounds the quota cost:
+//
+//	threads, err := data.GetFullThread(ctx, client, "video-id", data.WithMaxComments(1000))
+//	for _, t := range threads {
+//		fmt.Printf("%s (%d replies)\n", t.Comment.Snippet.TextDisplay, len(t.Replies))
+//	}
+//
+// Videos with comments turned off, or that are private, return typed errors
+// that bulk readers can skip:
+//
+//	var disabled *data.CommentsDisabledError
+//	var private *data.PrivateVideoError
+//	if errors.As(err, &disabled) || errors.As(err, &private) {
+//		continue
+//	}
+//
 // # Subscriptions
 //
 // Retrieve subscription information:
@@ -96,6 +194,28 @@
 //	// Check if subscribed
 //	subscribed, err := data.IsSubscribedTo(ctx, client, "channel-id")
 //
+// To be notified of new uploads without polling, see the websub subpackage.
+//
+// # Memberships
+//
+// List membership levels (requires auth.ScopeChannelMemberships) and gate
+// perks by tier rank rather than comparing level names:
+//
+//	levels, err := data.GetMembershipLevels(ctx, client)
+//	if levels.Rank(event.LevelName) >= levels.Rank("Gold") {
+//		// grant perk
+//	}
+//
+// List members with their current level and tenure:
+//
+//	resp, err := data.GetMembers(ctx, client, &data.GetMembersParams{
+//		HasAccessToLevel: levels.ByName("Gold").ID,
+//		MaxResults:       1000,
+//	})
+//	for _, m := range resp.Items {
+//		fmt.Printf("%s: %s since %s\n", m.DisplayName(), m.LevelName(), m.MemberSince())
+//	}
+//
 // # LiveChatID
 //
 // Get the live chat ID from a video (for connecting a chat bot):
@@ -103,19 +223,34 @@
 //	liveChatID, err := data.GetLiveChatID(ctx, client, videoID)
 //	bot, err := streaming.NewChatBotClient(client, authClient, liveChatID)
 //
+// For a stream that has not started yet, WaitForLiveChatID checks the video
+// at an interval until its chat is active. It returns a *NotLiveError if the
+// video is not a live stream or has already ended:
+//
+//	liveChatID, err := data.WaitForLiveChatID(ctx, client, videoID, time.Minute)
+//
+// # Page Sizes
+//
+// List calls clamp MaxResults to the endpoint's maximum rather than sending
+// a value the API would reject: 50 for most endpoints, 100 for comment
+// threads and comments, and 1000 for channel members. A zero MaxResults
+// uses the API default. To collect more items than one page holds, follow
+// NextPageToken.
+//
 // # Quota Costs
 //
 // Most endpoints cost 1 quota unit per call. The exception is search.list
 // which costs 100 quota units per call - use sparingly!
 //
-//	| Operation           | Quota Cost |
-//	|---------------------|------------|
-//	| videos.list         | 1          |
-//	| channels.list       | 1          |
-//	| playlists.list      | 1          |
-//	| playlistItems.list  | 1          |
-//	| search.list         | 100        |
-//	| commentThreads.list | 1          |
-//	| comments.list       | 1          |
-//	| subscriptions.list  | 1          |
+//	| Operation            | Quota Cost |
+//	|----------------------|------------|
+//	| videos.list          | 1          |
+//	| channels.list        | 1          |
+//	| playlists.list       | 1          |
+//	| playlistItems.list   | 1          |
+//	| search.list          | 100        |
+//	| commentThreads.list  | 1          |
+//	| comments.list        | 1          |
+//	| subscriptions.list   | 1          |
+//	| videoCategories.list | 1          |
 package data
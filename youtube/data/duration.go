@@ -0,0 +1,113 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration returns the video's length, parsed from ContentDetails.Duration.
+// Requires the contentDetails part.
+//
+// The bool is false if contentDetails was not requested, the duration is
+// malformed, or it is zero. YouTube reports live and upcoming broadcasts
+// with a zero duration ("P0D"), which is not a real length:
+//
+//	if d, ok := video.Duration(); ok {
+//		fmt.Printf("%s runs %s\n", video.ID, d)
+//	}
+func (v *Video) Duration() (time.Duration, bool) {
+	if v.ContentDetails == nil || v.ContentDetails.Duration == "" {
+		return 0, false
+	}
+	d, err := ParseISODuration(v.ContentDetails.Duration)
+	if err != nil || d == 0 {
+		return 0, false
+	}
+	return d, true
+}
+
+// ParseISODuration parses an ISO 8601 duration as used by the YouTube API,
+// such as "PT1H2M3S", "P1DT2H", or "P0D". Weeks (W) and days (D) are
+// converted as 7 days and 24 hours; seconds may be fractional. Years and
+// months have no fixed length and are rejected.
+func ParseISODuration(s string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" || rest == "T" {
+		return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+
+	var total time.Duration
+	inTime := false
+	last := -1 // position of the previous designator, which must come earlier
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+			}
+			inTime = true
+			rest = rest[1:]
+			if rest == "" {
+				return 0, fmt.Errorf("invalid ISO 8601 duration %q: no time components after T", s)
+			}
+			continue
+		}
+
+		i := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if i <= 0 {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+		number, designator := rest[:i], rest[i]
+		rest = rest[i+1:]
+
+		unit, pos, err := durationUnit(designator, inTime)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+		}
+		if pos <= last {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: %c out of order", s, designator)
+		}
+		last = pos
+
+		var part float64
+		if designator == 'S' {
+			part, err = strconv.ParseFloat(number, 64)
+		} else {
+			var n uint64
+			n, err = strconv.ParseUint(number, 10, 63)
+			part = float64(n)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+		}
+
+		value := part * float64(unit)
+		if value >= float64(math.MaxInt64-total) {
+			return 0, fmt.Errorf("ISO 8601 duration %q out of range", s)
+		}
+		total += time.Duration(value)
+	}
+	return total, nil
+}
+
+// durationUnit returns the length of an ISO 8601 duration designator and
+// its position in the required W, D, H, M, S order.
+func durationUnit(designator byte, inTime bool) (time.Duration, int, error) {
+	switch {
+	case !inTime && designator == 'W':
+		return 7 * 24 * time.Hour, 0, nil
+	case !inTime && designator == 'D':
+		return 24 * time.Hour, 1, nil
+	case !inTime && (designator == 'Y' || designator == 'M'):
+		return 0, 0, fmt.Errorf("years and months have no fixed length")
+	case inTime && designator == 'H':
+		return time.Hour, 2, nil
+	case inTime && designator == 'M':
+		return time.Minute, 3, nil
+	case inTime && designator == 'S':
+		return time.Second, 4, nil
+	}
+	return 0, 0, fmt.Errorf("unexpected designator %q", designator)
+}
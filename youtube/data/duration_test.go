@@ -0,0 +1,79 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"PT1H2M3S", time.Hour + 2*time.Minute + 3*time.Second, false},
+		{"PT5M", 5 * time.Minute, false},
+		{"PT45S", 45 * time.Second, false},
+		{"PT2H", 2 * time.Hour, false},
+		{"PT1.5S", 1500 * time.Millisecond, false},
+		{"P0D", 0, false},
+		{"PT0S", 0, false},
+		{"P1DT2H", 26 * time.Hour, false},
+		{"P2W", 14 * 24 * time.Hour, false},
+		{"P1W2DT3H4M5S", 9*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second, false},
+		{"", 0, true},
+		{"P", 0, true},
+		{"PT", 0, true},
+		{"P1DT", 0, true},
+		{"1H", 0, true},
+		{"PT1H2H", 0, true},
+		{"PT3S2M", 0, true},
+		{"P1H", 0, true},
+		{"PT1D", 0, true},
+		{"P1Y", 0, true},
+		{"P1M", 0, true},
+		{"PT1.5M", 0, true},
+		{"PTH", 0, true},
+		{"PT1HT2M", 0, true},
+		{"PT-1S", 0, true},
+		{"PT5", 0, true},
+		{"P99999999999999W", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseISODuration(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseISODuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseISODuration(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVideo_Duration(t *testing.T) {
+	tests := []struct {
+		name   string
+		video  *Video
+		want   time.Duration
+		wantOK bool
+	}{
+		{"no content details", &Video{}, 0, false},
+		{"empty duration", &Video{ContentDetails: &VideoContentDetails{}}, 0, false},
+		{"video", &Video{ContentDetails: &VideoContentDetails{Duration: "PT1H2M3S"}}, time.Hour + 2*time.Minute + 3*time.Second, true},
+		{"long stream", &Video{ContentDetails: &VideoContentDetails{Duration: "P1DT4H"}}, 28 * time.Hour, true},
+		{"live or upcoming", &Video{ContentDetails: &VideoContentDetails{Duration: "P0D"}}, 0, false},
+		{"malformed", &Video{ContentDetails: &VideoContentDetails{Duration: "1:02:03"}}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.video.Duration()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Duration() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// DefaultLiveChatWaitInterval is the time between checks in
+// WaitForLiveChatID when no interval is given.
+const DefaultLiveChatWaitInterval = 30 * time.Second
+
+// NotLiveError is returned by WaitForLiveChatID when the video will never
+// have an active live chat: it is not a live stream, or the stream has
+// already ended.
+type NotLiveError struct {
+	// VideoID is the video that was waited on.
+	VideoID string
+
+	// Ended reports whether the video was a live stream that has ended, as
+	// opposed to a video that was never a live stream.
+	Ended bool
+}
+
+// Error implements the error interface.
+func (e *NotLiveError) Error() string {
+	if e.Ended {
+		return fmt.Sprintf("video %s: live stream has ended", e.VideoID)
+	}
+	return fmt.Sprintf("video %s is not a live stream", e.VideoID)
+}
+
+// WaitForLiveChatID waits for an upcoming stream's live chat to become
+// active and returns its ID, e.g. for a bot started before the stream goes
+// live. It checks the video every poll interval (DefaultLiveChatWaitInterval
+// if poll is zero or negative) until the chat is active, returning
+// immediately if it already is:
+//
+//	ctx, cancel := context.WithDeadline(ctx, scheduledStart.Add(time.Hour))
+//	defer cancel()
+//	liveChatID, err := data.WaitForLiveChatID(ctx, client, videoID, time.Minute)
+//	var notLive *data.NotLiveError
+//	if errors.As(err, &notLive) {
+//		return // not a live stream, or it already ended
+//	}
+//
+// It returns a *NotLiveError when the video is not a live stream or the
+// stream has ended, and the context's error when it is done first. A
+// stream that is scheduled but never starts is waited on until the context
+// is done, so give the context a deadline. Transient API errors (see
+// core.IsRetryable) are retried at the next check; other errors, such as a
+// *core.NotFoundError for a deleted video, are returned.
+// Quota cost: 1 unit per check.
+func WaitForLiveChatID(ctx context.Context, client *core.Client, videoID string, poll time.Duration) (string, error) {
+	if videoID == "" {
+		return "", fmt.Errorf("video ID cannot be empty")
+	}
+	if poll <= 0 {
+		poll = DefaultLiveChatWaitInterval
+	}
+
+	for {
+		video, err := GetVideo(ctx, client, videoID, "snippet", "liveStreamingDetails")
+		switch {
+		case err == nil:
+			details := video.LiveStreamingDetails
+			if details == nil {
+				return "", &NotLiveError{VideoID: videoID}
+			}
+			if details.ActiveLiveChatID != "" {
+				return details.ActiveLiveChatID, nil
+			}
+			if details.ActualEndTime != nil || (video.Snippet != nil && video.Snippet.LiveBroadcastContent == "none") {
+				return "", &NotLiveError{VideoID: videoID, Ended: true}
+			}
+		case ctx.Err() != nil:
+			return "", ctx.Err()
+		case !core.IsRetryable(err):
+			return "", err
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(poll):
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestWaitForLiveChatID(t *testing.T) {
+	upcoming := &Video{
+		ID:                   "video123",
+		Snippet:              &VideoSnippet{LiveBroadcastContent: "upcoming"},
+		LiveStreamingDetails: &LiveStreamingDetails{},
+	}
+	live := &Video{
+		ID:                   "video123",
+		Snippet:              &VideoSnippet{LiveBroadcastContent: "live"},
+		LiveStreamingDetails: &LiveStreamingDetails{ActiveLiveChatID: "chat123"},
+	}
+	ended := time.Now()
+
+	tests := []struct {
+		name      string
+		responses []any // *Video, or an int status code
+		wantID    string
+		wantErr   func(error) bool
+		wantCalls int32
+	}{
+		{
+			name:      "already live",
+			responses: []any{live},
+			wantID:    "chat123",
+			wantCalls: 1,
+		},
+		{
+			name:      "goes live",
+			responses: []any{upcoming, upcoming, live},
+			wantID:    "chat123",
+			wantCalls: 3,
+		},
+		{
+			name:      "transient error retried",
+			responses: []any{upcoming, http.StatusServiceUnavailable, live},
+			wantID:    "chat123",
+			wantCalls: 3,
+		},
+		{
+			name:      "not a live stream",
+			responses: []any{&Video{ID: "video123", Snippet: &VideoSnippet{LiveBroadcastContent: "none"}}},
+			wantErr: func(err error) bool {
+				var notLive *NotLiveError
+				return errors.As(err, &notLive) && !notLive.Ended
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "ended before the chat was seen",
+			responses: []any{upcoming, &Video{
+				ID:                   "video123",
+				Snippet:              &VideoSnippet{LiveBroadcastContent: "none"},
+				LiveStreamingDetails: &LiveStreamingDetails{ActualEndTime: &ended},
+			}},
+			wantErr: func(err error) bool {
+				var notLive *NotLiveError
+				return errors.As(err, &notLive) && notLive.Ended
+			},
+			wantCalls: 2,
+		},
+		{
+			name:      "deleted",
+			responses: []any{upcoming, nil},
+			wantErr: func(err error) bool {
+				var notFound *core.NotFoundError
+				return errors.As(err, &notFound)
+			},
+			wantCalls: 2,
+		},
+		{
+			name:      "permanent error",
+			responses: []any{http.StatusForbidden},
+			wantErr: func(err error) bool {
+				var apiErr *core.APIError
+				return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+			},
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(calls.Add(1)) - 1
+				if got := r.URL.Query().Get("part"); got != "snippet,liveStreamingDetails" {
+					t.Errorf("part = %q", got)
+				}
+				switch resp := tt.responses[min(n, len(tt.responses)-1)].(type) {
+				case int:
+					w.WriteHeader(resp)
+					_, _ = w.Write([]byte(`{"error":{"message":"failed"}}`))
+				case *Video:
+					_ = json.NewEncoder(w).Encode(VideoListResponse{Items: []*Video{resp}})
+				default:
+					_ = json.NewEncoder(w).Encode(VideoListResponse{})
+				}
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			id, err := WaitForLiveChatID(context.Background(), client, "video123", time.Millisecond)
+
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("error = %v", err)
+				}
+			} else if err != nil || id != tt.wantID {
+				t.Errorf("WaitForLiveChatID() = %q, %v, want %q", id, err, tt.wantID)
+			}
+			if calls.Load() != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls.Load(), tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestWaitForLiveChatID_Context(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(VideoListResponse{Items: []*Video{{
+			ID:                   "video123",
+			LiveStreamingDetails: &LiveStreamingDetails{},
+		}}})
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	client := core.NewClient(core.WithBaseURL(server.URL))
+
+	_, err := WaitForLiveChatID(ctx, client, "video123", 0)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want context.DeadlineExceeded", err)
+	}
+
+	if _, err := WaitForLiveChatID(context.Background(), client, "", time.Second); err == nil {
+		t.Error("expected error for empty video ID")
+	}
+}
+
+func TestNotLiveError(t *testing.T) {
+	if got := (&NotLiveError{VideoID: "v1"}).Error(); got != "video v1 is not a live stream" {
+		t.Errorf("Error() = %q", got)
+	}
+	if got := (&NotLiveError{VideoID: "v1", Ended: true}).Error(); got != "video v1: live stream has ended" {
+		t.Errorf("Error() = %q", got)
+	}
+}
@@ -0,0 +1,233 @@
+package data
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// Member list modes.
+const (
+	// MembersModeAll lists all current members, from newest to oldest.
+	MembersModeAll = "all_current"
+
+	// MembersModeUpdates lists only members who joined or upgraded since the
+	// previous call. Pass NextPageToken from the prior response as PageToken.
+	MembersModeUpdates = "updates"
+)
+
+// Member represents a channel member (sponsor).
+type Member struct {
+	// Kind is the resource type (youtube#member).
+	Kind string `json:"kind,omitempty"`
+
+	// ETag is the entity tag.
+	ETag string `json:"etag,omitempty"`
+
+	// Snippet contains details about the member and their membership.
+	Snippet *MemberSnippet `json:"snippet,omitempty"`
+}
+
+// MemberSnippet contains details about a member.
+type MemberSnippet struct {
+	// CreatorChannelID is the ID of the channel the member belongs to.
+	CreatorChannelID string `json:"creatorChannelId,omitempty"`
+
+	// MemberDetails identifies the member.
+	MemberDetails *MemberDetails `json:"memberDetails,omitempty"`
+
+	// MembershipsDetails describes the member's levels and tenure.
+	MembershipsDetails *MembershipsDetails `json:"membershipsDetails,omitempty"`
+}
+
+// MemberDetails identifies a member's channel.
+type MemberDetails struct {
+	// ChannelID is the member's channel ID.
+	ChannelID string `json:"channelId,omitempty"`
+
+	// ChannelURL is the member's channel URL.
+	ChannelURL string `json:"channelUrl,omitempty"`
+
+	// DisplayName is the member's channel name.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// ProfileImageURL is the member's avatar URL.
+	ProfileImageURL string `json:"profileImageUrl,omitempty"`
+}
+
+// MembershipsDetails describes a member's levels and tenure.
+type MembershipsDetails struct {
+	// HighestAccessibleLevel is the ID of the highest level the member can access.
+	HighestAccessibleLevel string `json:"highestAccessibleLevel,omitempty"`
+
+	// HighestAccessibleLevelDisplayName is the display name of that level.
+	HighestAccessibleLevelDisplayName string `json:"highestAccessibleLevelDisplayName,omitempty"`
+
+	// AccessibleLevels are the IDs of all levels the member can access.
+	AccessibleLevels []string `json:"accessibleLevels,omitempty"`
+
+	// MembershipsDuration is the member's total tenure.
+	MembershipsDuration *MembershipsDuration `json:"membershipsDuration,omitempty"`
+
+	// MembershipsDurationAtLevel is the member's tenure at each level.
+	MembershipsDurationAtLevel []*MembershipsDurationAtLevel `json:"membershipsDurationAtLevel,omitempty"`
+}
+
+// MembershipsDuration describes how long a member has been a member.
+type MembershipsDuration struct {
+	// MemberSince is when the current membership started.
+	MemberSince time.Time `json:"memberSince,omitempty"`
+
+	// MemberTotalDurationMonths is the total number of months of membership,
+	// rounded down, including previous memberships.
+	MemberTotalDurationMonths int `json:"memberTotalDurationMonths,omitempty"`
+}
+
+// MembershipsDurationAtLevel describes a member's tenure at one level.
+type MembershipsDurationAtLevel struct {
+	// Level is the membership level ID.
+	Level string `json:"level,omitempty"`
+
+	// MemberSince is when the member first reached this level.
+	MemberSince time.Time `json:"memberSince,omitempty"`
+
+	// MemberTotalDurationMonths is the number of months at this level.
+	MemberTotalDurationMonths int `json:"memberTotalDurationMonths,omitempty"`
+}
+
+// MemberListResponse is the response from members.list.
+type MemberListResponse struct {
+	// Kind is the resource type.
+	Kind string `json:"kind,omitempty"`
+
+	// ETag is the entity tag.
+	ETag string `json:"etag,omitempty"`
+
+	// NextPageToken is the token for the next page.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+
+	// PageInfo contains paging information.
+	PageInfo *PageInfo `json:"pageInfo,omitempty"`
+
+	// Items contains the members.
+	Items []*Member `json:"items,omitempty"`
+}
+
+// GetMembersParams contains parameters for members.list.
+type GetMembersParams struct {
+	// Mode is MembersModeAll (default) or MembersModeUpdates.
+	Mode string
+
+	// HasAccessToLevel returns only members who can access the given level ID,
+	// including members of higher levels.
+	HasAccessToLevel string
+
+	// Level returns only members whose highest accessible level is the given
+	// level ID. Unlike HasAccessToLevel, this filter is applied client-side,
+	// so a page may contain fewer than MaxResults items.
+	Level string
+
+	// ChannelIDs returns only the given members (up to 100 channel IDs).
+	ChannelIDs []string
+
+	// MaxResults is the maximum number of items to return (0-1000).
+	// Larger values are clamped to 1000.
+	MaxResults int
+
+	// PageToken is the token for pagination.
+	PageToken string
+}
+
+// GetMembers lists the members of the authenticated user's channel.
+//
+// Requires OAuth authentication with youtube.channel-memberships.creator scope
+// (auth.ScopeChannelMemberships). Returns an error wrapping
+// ErrMembershipsForbidden if access is denied.
+// Quota cost: 2 units.
+func GetMembers(ctx context.Context, client *core.Client, params *GetMembersParams) (*MemberListResponse, error) {
+	if params == nil {
+		params = &GetMembersParams{}
+	}
+
+	mode := params.Mode
+	if mode == "" {
+		mode = MembersModeAll
+	}
+
+	query := url.Values{}
+	query.Set("part", "snippet")
+	query.Set("mode", mode)
+
+	if params.HasAccessToLevel != "" {
+		query.Set("hasAccessToLevel", params.HasAccessToLevel)
+	}
+	if len(params.ChannelIDs) > 0 {
+		query.Set("filterByMemberChannelId", strings.Join(params.ChannelIDs, ","))
+	}
+	core.SetMaxResults(query, params.MaxResults, 1000)
+	if params.PageToken != "" {
+		query.Set("pageToken", params.PageToken)
+	}
+
+	var resp MemberListResponse
+	err := client.Get(ctx, "members", query, "members.list", &resp)
+	if err != nil {
+		return nil, membershipsError(err)
+	}
+
+	if params.Level != "" {
+		filtered := resp.Items[:0]
+		for _, m := range resp.Items {
+			if m.LevelID() == params.Level {
+				filtered = append(filtered, m)
+			}
+		}
+		resp.Items = filtered
+	}
+
+	return &resp, nil
+}
+
+// ChannelID returns the member's channel ID, or "" if not available.
+func (m *Member) ChannelID() string {
+	if m.Snippet == nil || m.Snippet.MemberDetails == nil {
+		return ""
+	}
+	return m.Snippet.MemberDetails.ChannelID
+}
+
+// DisplayName returns the member's channel name, or "" if not available.
+func (m *Member) DisplayName() string {
+	if m.Snippet == nil || m.Snippet.MemberDetails == nil {
+		return ""
+	}
+	return m.Snippet.MemberDetails.DisplayName
+}
+
+// LevelID returns the ID of the member's current (highest accessible) level.
+func (m *Member) LevelID() string {
+	if m.Snippet == nil || m.Snippet.MembershipsDetails == nil {
+		return ""
+	}
+	return m.Snippet.MembershipsDetails.HighestAccessibleLevel
+}
+
+// LevelName returns the display name of the member's current level.
+func (m *Member) LevelName() string {
+	if m.Snippet == nil || m.Snippet.MembershipsDetails == nil {
+		return ""
+	}
+	return m.Snippet.MembershipsDetails.HighestAccessibleLevelDisplayName
+}
+
+// MemberSince returns when the member's current membership started,
+// or the zero time if not available.
+func (m *Member) MemberSince() time.Time {
+	if m.Snippet == nil || m.Snippet.MembershipsDetails == nil || m.Snippet.MembershipsDetails.MembershipsDuration == nil {
+		return time.Time{}
+	}
+	return m.Snippet.MembershipsDetails.MembershipsDuration.MemberSince
+}
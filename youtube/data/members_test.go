@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+const membersJSON = `{
+	"kind": "youtube#memberListResponse",
+	"nextPageToken": "page2",
+	"pageInfo": {"totalResults": 2, "resultsPerPage": 2},
+	"items": [
+		{
+			"kind": "youtube#member",
+			"snippet": {
+				"creatorChannelId": "UCcreator",
+				"memberDetails": {"channelId": "UCmember1", "displayName": "Alice"},
+				"membershipsDetails": {
+					"highestAccessibleLevel": "lvl2",
+					"highestAccessibleLevelDisplayName": "Silver",
+					"accessibleLevels": ["lvl1", "lvl2"],
+					"membershipsDuration": {"memberSince": "2024-01-15T00:00:00Z", "memberTotalDurationMonths": 14}
+				}
+			}
+		},
+		{
+			"kind": "youtube#member",
+			"snippet": {
+				"creatorChannelId": "UCcreator",
+				"memberDetails": {"channelId": "UCmember2", "displayName": "Bob"},
+				"membershipsDetails": {
+					"highestAccessibleLevel": "lvl1",
+					"highestAccessibleLevelDisplayName": "Bronze",
+					"accessibleLevels": ["lvl1"]
+				}
+			}
+		}
+	]
+}`
+
+func TestGetMembers(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    *GetMembersParams
+		wantQuery map[string]string
+		wantIDs   []string
+	}{
+		{
+			name:      "defaults",
+			params:    nil,
+			wantQuery: map[string]string{"part": "snippet", "mode": MembersModeAll},
+			wantIDs:   []string{"UCmember1", "UCmember2"},
+		},
+		{
+			name: "server filters",
+			params: &GetMembersParams{
+				Mode:             MembersModeUpdates,
+				HasAccessToLevel: "lvl1",
+				ChannelIDs:       []string{"UCmember1", "UCmember2"},
+				MaxResults:       500,
+				PageToken:        "tok",
+			},
+			wantQuery: map[string]string{
+				"mode":                    MembersModeUpdates,
+				"hasAccessToLevel":        "lvl1",
+				"filterByMemberChannelId": "UCmember1,UCmember2",
+				"maxResults":              "500",
+				"pageToken":               "tok",
+			},
+			wantIDs: []string{"UCmember1", "UCmember2"},
+		},
+		{
+			name:    "level filter",
+			params:  &GetMembersParams{Level: "lvl1"},
+			wantIDs: []string{"UCmember2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/members" {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				for k, v := range tt.wantQuery {
+					if got := r.URL.Query().Get(k); got != v {
+						t.Errorf("query %s = %q, want %q", k, got, v)
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(membersJSON))
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			resp, err := GetMembers(context.Background(), client, tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if resp.NextPageToken != "page2" {
+				t.Errorf("NextPageToken = %q, want 'page2'", resp.NextPageToken)
+			}
+			if len(resp.Items) != len(tt.wantIDs) {
+				t.Fatalf("len(Items) = %d, want %d", len(resp.Items), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if resp.Items[i].ChannelID() != id {
+					t.Errorf("Items[%d].ChannelID() = %q, want %q", i, resp.Items[i].ChannelID(), id)
+				}
+			}
+		})
+	}
+
+	t.Run("forbidden", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"Channel memberships not enabled","errors":[{"reason":"channelMembershipsNotEnabled"}]}}`))
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := GetMembers(context.Background(), client, nil)
+		if !errors.Is(err, ErrMembershipsForbidden) {
+			t.Errorf("error = %v, want ErrMembershipsForbidden", err)
+		}
+		var apiErr *core.APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("error should wrap *core.APIError, got %T", err)
+		}
+	})
+}
+
+func TestMember_Helpers(t *testing.T) {
+	m := &Member{Snippet: &MemberSnippet{
+		MemberDetails: &MemberDetails{ChannelID: "UC1", DisplayName: "Alice"},
+		MembershipsDetails: &MembershipsDetails{
+			HighestAccessibleLevel:            "lvl2",
+			HighestAccessibleLevelDisplayName: "Silver",
+			MembershipsDuration: &MembershipsDuration{
+				MemberSince: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}}
+
+	if m.ChannelID() != "UC1" {
+		t.Errorf("ChannelID() = %q, want UC1", m.ChannelID())
+	}
+	if m.DisplayName() != "Alice" {
+		t.Errorf("DisplayName() = %q, want Alice", m.DisplayName())
+	}
+	if m.LevelID() != "lvl2" {
+		t.Errorf("LevelID() = %q, want lvl2", m.LevelID())
+	}
+	if m.LevelName() != "Silver" {
+		t.Errorf("LevelName() = %q, want Silver", m.LevelName())
+	}
+	if !m.MemberSince().Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("MemberSince() = %v", m.MemberSince())
+	}
+
+	empty := &Member{}
+	if empty.ChannelID() != "" || empty.DisplayName() != "" || empty.LevelID() != "" || empty.LevelName() != "" || !empty.MemberSince().IsZero() {
+		t.Error("helpers on empty member should return zero values")
+	}
+}
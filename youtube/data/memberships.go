@@ -0,0 +1,141 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// ErrMembershipsForbidden is returned when the API refuses access to
+// channel membership data. This happens when the token lacks the
+// youtube.channel-memberships.creator scope (auth.ScopeChannelMemberships)
+// or the channel does not have memberships enabled.
+var ErrMembershipsForbidden = errors.New("channel memberships access forbidden")
+
+// MembershipLevel represents a channel membership level (tier).
+type MembershipLevel struct {
+	// Kind is the resource type (youtube#membershipsLevel).
+	Kind string `json:"kind,omitempty"`
+
+	// ETag is the entity tag.
+	ETag string `json:"etag,omitempty"`
+
+	// ID is the level's unique identifier.
+	ID string `json:"id,omitempty"`
+
+	// Snippet contains basic details about the level.
+	Snippet *MembershipLevelSnippet `json:"snippet,omitempty"`
+
+	// Rank is the level's 1-based position, from lowest to highest tier.
+	// It is set by GetMembershipLevels and is not part of the API response.
+	Rank int `json:"-"`
+}
+
+// MembershipLevelSnippet contains basic details about a membership level.
+type MembershipLevelSnippet struct {
+	// CreatorChannelID is the ID of the channel that offers the level.
+	CreatorChannelID string `json:"creatorChannelId,omitempty"`
+
+	// LevelDetails contains the level's display details.
+	LevelDetails *LevelDetails `json:"levelDetails,omitempty"`
+}
+
+// LevelDetails contains display details for a membership level.
+type LevelDetails struct {
+	// DisplayName is the level's name as shown to viewers.
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// DisplayName returns the level's display name, or "" if not available.
+func (l *MembershipLevel) DisplayName() string {
+	if l.Snippet == nil || l.Snippet.LevelDetails == nil {
+		return ""
+	}
+	return l.Snippet.LevelDetails.DisplayName
+}
+
+// MembershipLevelListResponse is the response from membershipsLevels.list.
+type MembershipLevelListResponse struct {
+	// Kind is the resource type.
+	Kind string `json:"kind,omitempty"`
+
+	// ETag is the entity tag.
+	ETag string `json:"etag,omitempty"`
+
+	// Items contains the membership levels.
+	Items []*MembershipLevel `json:"items,omitempty"`
+}
+
+// MembershipLevels is an ordered list of membership levels, from lowest to
+// highest tier.
+type MembershipLevels []*MembershipLevel
+
+// ByName returns the level with the given display name, or nil if none matches.
+func (ls MembershipLevels) ByName(name string) *MembershipLevel {
+	for _, l := range ls {
+		if l.DisplayName() == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// ByID returns the level with the given ID, or nil if none matches.
+func (ls MembershipLevels) ByID(id string) *MembershipLevel {
+	for _, l := range ls {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
+
+// Rank returns the 1-based rank of the level with the given display name,
+// or 0 if the name is unknown. Use it to gate perks by tier:
+//
+//	if levels.Rank(event.LevelName) >= levels.Rank("Gold") { ... }
+func (ls MembershipLevels) Rank(name string) int {
+	if l := ls.ByName(name); l != nil {
+		return l.Rank
+	}
+	return 0
+}
+
+// GetMembershipLevels retrieves the membership levels of the authenticated
+// user's channel, ordered from lowest to highest tier as returned by the API.
+// Each level's Rank is set to its 1-based position.
+//
+// Requires OAuth authentication with youtube.channel-memberships.creator scope
+// (auth.ScopeChannelMemberships). Returns an error wrapping
+// ErrMembershipsForbidden if access is denied.
+// Quota cost: 1 unit.
+func GetMembershipLevels(ctx context.Context, client *core.Client) (MembershipLevels, error) {
+	query := url.Values{}
+	query.Set("part", "id,snippet")
+
+	var resp MembershipLevelListResponse
+	err := client.Get(ctx, "membershipsLevels", query, "membershipsLevels.list", &resp)
+	if err != nil {
+		return nil, membershipsError(err)
+	}
+
+	levels := MembershipLevels(resp.Items)
+	for i, l := range levels {
+		l.Rank = i + 1
+	}
+	return levels, nil
+}
+
+// membershipsError wraps a 403 response with ErrMembershipsForbidden.
+// Quota errors, which also use 403, are returned unchanged.
+func membershipsError(err error) error {
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) && apiErr.IsForbidden() && !apiErr.IsQuotaExceeded() {
+		return fmt.Errorf("%w (requires youtube.channel-memberships.creator scope and memberships enabled on the channel): %w",
+			ErrMembershipsForbidden, err)
+	}
+	return err
+}
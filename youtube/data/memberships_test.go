@@ -0,0 +1,109 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+const membershipLevelsJSON = `{
+	"kind": "youtube#membershipsLevelListResponse",
+	"items": [
+		{"kind": "youtube#membershipsLevel", "id": "lvl1", "snippet": {"creatorChannelId": "UC1", "levelDetails": {"displayName": "Bronze"}}},
+		{"kind": "youtube#membershipsLevel", "id": "lvl2", "snippet": {"creatorChannelId": "UC1", "levelDetails": {"displayName": "Silver"}}},
+		{"kind": "youtube#membershipsLevel", "id": "lvl3", "snippet": {"creatorChannelId": "UC1", "levelDetails": {"displayName": "Gold"}}}
+	]
+}`
+
+func TestGetMembershipLevels(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/membershipsLevels" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+			if r.URL.Query().Get("part") != "id,snippet" {
+				t.Errorf("unexpected part: %s", r.URL.Query().Get("part"))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(membershipLevelsJSON))
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		levels, err := GetMembershipLevels(context.Background(), client)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(levels) != 3 {
+			t.Fatalf("len(levels) = %d, want 3", len(levels))
+		}
+		for i, l := range levels {
+			if l.Rank != i+1 {
+				t.Errorf("levels[%d].Rank = %d, want %d", i, l.Rank, i+1)
+			}
+		}
+		if levels[2].DisplayName() != "Gold" {
+			t.Errorf("DisplayName() = %q, want 'Gold'", levels[2].DisplayName())
+		}
+		if levels.Rank("Silver") != 2 {
+			t.Errorf("Rank(Silver) = %d, want 2", levels.Rank("Silver"))
+		}
+		if levels.Rank("Platinum") != 0 {
+			t.Errorf("Rank(Platinum) = %d, want 0", levels.Rank("Platinum"))
+		}
+		if l := levels.ByID("lvl1"); l == nil || l.DisplayName() != "Bronze" {
+			t.Errorf("ByID(lvl1) = %+v, want Bronze", l)
+		}
+		if levels.ByID("missing") != nil {
+			t.Error("ByID(missing) should be nil")
+		}
+	})
+
+	tests := []struct {
+		name          string
+		body          string
+		wantForbidden bool
+	}{
+		{
+			name:          "forbidden",
+			body:          `{"error":{"code":403,"message":"The request is not properly authorized.","errors":[{"reason":"insufficientPermissions"}]}}`,
+			wantForbidden: true,
+		},
+		{
+			name:          "quota exceeded",
+			body:          `{"error":{"code":403,"message":"Quota exceeded","errors":[{"reason":"quotaExceeded"}]}}`,
+			wantForbidden: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			_, err := GetMembershipLevels(context.Background(), client)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got := errors.Is(err, ErrMembershipsForbidden); got != tt.wantForbidden {
+				t.Errorf("errors.Is(ErrMembershipsForbidden) = %v, want %v (err: %v)", got, tt.wantForbidden, err)
+			}
+		})
+	}
+}
+
+func TestMembershipLevel_DisplayName(t *testing.T) {
+	if got := (&MembershipLevel{}).DisplayName(); got != "" {
+		t.Errorf("DisplayName() = %q, want empty", got)
+	}
+}
@@ -62,6 +62,13 @@ type PlaylistStatus struct {
 	PrivacyStatus string `json:"privacyStatus,omitempty"`
 }
 
+// Privacy status values for playlists and videos.
+const (
+	PrivacyPublic   = "public"
+	PrivacyUnlisted = "unlisted"
+	PrivacyPrivate  = "private"
+)
+
 // PlaylistContentDetails contains playlist content information.
 type PlaylistContentDetails struct {
 	// ItemCount is the number of items in the playlist.
@@ -105,6 +112,7 @@ type GetPlaylistsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -143,9 +151,7 @@ func GetPlaylists(ctx context.Context, client *core.Client, params *GetPlaylists
 	if params.Mine {
 		query.Set("mine", "true")
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -199,6 +205,106 @@ func GetMyPlaylists(ctx context.Context, client *core.Client, params *GetPlaylis
 	return GetPlaylists(ctx, client, params)
 }
 
+// CreatePlaylist creates a playlist on the authenticated user's channel.
+// privacy must be PrivacyPublic, PrivacyUnlisted, or PrivacyPrivate.
+// Requires OAuth authentication with youtube or youtube.force-ssl scope.
+// Quota cost: 50 units.
+func CreatePlaylist(ctx context.Context, client *core.Client, title, description, privacy string) (*Playlist, error) {
+	if title == "" {
+		return nil, fmt.Errorf("playlist title cannot be empty")
+	}
+	if err := validatePrivacy(privacy); err != nil {
+		return nil, err
+	}
+
+	playlist := &Playlist{
+		Snippet: &PlaylistSnippet{Title: title, Description: description},
+		Status:  &PlaylistStatus{PrivacyStatus: privacy},
+	}
+
+	query := url.Values{}
+	query.Set("part", "snippet,status")
+
+	var resp Playlist
+	err := client.Post(ctx, "playlists", query, playlist, "playlists.insert", &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdatePlaylist updates an existing playlist.
+// The playlist must include the ID field. The API replaces every part that is
+// updated, so include all snippet fields to keep (the title is required).
+// If parts is empty, the parts are derived from the non-nil Snippet and Status.
+// Requires OAuth authentication with youtube or youtube.force-ssl scope.
+// Quota cost: 50 units.
+func UpdatePlaylist(ctx context.Context, client *core.Client, playlist *Playlist, parts ...string) (*Playlist, error) {
+	if playlist == nil {
+		return nil, fmt.Errorf("playlist cannot be nil")
+	}
+	if playlist.ID == "" {
+		return nil, fmt.Errorf("playlist ID is required for update")
+	}
+	if playlist.Snippet != nil && playlist.Snippet.Title == "" {
+		return nil, fmt.Errorf("playlist title cannot be empty")
+	}
+	if playlist.Status != nil {
+		if err := validatePrivacy(playlist.Status.PrivacyStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(parts) == 0 {
+		if playlist.Snippet != nil {
+			parts = append(parts, "snippet")
+		}
+		if playlist.Status != nil {
+			parts = append(parts, "status")
+		}
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("playlist has no snippet or status to update")
+	}
+
+	query := url.Values{}
+	query.Set("part", strings.Join(parts, ","))
+
+	var resp Playlist
+	err := client.Put(ctx, "playlists", query, playlist, "playlists.update", &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeletePlaylist deletes a playlist.
+// Requires OAuth authentication with youtube or youtube.force-ssl scope.
+// Quota cost: 50 units.
+func DeletePlaylist(ctx context.Context, client *core.Client, playlistID string) error {
+	if playlistID == "" {
+		return fmt.Errorf("playlist ID cannot be empty")
+	}
+
+	query := url.Values{}
+	query.Set("id", playlistID)
+
+	return client.Delete(ctx, "playlists", query, "playlists.delete")
+}
+
+// validatePrivacy checks that privacy is a known privacy status.
+func validatePrivacy(privacy string) error {
+	switch privacy {
+	case PrivacyPublic, PrivacyUnlisted, PrivacyPrivate:
+		return nil
+	default:
+		return fmt.Errorf("invalid privacy status %q: must be %q, %q, or %q",
+			privacy, PrivacyPublic, PrivacyUnlisted, PrivacyPrivate)
+	}
+}
+
 // PlaylistItem represents an item in a playlist.
 type PlaylistItem struct {
 	// Kind is the resource type (youtube#playlistItem).
@@ -314,6 +420,7 @@ type GetPlaylistItemsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -349,9 +456,7 @@ func GetPlaylistItems(ctx context.Context, client *core.Client, params *GetPlayl
 	if len(params.IDs) > 0 {
 		query.Set("id", strings.Join(params.IDs, ","))
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
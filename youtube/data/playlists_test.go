@@ -246,6 +246,160 @@ func TestGetMyPlaylists(t *testing.T) {
 	})
 }
 
+func TestCreatePlaylist(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var body Playlist
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/playlists" {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+			if r.URL.Query().Get("part") != "snippet,status" {
+				t.Errorf("unexpected part: %s", r.URL.Query().Get("part"))
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			body.ID = "playlist123"
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(body)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		playlist, err := CreatePlaylist(context.Background(), client, "Highlights", "Best moments", PrivacyUnlisted)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if playlist.ID != "playlist123" {
+			t.Errorf("unexpected ID: %s", playlist.ID)
+		}
+		if body.Snippet == nil || body.Snippet.Title != "Highlights" || body.Snippet.Description != "Best moments" {
+			t.Errorf("unexpected snippet: %+v", body.Snippet)
+		}
+		if body.Status == nil || body.Status.PrivacyStatus != PrivacyUnlisted {
+			t.Errorf("unexpected status: %+v", body.Status)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client := core.NewClient()
+		tests := []struct {
+			name    string
+			title   string
+			privacy string
+		}{
+			{"empty title", "", PrivacyPublic},
+			{"empty privacy", "Title", ""},
+			{"invalid privacy", "Title", "friends"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := CreatePlaylist(context.Background(), client, tt.title, "", tt.privacy); err == nil {
+					t.Error("expected error")
+				}
+			})
+		}
+	})
+}
+
+func TestUpdatePlaylist(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/playlists" {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+			if r.URL.Query().Get("part") != "snippet,status" {
+				t.Errorf("unexpected part: %s", r.URL.Query().Get("part"))
+			}
+			var body Playlist
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(body)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		playlist, err := UpdatePlaylist(context.Background(), client, &Playlist{
+			ID:      "playlist123",
+			Snippet: &PlaylistSnippet{Title: "Renamed"},
+			Status:  &PlaylistStatus{PrivacyStatus: PrivacyPrivate},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if playlist.Snippet.Title != "Renamed" {
+			t.Errorf("unexpected title: %s", playlist.Snippet.Title)
+		}
+	})
+
+	t.Run("status only", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("part") != "status" {
+				t.Errorf("unexpected part: %s", r.URL.Query().Get("part"))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(Playlist{ID: "playlist123"})
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := UpdatePlaylist(context.Background(), client, &Playlist{
+			ID:     "playlist123",
+			Status: &PlaylistStatus{PrivacyStatus: PrivacyPublic},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client := core.NewClient()
+		tests := []struct {
+			name     string
+			playlist *Playlist
+		}{
+			{"nil playlist", nil},
+			{"missing ID", &Playlist{Snippet: &PlaylistSnippet{Title: "Title"}}},
+			{"empty title", &Playlist{ID: "playlist123", Snippet: &PlaylistSnippet{}}},
+			{"invalid privacy", &Playlist{ID: "playlist123", Status: &PlaylistStatus{PrivacyStatus: "friends"}}},
+			{"nothing to update", &Playlist{ID: "playlist123"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := UpdatePlaylist(context.Background(), client, tt.playlist); err == nil {
+					t.Error("expected error")
+				}
+			})
+		}
+	})
+}
+
+func TestDeletePlaylist(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodDelete || r.URL.Path != "/playlists" {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+			if r.URL.Query().Get("id") != "playlist123" {
+				t.Errorf("unexpected id: %s", r.URL.Query().Get("id"))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		if err := DeletePlaylist(context.Background(), client, "playlist123"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		client := core.NewClient()
+		if err := DeletePlaylist(context.Background(), client, ""); err == nil {
+			t.Fatal("expected error for empty playlist ID")
+		}
+	})
+}
+
 func TestGetPlaylistItems(t *testing.T) {
 	t.Run("success with playlistId", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -367,6 +521,38 @@ func TestGetPlaylistItems(t *testing.T) {
 			t.Fatalf("unexpected error: %v", err)
 		}
 	})
+
+	t.Run("max results clamped", func(t *testing.T) {
+		tests := []struct {
+			maxResults int
+			want       string
+		}{
+			{0, ""},
+			{-1, ""},
+			{50, "50"},
+			{100, "50"},
+		}
+		for _, tt := range tests {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("maxResults"); got != tt.want {
+					t.Errorf("MaxResults %d: maxResults = %q, want %q", tt.maxResults, got, tt.want)
+				}
+				resp := PlaylistItemListResponse{}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			_, err := GetPlaylistItems(context.Background(), client, &GetPlaylistItemsParams{
+				PlaylistID: "playlist123",
+				MaxResults: tt.maxResults,
+			})
+			server.Close()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	})
 }
 
 func TestPlaylistItem_VideoID(t *testing.T) {
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// Video rating values for RateVideo and VideoRating.Rating.
+const (
+	VideoRatingLike    = "like"
+	VideoRatingDislike = "dislike"
+	VideoRatingNone    = "none"
+
+	// VideoRatingUnspecified is returned by GetRating for videos the user
+	// has not rated.
+	VideoRatingUnspecified = "unspecified"
+)
+
+// maxRatingIDs is the maximum number of video IDs per videos.getRating call.
+const maxRatingIDs = 50
+
+// VideoRating is the authenticated user's rating of a video.
+type VideoRating struct {
+	// VideoID is the video's ID.
+	VideoID string `json:"videoId,omitempty"`
+
+	// Rating is VideoRatingLike, VideoRatingDislike, VideoRatingNone, or
+	// VideoRatingUnspecified.
+	Rating string `json:"rating,omitempty"`
+}
+
+// VideoGetRatingResponse is the response from videos.getRating.
+type VideoGetRatingResponse struct {
+	// Kind is the resource type.
+	Kind string `json:"kind,omitempty"`
+
+	// ETag is the entity tag.
+	ETag string `json:"etag,omitempty"`
+
+	// Items contains the ratings.
+	Items []*VideoRating `json:"items,omitempty"`
+}
+
+// RateVideo likes or dislikes a video as the authenticated user, or removes
+// the rating. rating must be VideoRatingLike, VideoRatingDislike, or
+// VideoRatingNone.
+// Requires OAuth authentication with youtube or youtube.force-ssl scope.
+// Quota cost: 50 units.
+func RateVideo(ctx context.Context, client *core.Client, videoID, rating string) error {
+	if videoID == "" {
+		return fmt.Errorf("video ID cannot be empty")
+	}
+	switch rating {
+	case VideoRatingLike, VideoRatingDislike, VideoRatingNone:
+	default:
+		return fmt.Errorf("invalid rating %q: must be %q, %q, or %q",
+			rating, VideoRatingLike, VideoRatingDislike, VideoRatingNone)
+	}
+
+	query := url.Values{}
+	query.Set("id", videoID)
+	query.Set("rating", rating)
+
+	return client.Post(ctx, "videos/rate", query, nil, "videos.rate", nil)
+}
+
+// GetRating retrieves the authenticated user's ratings of the given videos.
+// IDs are sent in batches of 50, so any number may be passed; ratings are
+// returned in the order the API reports them, batch by batch.
+// Requires OAuth authentication.
+// Quota cost: 1 unit per batch of 50 IDs.
+func GetRating(ctx context.Context, client *core.Client, videoIDs []string) ([]*VideoRating, error) {
+	if len(videoIDs) == 0 {
+		return nil, fmt.Errorf("at least one video ID is required")
+	}
+
+	ratings := make([]*VideoRating, 0, len(videoIDs))
+	for start := 0; start < len(videoIDs); start += maxRatingIDs {
+		batch := videoIDs[start:min(start+maxRatingIDs, len(videoIDs))]
+
+		query := url.Values{}
+		query.Set("id", strings.Join(batch, ","))
+
+		var resp VideoGetRatingResponse
+		err := client.Get(ctx, "videos/getRating", query, "videos.getRating", &resp)
+		if err != nil {
+			return nil, err
+		}
+		ratings = append(ratings, resp.Items...)
+	}
+
+	return ratings, nil
+}
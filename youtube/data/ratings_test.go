@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestRateVideo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/videos/rate" {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}
+			if r.URL.Query().Get("id") != "video123" {
+				t.Errorf("unexpected id: %s", r.URL.Query().Get("id"))
+			}
+			if r.URL.Query().Get("rating") != "like" {
+				t.Errorf("unexpected rating: %s", r.URL.Query().Get("rating"))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		if err := RateVideo(context.Background(), client, "video123", VideoRatingLike); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client := core.NewClient()
+		tests := []struct {
+			name    string
+			videoID string
+			rating  string
+		}{
+			{"empty video ID", "", VideoRatingLike},
+			{"empty rating", "video123", ""},
+			{"unspecified is read-only", "video123", VideoRatingUnspecified},
+			{"invalid rating", "video123", "love"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if err := RateVideo(context.Background(), client, tt.videoID, tt.rating); err == nil {
+					t.Error("expected error")
+				}
+			})
+		}
+	})
+}
+
+func TestGetRating(t *testing.T) {
+	t.Run("batches of 50", func(t *testing.T) {
+		var batches []int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/videos/getRating" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+			ids := strings.Split(r.URL.Query().Get("id"), ",")
+			batches = append(batches, len(ids))
+
+			var resp VideoGetRatingResponse
+			for _, id := range ids {
+				resp.Items = append(resp.Items, &VideoRating{VideoID: id, Rating: VideoRatingLike})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		ids := make([]string, 120)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("video%d", i)
+		}
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		ratings, err := GetRating(context.Background(), client, ids)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(batches) != 3 || batches[0] != 50 || batches[1] != 50 || batches[2] != 20 {
+			t.Errorf("unexpected batch sizes: %v", batches)
+		}
+		if len(ratings) != 120 {
+			t.Fatalf("expected 120 ratings, got %d", len(ratings))
+		}
+		if ratings[119].VideoID != "video119" {
+			t.Errorf("unexpected last video ID: %s", ratings[119].VideoID)
+		}
+	})
+
+	t.Run("empty IDs", func(t *testing.T) {
+		client := core.NewClient()
+		if _, err := GetRating(context.Background(), client, nil); err == nil {
+			t.Fatal("expected error for empty IDs")
+		}
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code":401,"message":"Login Required","errors":[{"reason":"required"}]}}`))
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		if _, err := GetRating(context.Background(), client, []string{"video123"}); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
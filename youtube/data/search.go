@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -164,11 +165,22 @@ type SearchParams struct {
 	// Values: "any", "episode", "movie"
 	VideoType string
 
+	// Location restricts results to videos geotagged near a point, as
+	// "latitude,longitude" in decimal degrees (e.g., "37.42307,-122.08427").
+	// Requires LocationRadius, and Type must be "video".
+	Location string
+
+	// LocationRadius is the distance from Location to search within: a
+	// number followed by m, km, ft, or mi (e.g., "10km"), up to 1000 km.
+	// Requires Location.
+	LocationRadius string
+
 	// Parts specifies which parts to include in the response.
 	// Common values: "snippet"
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -185,6 +197,9 @@ func Search(ctx context.Context, client *core.Client, params *SearchParams) (*Se
 	if params == nil {
 		return nil, fmt.Errorf("params cannot be nil")
 	}
+	if err := validateSearchLocation(params); err != nil {
+		return nil, err
+	}
 
 	parts := params.Parts
 	if len(parts) == 0 {
@@ -236,9 +251,11 @@ func Search(ctx context.Context, client *core.Client, params *SearchParams) (*Se
 	if params.VideoType != "" {
 		query.Set("videoType", params.VideoType)
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
+	if params.Location != "" {
+		query.Set("location", params.Location)
+		query.Set("locationRadius", params.LocationRadius)
 	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -252,6 +269,64 @@ func Search(ctx context.Context, client *core.Client, params *SearchParams) (*Se
 	return &resp, nil
 }
 
+// maxLocationRadiusMeters is the largest locationRadius the API accepts.
+const maxLocationRadiusMeters = 1000 * 1000
+
+// locationRadiusUnits are the locationRadius units and their length in meters.
+var locationRadiusUnits = []struct {
+	suffix string
+	meters float64
+}{
+	// "km" before "m", since "10km" also ends in "m".
+	{"km", 1000},
+	{"m", 1},
+	{"ft", 0.3048},
+	{"mi", 1609.344},
+}
+
+// validateSearchLocation checks the geo parameters against the API's rules,
+// which otherwise fail with a generic invalid-argument error.
+func validateSearchLocation(params *SearchParams) error {
+	if params.Location == "" && params.LocationRadius == "" {
+		return nil
+	}
+	if params.Location == "" {
+		return fmt.Errorf("location radius requires a location")
+	}
+	if params.LocationRadius == "" {
+		return fmt.Errorf("location requires a location radius")
+	}
+	if params.Type != SearchTypeVideo {
+		return fmt.Errorf("location search requires type %q, got %q", SearchTypeVideo, params.Type)
+	}
+
+	lat, lng, ok := strings.Cut(params.Location, ",")
+	latitude, latErr := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	longitude, lngErr := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+	if !ok || latErr != nil || lngErr != nil {
+		return fmt.Errorf("invalid location %q: want \"latitude,longitude\"", params.Location)
+	}
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return fmt.Errorf("location %q out of range", params.Location)
+	}
+
+	for _, unit := range locationRadiusUnits {
+		number, ok := strings.CutSuffix(params.LocationRadius, unit.suffix)
+		if !ok {
+			continue
+		}
+		radius, err := strconv.ParseFloat(number, 64)
+		if err != nil || radius <= 0 {
+			break
+		}
+		if radius*unit.meters > maxLocationRadiusMeters {
+			return fmt.Errorf("location radius %q exceeds 1000 km", params.LocationRadius)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid location radius %q: want a positive number followed by m, km, ft, or mi", params.LocationRadius)
+}
+
 // SearchVideos searches for videos.
 // WARNING: Each call costs 100 quota units! Use sparingly.
 // Quota cost: 100 units per call.
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -165,6 +167,66 @@ func TestSearch(t *testing.T) {
 	})
 }
 
+func TestSearch_Location(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(SearchListResponse{})
+	}))
+	defer server.Close()
+	client := core.NewClient(core.WithBaseURL(server.URL))
+
+	tests := []struct {
+		name     string
+		typ      string
+		location string
+		radius   string
+		wantErr  string
+	}{
+		{"km radius", SearchTypeVideo, "37.42307,-122.08427", "10km", ""},
+		{"meters", SearchTypeVideo, "51.5074, -0.1278", "500m", ""},
+		{"feet", SearchTypeVideo, "0,0", "1500.5ft", ""},
+		{"miles at limit", SearchTypeVideo, "-33.86,151.21", "621mi", ""},
+		{"missing radius", SearchTypeVideo, "37.4,-122.1", "", "requires a location radius"},
+		{"missing location", SearchTypeVideo, "", "10km", "requires a location"},
+		{"no type", "", "37.4,-122.1", "10km", "requires type"},
+		{"channel type", SearchTypeChannel, "37.4,-122.1", "10km", "requires type"},
+		{"malformed location", SearchTypeVideo, "Mountain View", "10km", "invalid location"},
+		{"latitude out of range", SearchTypeVideo, "91,0", "10km", "out of range"},
+		{"longitude out of range", SearchTypeVideo, "0,-181", "10km", "out of range"},
+		{"unknown unit", SearchTypeVideo, "0,0", "10yd", "invalid location radius"},
+		{"no unit", SearchTypeVideo, "0,0", "10", "invalid location radius"},
+		{"zero radius", SearchTypeVideo, "0,0", "0km", "invalid location radius"},
+		{"too far", SearchTypeVideo, "0,0", "1001km", "exceeds 1000 km"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query = nil
+			_, err := Search(context.Background(), client, &SearchParams{
+				Type:           tt.typ,
+				Location:       tt.location,
+				LocationRadius: tt.radius,
+			})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %v, want %q", err, tt.wantErr)
+				}
+				if query != nil {
+					t.Error("request sent despite invalid location")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query.Get("location") != tt.location || query.Get("locationRadius") != tt.radius {
+				t.Errorf("query = %v, want location %q radius %q", query, tt.location, tt.radius)
+			}
+		})
+	}
+}
+
 func TestSearchVideos(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Query().Get("type") != "video" {
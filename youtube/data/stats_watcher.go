@@ -0,0 +1,357 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// DefaultStatsInterval is the default interval between channel statistics polls.
+const DefaultStatsInterval = 5 * time.Minute
+
+// ChannelStats is a snapshot of a channel's statistics.
+type ChannelStats struct {
+	// ViewCount is the total view count across all videos.
+	ViewCount int64
+
+	// SubscriberCount is the subscriber count. It is 0 when hidden.
+	SubscriberCount int64
+
+	// SubscriberCountHidden indicates the channel hides its subscriber count.
+	SubscriberCountHidden bool
+
+	// VideoCount is the number of public videos.
+	VideoCount int64
+
+	// FetchedAt is when the statistics were retrieved.
+	FetchedAt time.Time
+}
+
+// Handler wrapper types for pointer identity.
+type (
+	statsDeltaHandler struct{ fn func(int64) }
+	statsErrorHandler struct{ fn func(error) }
+)
+
+// StatsWatcher polls a channel's statistics and reports changes, e.g. to
+// announce subscriber milestones. The first poll establishes a baseline;
+// later polls fire the change handlers with the difference from the
+// previous poll when a count changes.
+//
+// YouTube rounds public subscriber counts to three significant figures, so
+// subscriber deltas arrive in steps (e.g., +1000 at 1.23M subscribers).
+// Channels that hide their subscriber count produce no subscriber deltas;
+// see ChannelStats.SubscriberCountHidden.
+//
+// Each poll costs 1 quota unit (channels.list).
+type StatsWatcher struct {
+	client    *core.Client
+	channelID string
+	interval  time.Duration
+
+	// Composable handlers (wrapper pointers for identity-based unsubscribe)
+	handlerMu          sync.RWMutex
+	subscriberHandlers []*statsDeltaHandler
+	viewHandlers       []*statsDeltaHandler
+	videoCountHandlers []*statsDeltaHandler
+	errorHandlers      []*statsErrorHandler
+
+	// Lifecycle and latest snapshot
+	mu     sync.Mutex
+	last   *ChannelStats
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// StatsWatcherOption configures a StatsWatcher.
+type StatsWatcherOption func(*StatsWatcher)
+
+// NewStatsWatcher creates a watcher for a channel's statistics.
+// Call Start to begin polling.
+func NewStatsWatcher(client *core.Client, channelID string, opts ...StatsWatcherOption) *StatsWatcher {
+	w := &StatsWatcher{
+		client:    client,
+		channelID: channelID,
+		interval:  DefaultStatsInterval,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// WithInterval sets how often statistics are polled (default DefaultStatsInterval).
+func WithInterval(d time.Duration) StatsWatcherOption {
+	return func(w *StatsWatcher) {
+		if d > 0 {
+			w.interval = d
+		}
+	}
+}
+
+// Start begins polling. The watcher runs until Stop is called or ctx is cancelled.
+func (w *StatsWatcher) Start(ctx context.Context) error {
+	if w.client == nil {
+		return fmt.Errorf("client cannot be nil")
+	}
+	if w.channelID == "" {
+		return fmt.Errorf("channel ID cannot be empty")
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.running() {
+		return core.ErrAlreadyRunning
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	w.done = make(chan struct{})
+	go w.watchLoop(watchCtx, w.done)
+	return nil
+}
+
+// Stop stops polling and waits for the watcher to shut down.
+// Safe to call multiple times.
+func (w *StatsWatcher) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+
+	w.mu.Lock()
+	if w.done == done {
+		w.cancel = nil
+		w.done = nil
+	}
+	w.mu.Unlock()
+}
+
+// IsRunning returns true if the watcher is polling.
+func (w *StatsWatcher) IsRunning() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.running()
+}
+
+// running reports whether the watch loop is active. Must be called with mu held.
+func (w *StatsWatcher) running() bool {
+	if w.done == nil {
+		return false
+	}
+	select {
+	case <-w.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// Stats returns the most recent statistics, or nil before the first
+// successful poll.
+func (w *StatsWatcher) Stats() *ChannelStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.last == nil {
+		return nil
+	}
+	stats := *w.last
+	return &stats
+}
+
+// watchLoop polls until ctx is cancelled.
+func (w *StatsWatcher) watchLoop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches statistics once and dispatches changes.
+func (w *StatsWatcher) poll(ctx context.Context) {
+	stats, err := w.fetch(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			w.dispatchError(err)
+		}
+		return
+	}
+
+	w.mu.Lock()
+	prev := w.last
+	w.last = stats
+	w.mu.Unlock()
+
+	if prev == nil {
+		return
+	}
+	if !stats.SubscriberCountHidden && !prev.SubscriberCountHidden {
+		w.dispatchDelta(&w.subscriberHandlers, stats.SubscriberCount-prev.SubscriberCount)
+	}
+	w.dispatchDelta(&w.viewHandlers, stats.ViewCount-prev.ViewCount)
+	w.dispatchDelta(&w.videoCountHandlers, stats.VideoCount-prev.VideoCount)
+}
+
+// fetch retrieves and parses the channel's statistics.
+func (w *StatsWatcher) fetch(ctx context.Context) (*ChannelStats, error) {
+	channel, err := GetChannel(ctx, w.client, w.channelID, "statistics")
+	if err != nil {
+		return nil, err
+	}
+	if channel.Statistics == nil {
+		return nil, fmt.Errorf("channel %s returned no statistics", w.channelID)
+	}
+
+	s := channel.Statistics
+	stats := &ChannelStats{
+		SubscriberCountHidden: s.HiddenSubscriberCount,
+		FetchedAt:             time.Now(),
+	}
+	if stats.ViewCount, err = parseCount(s.ViewCount); err != nil {
+		return nil, fmt.Errorf("parsing view count: %w", err)
+	}
+	if stats.VideoCount, err = parseCount(s.VideoCount); err != nil {
+		return nil, fmt.Errorf("parsing video count: %w", err)
+	}
+	if !stats.SubscriberCountHidden {
+		if stats.SubscriberCount, err = parseCount(s.SubscriberCount); err != nil {
+			return nil, fmt.Errorf("parsing subscriber count: %w", err)
+		}
+	}
+	return stats, nil
+}
+
+// parseCount parses a statistics count, treating an empty string as 0.
+func parseCount(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// OnSubscriberChange registers a handler called with the change in
+// subscriber count. It is not called while the count is hidden.
+// Returns an unsubscribe function.
+func (w *StatsWatcher) OnSubscriberChange(fn func(delta int64)) func() {
+	return w.addDeltaHandler(&w.subscriberHandlers, fn)
+}
+
+// OnViewChange registers a handler called with the change in total views.
+// Returns an unsubscribe function.
+func (w *StatsWatcher) OnViewChange(fn func(delta int64)) func() {
+	return w.addDeltaHandler(&w.viewHandlers, fn)
+}
+
+// OnVideoCountChange registers a handler called with the change in the
+// number of public videos. Returns an unsubscribe function.
+func (w *StatsWatcher) OnVideoCountChange(fn func(delta int64)) func() {
+	return w.addDeltaHandler(&w.videoCountHandlers, fn)
+}
+
+// OnError registers a handler for polling errors and handler panics.
+// Polling continues after errors. Returns an unsubscribe function.
+func (w *StatsWatcher) OnError(fn func(error)) func() {
+	w.handlerMu.Lock()
+	defer w.handlerMu.Unlock()
+
+	h := &statsErrorHandler{fn: fn}
+	w.errorHandlers = append(w.errorHandlers, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			w.handlerMu.Lock()
+			defer w.handlerMu.Unlock()
+			for i, handler := range w.errorHandlers {
+				if handler == h {
+					w.errorHandlers = slices.Delete(w.errorHandlers, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
+// addDeltaHandler appends a delta handler to list and returns its
+// unsubscribe function.
+func (w *StatsWatcher) addDeltaHandler(list *[]*statsDeltaHandler, fn func(int64)) func() {
+	w.handlerMu.Lock()
+	defer w.handlerMu.Unlock()
+
+	h := &statsDeltaHandler{fn: fn}
+	*list = append(*list, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			w.handlerMu.Lock()
+			defer w.handlerMu.Unlock()
+			for i, handler := range *list {
+				if handler == h {
+					*list = slices.Delete(*list, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
+// dispatchDelta calls the handlers in list if delta is non-zero.
+func (w *StatsWatcher) dispatchDelta(list *[]*statsDeltaHandler, delta int64) {
+	if delta == 0 {
+		return
+	}
+
+	w.handlerMu.RLock()
+	handlers := slices.Clone(*list)
+	w.handlerMu.RUnlock()
+
+	for _, h := range handlers {
+		w.safeCall(func() { h.fn(delta) })
+	}
+}
+
+// dispatchError sends an error to all error handlers.
+func (w *StatsWatcher) dispatchError(err error) {
+	w.handlerMu.RLock()
+	handlers := slices.Clone(w.errorHandlers)
+	w.handlerMu.RUnlock()
+
+	for _, h := range handlers {
+		func() {
+			defer func() { _ = recover() }() // Ignore panics in error handlers
+			h.fn(err)
+		}()
+	}
+}
+
+// safeCall executes fn and reports panics to the error handlers.
+func (w *StatsWatcher) safeCall(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			w.dispatchError(fmt.Errorf("handler panic: %v", r))
+		}
+	}()
+	fn()
+}
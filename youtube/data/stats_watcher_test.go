@@ -0,0 +1,283 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// newStatsServer serves each statistics snapshot in turn, repeating the last.
+func newStatsServer(t *testing.T, snapshots []*ChannelStatistics) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("part") != "statistics" {
+			t.Errorf("unexpected part: %s", r.URL.Query().Get("part"))
+		}
+		n := int(calls.Add(1)) - 1
+		stats := snapshots[min(n, len(snapshots)-1)]
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ChannelListResponse{
+			Items: []*Channel{{ID: "UC123", Statistics: stats}},
+		})
+	}))
+	return server, &calls
+}
+
+// waitForCalls waits until the server has received at least n requests.
+func waitForCalls(t *testing.T, calls *atomic.Int32, n int32) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for calls.Load() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("server received %d requests, want %d", calls.Load(), n)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	// Let the watcher dispatch the last response.
+	time.Sleep(20 * time.Millisecond)
+}
+
+func TestStatsWatcher_Deltas(t *testing.T) {
+	server, calls := newStatsServer(t, []*ChannelStatistics{
+		{SubscriberCount: "1000", ViewCount: "50000", VideoCount: "10"},
+		{SubscriberCount: "1100", ViewCount: "50000", VideoCount: "11"},
+		{SubscriberCount: "1050", ViewCount: "50250", VideoCount: "11"},
+	})
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	watcher := NewStatsWatcher(client, "UC123", WithInterval(10*time.Millisecond))
+
+	var mu sync.Mutex
+	var subs, views, videos []int64
+	watcher.OnSubscriberChange(func(delta int64) {
+		mu.Lock()
+		subs = append(subs, delta)
+		mu.Unlock()
+	})
+	watcher.OnViewChange(func(delta int64) {
+		mu.Lock()
+		views = append(views, delta)
+		mu.Unlock()
+	})
+	watcher.OnVideoCountChange(func(delta int64) {
+		mu.Lock()
+		videos = append(videos, delta)
+		mu.Unlock()
+	})
+
+	if err := watcher.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	waitForCalls(t, calls, 4)
+	watcher.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(subs, []int64{100, -50}) {
+		t.Errorf("subscriber deltas = %v, want [100 -50]", subs)
+	}
+	if !slices.Equal(views, []int64{250}) {
+		t.Errorf("view deltas = %v, want [250]", views)
+	}
+	if !slices.Equal(videos, []int64{1}) {
+		t.Errorf("video count deltas = %v, want [1]", videos)
+	}
+
+	stats := watcher.Stats()
+	if stats == nil || stats.SubscriberCount != 1050 || stats.ViewCount != 50250 || stats.VideoCount != 11 {
+		t.Errorf("Stats() = %+v, want latest snapshot", stats)
+	}
+}
+
+func TestStatsWatcher_HiddenSubscriberCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		snapshots  []*ChannelStatistics
+		wantHidden bool
+		wantViews  int32
+	}{
+		{
+			name: "hidden",
+			snapshots: []*ChannelStatistics{
+				{HiddenSubscriberCount: true, ViewCount: "100"},
+				{HiddenSubscriberCount: true, ViewCount: "150"},
+			},
+			wantHidden: true,
+			wantViews:  1,
+		},
+		{
+			name: "hidden to visible",
+			snapshots: []*ChannelStatistics{
+				{HiddenSubscriberCount: true, ViewCount: "100"},
+				{SubscriberCount: "500", ViewCount: "100"},
+			},
+			wantHidden: false,
+			wantViews:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newStatsServer(t, tt.snapshots)
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			watcher := NewStatsWatcher(client, "UC123", WithInterval(10*time.Millisecond))
+
+			var subChanges, viewChanges atomic.Int32
+			watcher.OnSubscriberChange(func(int64) { subChanges.Add(1) })
+			watcher.OnViewChange(func(int64) { viewChanges.Add(1) })
+
+			if err := watcher.Start(context.Background()); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			waitForCalls(t, calls, 3)
+			watcher.Stop()
+
+			if got := subChanges.Load(); got != 0 {
+				t.Errorf("subscriber change handler called %d times, want 0", got)
+			}
+			if got := viewChanges.Load(); got != tt.wantViews {
+				t.Errorf("view change handler called %d times, want %d", got, tt.wantViews)
+			}
+			if stats := watcher.Stats(); stats == nil || stats.SubscriberCountHidden != tt.wantHidden {
+				t.Errorf("Stats() = %+v, want SubscriberCountHidden = %v", stats, tt.wantHidden)
+			}
+		})
+	}
+}
+
+func TestStatsWatcher_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ChannelListResponse{})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	watcher := NewStatsWatcher(client, "UCmissing", WithInterval(time.Hour))
+
+	errCh := make(chan error, 1)
+	watcher.OnError(func(err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	})
+
+	if err := watcher.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer watcher.Stop()
+
+	select {
+	case err := <-errCh:
+		var notFound *core.NotFoundError
+		if !errors.As(err, &notFound) {
+			t.Errorf("error = %v, want *core.NotFoundError", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("OnError handler was not called")
+	}
+}
+
+func TestStatsWatcher_HandlerPanic(t *testing.T) {
+	server, calls := newStatsServer(t, []*ChannelStatistics{
+		{SubscriberCount: "1", VideoCount: "1"},
+		{SubscriberCount: "2", VideoCount: "1"},
+	})
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	watcher := NewStatsWatcher(client, "UC123", WithInterval(10*time.Millisecond))
+
+	var panics atomic.Int32
+	watcher.OnSubscriberChange(func(int64) { panic("boom") })
+	watcher.OnError(func(error) { panics.Add(1) })
+
+	_ = watcher.Start(context.Background())
+	waitForCalls(t, calls, 2)
+	watcher.Stop()
+
+	if panics.Load() != 1 {
+		t.Errorf("panics reported = %d, want 1", panics.Load())
+	}
+}
+
+func TestStatsWatcher_Lifecycle(t *testing.T) {
+	server, _ := newStatsServer(t, []*ChannelStatistics{{SubscriberCount: "1"}})
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+
+	t.Run("validation", func(t *testing.T) {
+		if err := NewStatsWatcher(client, "").Start(context.Background()); err == nil {
+			t.Error("Start() with empty channel ID expected error")
+		}
+		if err := NewStatsWatcher(nil, "UC123").Start(context.Background()); err == nil {
+			t.Error("Start() with nil client expected error")
+		}
+	})
+
+	t.Run("start stop", func(t *testing.T) {
+		watcher := NewStatsWatcher(client, "UC123", WithInterval(time.Hour))
+		if watcher.IsRunning() {
+			t.Error("IsRunning() = true before Start()")
+		}
+		if err := watcher.Start(context.Background()); err != nil {
+			t.Fatalf("Start() error = %v", err)
+		}
+		if err := watcher.Start(context.Background()); err != core.ErrAlreadyRunning {
+			t.Errorf("second Start() error = %v, want ErrAlreadyRunning", err)
+		}
+		if !watcher.IsRunning() {
+			t.Error("IsRunning() = false after Start()")
+		}
+		watcher.Stop()
+		watcher.Stop() // idempotent
+		if watcher.IsRunning() {
+			t.Error("IsRunning() = true after Stop()")
+		}
+		if err := watcher.Start(context.Background()); err != nil {
+			t.Errorf("restart error = %v", err)
+		}
+		watcher.Stop()
+	})
+
+	t.Run("context cancel", func(t *testing.T) {
+		watcher := NewStatsWatcher(client, "UC123", WithInterval(time.Hour))
+		ctx, cancel := context.WithCancel(context.Background())
+		_ = watcher.Start(ctx)
+		cancel()
+
+		deadline := time.Now().Add(time.Second)
+		for watcher.IsRunning() && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		if watcher.IsRunning() {
+			t.Error("IsRunning() = true after context cancel")
+		}
+	})
+
+	t.Run("default interval", func(t *testing.T) {
+		watcher := NewStatsWatcher(client, "UC123", WithInterval(0))
+		if watcher.interval != DefaultStatsInterval {
+			t.Errorf("interval = %v, want %v", watcher.interval, DefaultStatsInterval)
+		}
+	})
+}
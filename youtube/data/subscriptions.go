@@ -145,6 +145,7 @@ type GetSubscriptionsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -195,9 +196,7 @@ func GetSubscriptions(ctx context.Context, client *core.Client, params *GetSubsc
 	if params.Order != "" {
 		query.Set("order", params.Order)
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -0,0 +1,224 @@
+package data
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ChannelRefType identifies how a ChannelRef refers to a channel.
+type ChannelRefType string
+
+// Channel reference types.
+const (
+	// ChannelRefID is a canonical channel ID (UC...).
+	ChannelRefID ChannelRefType = "id"
+
+	// ChannelRefHandle is a channel handle, without the leading "@".
+	ChannelRefHandle ChannelRefType = "handle"
+
+	// ChannelRefCustom is a legacy custom URL name (/c/name).
+	ChannelRefCustom ChannelRefType = "custom"
+
+	// ChannelRefUsername is a legacy username (/user/name).
+	ChannelRefUsername ChannelRefType = "username"
+)
+
+// ChannelRef is a channel reference extracted from a URL.
+// Use ResolveChannelID to turn non-ID references into a channel ID.
+type ChannelRef struct {
+	// Type is the kind of reference.
+	Type ChannelRefType
+
+	// Value is the channel ID, handle, custom name, or username.
+	Value string
+}
+
+// String returns the reference in the form it appears in a YouTube URL path.
+func (r ChannelRef) String() string {
+	switch r.Type {
+	case ChannelRefHandle:
+		return "@" + r.Value
+	case ChannelRefCustom:
+		return "c/" + r.Value
+	case ChannelRefUsername:
+		return "user/" + r.Value
+	default:
+		return r.Value
+	}
+}
+
+// URLParseError is returned when a URL is not a recognized YouTube link.
+type URLParseError struct {
+	// URL is the input that could not be parsed.
+	URL string
+
+	// Reason describes what was wrong with the input.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *URLParseError) Error() string {
+	return fmt.Sprintf("unrecognized YouTube URL %q: %s", e.URL, e.Reason)
+}
+
+// ParseVideoID extracts the video ID from a YouTube video URL.
+// Supported formats include:
+//
+//	https://www.youtube.com/watch?v=ID
+//	https://youtu.be/ID
+//	https://www.youtube.com/live/ID
+//	https://www.youtube.com/shorts/ID
+//	https://www.youtube.com/embed/ID
+//
+// Mobile, music, and no-cookie hosts are accepted, the scheme is optional,
+// and a bare 11-character video ID is returned unchanged.
+// Returns a *URLParseError for unrecognized input.
+func ParseVideoID(rawURL string) (string, error) {
+	input := strings.TrimSpace(rawURL)
+	if isVideoID(input) {
+		return input, nil
+	}
+
+	u, err := parseYouTubeURL(input)
+	if err != nil {
+		return "", err
+	}
+
+	var id string
+	segments := pathSegments(u)
+	switch {
+	case strings.ToLower(u.Hostname()) == "youtu.be" && len(segments) > 0:
+		id = segments[0]
+	case len(segments) > 0 && segments[0] == "watch":
+		id = u.Query().Get("v")
+	case len(segments) > 1 && isVideoPathPrefix(segments[0]):
+		id = segments[1]
+	default:
+		return "", &URLParseError{URL: rawURL, Reason: "not a video URL"}
+	}
+
+	if !isVideoID(id) {
+		return "", &URLParseError{URL: rawURL, Reason: "invalid video ID"}
+	}
+	return id, nil
+}
+
+// ParseChannelRef extracts a channel reference from a YouTube channel URL.
+// Supported formats include:
+//
+//	https://www.youtube.com/channel/UC...
+//	https://www.youtube.com/@handle
+//	https://www.youtube.com/c/name
+//	https://www.youtube.com/user/name
+//
+// Trailing tabs such as /videos or /live are ignored, the scheme is
+// optional, and a bare channel ID or "@handle" is also accepted.
+// Returns a *URLParseError for unrecognized input.
+func ParseChannelRef(rawURL string) (ChannelRef, error) {
+	input := strings.TrimSpace(rawURL)
+	if isChannelID(input) {
+		return ChannelRef{Type: ChannelRefID, Value: input}, nil
+	}
+	if strings.HasPrefix(input, "@") && !strings.ContainsAny(input, "/?#") {
+		if len(input) == 1 {
+			return ChannelRef{}, &URLParseError{URL: rawURL, Reason: "empty handle"}
+		}
+		return ChannelRef{Type: ChannelRefHandle, Value: input[1:]}, nil
+	}
+
+	u, err := parseYouTubeURL(input)
+	if err != nil {
+		return ChannelRef{}, err
+	}
+
+	segments := pathSegments(u)
+	switch {
+	case len(segments) > 0 && len(segments[0]) > 1 && strings.HasPrefix(segments[0], "@"):
+		return ChannelRef{Type: ChannelRefHandle, Value: segments[0][1:]}, nil
+	case len(segments) > 1 && segments[0] == "channel":
+		if !isChannelID(segments[1]) {
+			return ChannelRef{}, &URLParseError{URL: rawURL, Reason: "invalid channel ID"}
+		}
+		return ChannelRef{Type: ChannelRefID, Value: segments[1]}, nil
+	case len(segments) > 1 && segments[0] == "c":
+		return ChannelRef{Type: ChannelRefCustom, Value: segments[1]}, nil
+	case len(segments) > 1 && segments[0] == "user":
+		return ChannelRef{Type: ChannelRefUsername, Value: segments[1]}, nil
+	}
+
+	return ChannelRef{}, &URLParseError{URL: rawURL, Reason: "not a channel URL"}
+}
+
+// youtubeHosts are the hostnames accepted by the URL parsers.
+var youtubeHosts = map[string]bool{
+	"youtube.com":              true,
+	"www.youtube.com":          true,
+	"m.youtube.com":            true,
+	"music.youtube.com":        true,
+	"youtu.be":                 true,
+	"youtube-nocookie.com":     true,
+	"www.youtube-nocookie.com": true,
+}
+
+// parseYouTubeURL parses input as a URL on a YouTube host, adding an https
+// scheme if none is present.
+func parseYouTubeURL(input string) (*url.URL, error) {
+	if input == "" {
+		return nil, &URLParseError{URL: input, Reason: "empty URL"}
+	}
+
+	raw := input
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, &URLParseError{URL: input, Reason: err.Error()}
+	}
+	if !youtubeHosts[strings.ToLower(u.Hostname())] {
+		return nil, &URLParseError{URL: input, Reason: "not a YouTube host"}
+	}
+	return u, nil
+}
+
+// pathSegments returns the non-empty segments of the URL path.
+func pathSegments(u *url.URL) []string {
+	var segments []string
+	for _, s := range strings.Split(u.Path, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+// isVideoPathPrefix reports whether a path segment is followed by a video ID.
+func isVideoPathPrefix(s string) bool {
+	switch s {
+	case "live", "shorts", "embed", "v", "e":
+		return true
+	}
+	return false
+}
+
+// isVideoID reports whether s looks like a video ID.
+func isVideoID(s string) bool {
+	return len(s) == 11 && isIDChars(s)
+}
+
+// isChannelID reports whether s looks like a canonical channel ID.
+func isChannelID(s string) bool {
+	return len(s) == 24 && strings.HasPrefix(s, "UC") && isIDChars(s)
+}
+
+// isIDChars reports whether s contains only URL-safe base64 characters.
+func isIDChars(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package data
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseVideoID(t *testing.T) {
+	const id = "dQw4w9WgXcQ"
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "watch", input: "https://www.youtube.com/watch?v=" + id, want: id},
+		{name: "watch with extra params", input: "https://www.youtube.com/watch?feature=share&v=" + id + "&t=42s", want: id},
+		{name: "short link", input: "https://youtu.be/" + id + "?si=abc", want: id},
+		{name: "live", input: "https://www.youtube.com/live/" + id, want: id},
+		{name: "shorts", input: "https://youtube.com/shorts/" + id, want: id},
+		{name: "embed nocookie", input: "https://www.youtube-nocookie.com/embed/" + id, want: id},
+		{name: "mobile", input: "https://m.youtube.com/watch?v=" + id, want: id},
+		{name: "no scheme", input: "youtu.be/" + id, want: id},
+		{name: "uppercase short link host", input: "https://YOUTU.BE/" + id, want: id},
+		{name: "bare ID", input: id, want: id},
+		{name: "whitespace", input: "  https://youtu.be/" + id + "\n", want: id},
+		{name: "channel URL", input: "https://www.youtube.com/@creator", wantErr: true},
+		{name: "watch without v", input: "https://www.youtube.com/watch?list=PL1", wantErr: true},
+		{name: "invalid ID", input: "https://youtu.be/short", wantErr: true},
+		{name: "other host", input: "https://example.com/watch?v=" + id, wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVideoID(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVideoID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseVideoID() = %q, want %q", got, tt.want)
+			}
+			if err != nil {
+				var parseErr *URLParseError
+				if !errors.As(err, &parseErr) {
+					t.Errorf("error = %T, want *URLParseError", err)
+				}
+			}
+		})
+	}
+}
+
+func TestParseChannelRef(t *testing.T) {
+	const id = "UCabcdefghijklmnopqrstuv"
+
+	tests := []struct {
+		name    string
+		input   string
+		want    ChannelRef
+		wantErr bool
+	}{
+		{name: "channel", input: "https://www.youtube.com/channel/" + id, want: ChannelRef{ChannelRefID, id}},
+		{name: "channel tab", input: "https://www.youtube.com/channel/" + id + "/videos", want: ChannelRef{ChannelRefID, id}},
+		{name: "handle", input: "https://www.youtube.com/@creator", want: ChannelRef{ChannelRefHandle, "creator"}},
+		{name: "handle tab", input: "youtube.com/@creator/live?si=x", want: ChannelRef{ChannelRefHandle, "creator"}},
+		{name: "custom", input: "https://www.youtube.com/c/creator", want: ChannelRef{ChannelRefCustom, "creator"}},
+		{name: "user", input: "https://www.youtube.com/user/legacy", want: ChannelRef{ChannelRefUsername, "legacy"}},
+		{name: "bare ID", input: id, want: ChannelRef{ChannelRefID, id}},
+		{name: "bare handle", input: "@creator", want: ChannelRef{ChannelRefHandle, "creator"}},
+		{name: "invalid channel ID", input: "https://www.youtube.com/channel/abc", wantErr: true},
+		{name: "video URL", input: "https://youtu.be/dQw4w9WgXcQ", wantErr: true},
+		{name: "other host", input: "https://example.com/@creator", wantErr: true},
+		{name: "empty handle", input: "@", wantErr: true},
+		{name: "empty", input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseChannelRef(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseChannelRef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseChannelRef() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChannelRef_String(t *testing.T) {
+	tests := []struct {
+		ref  ChannelRef
+		want string
+	}{
+		{ChannelRef{ChannelRefID, "UC123"}, "UC123"},
+		{ChannelRef{ChannelRefHandle, "creator"}, "@creator"},
+		{ChannelRef{ChannelRefCustom, "creator"}, "c/creator"},
+		{ChannelRef{ChannelRefUsername, "legacy"}, "user/legacy"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ref.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
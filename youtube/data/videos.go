@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -92,7 +93,8 @@ type LiveStreamingDetails struct {
 
 // VideoContentDetails contains information about the video content.
 type VideoContentDetails struct {
-	// Duration is the video's duration in ISO 8601 format.
+	// Duration is the video's duration in ISO 8601 format, e.g. "PT1H2M3S".
+	// Use Video.Duration or ParseISODuration for a time.Duration.
 	Duration string `json:"duration,omitempty"`
 
 	// Dimension indicates whether the video is 2D or 3D.
@@ -106,6 +108,33 @@ type VideoContentDetails struct {
 
 	// LicensedContent indicates if the content is licensed.
 	LicensedContent bool `json:"licensedContent,omitempty"`
+
+	// RegionRestriction lists regions where the video is viewable or blocked.
+	RegionRestriction *RegionRestriction `json:"regionRestriction,omitempty"`
+
+	// ContentRating contains ratings from rating schemes, including YouTube's own.
+	ContentRating *ContentRating `json:"contentRating,omitempty"`
+}
+
+// RegionRestriction contains the regions where a video is viewable or blocked.
+// Region codes are ISO 3166-1 alpha-2.
+type RegionRestriction struct {
+	// Allowed lists regions where the video is viewable.
+	// If set, the video is blocked everywhere else.
+	Allowed []string `json:"allowed,omitempty"`
+
+	// Blocked lists regions where the video is blocked.
+	Blocked []string `json:"blocked,omitempty"`
+}
+
+// YouTubeRatingAgeRestricted is the ytRating value for age-restricted videos.
+const YouTubeRatingAgeRestricted = "ytAgeRestricted"
+
+// ContentRating contains a video's content ratings.
+// Only YouTube's own rating is modeled; other schemes are omitted.
+type ContentRating struct {
+	// YtRating is YouTube's rating (e.g., "ytAgeRestricted").
+	YtRating string `json:"ytRating,omitempty"`
 }
 
 // VideoStatistics contains video statistics.
@@ -187,19 +216,109 @@ type GetVideosParams struct {
 	IDs []string
 
 	// Parts specifies which parts to include in the response.
-	// Common values: "snippet", "liveStreamingDetails", "contentDetails", "statistics", "status"
+	// Use the VideoPart constants or a preset such as PartsBasic.
+	// Unknown part names are rejected before the request is sent.
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
 	PageToken string
 }
 
+// Video parts accepted by videos.list and the Video fields they populate.
+// ID, Kind, and ETag are always populated.
+const (
+	// VideoPartID returns only the video ID.
+	VideoPartID = "id"
+
+	// VideoPartSnippet populates Video.Snippet (title, description,
+	// channel, thumbnails, tags, live broadcast content).
+	VideoPartSnippet = "snippet"
+
+	// VideoPartLiveStreamingDetails populates Video.LiveStreamingDetails
+	// (scheduled and actual times, concurrent viewers, active live chat ID).
+	VideoPartLiveStreamingDetails = "liveStreamingDetails"
+
+	// VideoPartContentDetails populates Video.ContentDetails (duration,
+	// definition, captions, region restrictions, content rating).
+	VideoPartContentDetails = "contentDetails"
+
+	// VideoPartStatistics populates Video.Statistics (view, like,
+	// favorite, and comment counts).
+	VideoPartStatistics = "statistics"
+
+	// VideoPartStatus populates Video.Status (upload status, privacy
+	// status, license, embeddable, made for kids).
+	VideoPartStatus = "status"
+)
+
+// knownVideoParts are the parts videos.list accepts. Parts without a
+// VideoPart constant are sent to the API but have no Video field to
+// decode into.
+var knownVideoParts = []string{
+	VideoPartID,
+	VideoPartSnippet,
+	VideoPartLiveStreamingDetails,
+	VideoPartContentDetails,
+	VideoPartStatistics,
+	VideoPartStatus,
+	"fileDetails",
+	"localizations",
+	"paidProductPlacementDetails",
+	"player",
+	"processingDetails",
+	"recordingDetails",
+	"suggestions",
+	"topicDetails",
+}
+
 // DefaultVideoParts are the default parts to request for videos.
 var DefaultVideoParts = []string{"snippet", "liveStreamingDetails"}
 
+// PartsBasic returns the parts for a video's title, description, channel,
+// and thumbnails: snippet.
+func PartsBasic() []string {
+	return []string{VideoPartSnippet}
+}
+
+// PartsLiveStreaming returns the parts for live stream status and chat:
+// snippet and liveStreamingDetails. This matches DefaultVideoParts.
+func PartsLiveStreaming() []string {
+	return []string{VideoPartSnippet, VideoPartLiveStreamingDetails}
+}
+
+// PartsStatistics returns the parts for view, like, and comment counts:
+// snippet and statistics.
+func PartsStatistics() []string {
+	return []string{VideoPartSnippet, VideoPartStatistics}
+}
+
+// PartsAll returns every part that populates a Video field: snippet,
+// liveStreamingDetails, contentDetails, statistics, and status.
+func PartsAll() []string {
+	return []string{
+		VideoPartSnippet,
+		VideoPartLiveStreamingDetails,
+		VideoPartContentDetails,
+		VideoPartStatistics,
+		VideoPartStatus,
+	}
+}
+
+// ValidateVideoParts returns an error naming the first part that videos.list
+// does not accept, e.g. a misspelling.
+func ValidateVideoParts(parts []string) error {
+	for _, part := range parts {
+		if !slices.Contains(knownVideoParts, part) {
+			return fmt.Errorf("unknown video part %q (known parts: %s)", part, strings.Join(knownVideoParts, ", "))
+		}
+	}
+	return nil
+}
+
 // GetVideos retrieves video information.
 // Quota cost: 1 unit per call.
 func GetVideos(ctx context.Context, client *core.Client, params *GetVideosParams) (*VideoListResponse, error) {
@@ -214,14 +333,15 @@ func GetVideos(ctx context.Context, client *core.Client, params *GetVideosParams
 	if len(parts) == 0 {
 		parts = DefaultVideoParts
 	}
+	if err := ValidateVideoParts(parts); err != nil {
+		return nil, err
+	}
 
 	query := url.Values{}
 	query.Set("part", strings.Join(parts, ","))
 	query.Set("id", strings.Join(params.IDs, ","))
 
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -308,3 +428,30 @@ func (v *Video) HasActiveLiveChat() bool {
 	}
 	return v.LiveStreamingDetails.ActiveLiveChatID != ""
 }
+
+// IsAgeRestricted returns true if YouTube has age-restricted the video.
+// Requires the contentDetails part.
+func (v *Video) IsAgeRestricted() bool {
+	if v.ContentDetails == nil || v.ContentDetails.ContentRating == nil {
+		return false
+	}
+	return v.ContentDetails.ContentRating.YtRating == YouTubeRatingAgeRestricted
+}
+
+// AllowedRegions returns the regions where the video is viewable.
+// Returns nil if there is no allow list (or contentDetails was not requested).
+func (v *Video) AllowedRegions() []string {
+	if v.ContentDetails == nil || v.ContentDetails.RegionRestriction == nil {
+		return nil
+	}
+	return v.ContentDetails.RegionRestriction.Allowed
+}
+
+// BlockedRegions returns the regions where the video is blocked.
+// Returns nil if there is no block list (or contentDetails was not requested).
+func (v *Video) BlockedRegions() []string {
+	if v.ContentDetails == nil || v.ContentDetails.RegionRestriction == nil {
+		return nil
+	}
+	return v.ContentDetails.RegionRestriction.Blocked
+}
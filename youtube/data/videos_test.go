@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -130,6 +132,80 @@ func TestGetVideos(t *testing.T) {
 			t.Fatalf("unexpected error: %v", err)
 		}
 	})
+
+	t.Run("unknown part", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("request should not be sent for unknown parts")
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := GetVideos(context.Background(), client, &GetVideosParams{
+			IDs:   []string{"video123"},
+			Parts: []string{"snippet", "statistic"},
+		})
+		if err == nil || !strings.Contains(err.Error(), `"statistic"`) {
+			t.Errorf("error = %v, want unknown part error naming \"statistic\"", err)
+		}
+	})
+}
+
+func TestVideoPartPresets(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		want  []string
+	}{
+		{"basic", PartsBasic(), []string{"snippet"}},
+		{"live streaming", PartsLiveStreaming(), DefaultVideoParts},
+		{"statistics", PartsStatistics(), []string{"snippet", "statistics"}},
+		{"all", PartsAll(), []string{"snippet", "liveStreamingDetails", "contentDetails", "statistics", "status"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !slices.Equal(tt.parts, tt.want) {
+				t.Errorf("parts = %v, want %v", tt.parts, tt.want)
+			}
+			if err := ValidateVideoParts(tt.parts); err != nil {
+				t.Errorf("ValidateVideoParts() error = %v", err)
+			}
+		})
+	}
+
+	t.Run("returns fresh slice", func(t *testing.T) {
+		parts := PartsBasic()
+		parts[0] = "modified"
+		if PartsBasic()[0] != VideoPartSnippet {
+			t.Error("PartsBasic() should return a new slice on each call")
+		}
+	})
+}
+
+func TestValidateVideoParts(t *testing.T) {
+	tests := []struct {
+		name    string
+		parts   []string
+		wantErr bool
+	}{
+		{"empty", nil, false},
+		{"id only", []string{"id"}, false},
+		{"known parts", []string{"snippet", "status", "contentDetails"}, false},
+		{"misspelled", []string{"snipet"}, true},
+		{"wrong case", []string{"Statistics"}, true},
+		{"parts without a Video field", []string{"player", "topicDetails", "recordingDetails", "localizations"}, false},
+		{"owner-only parts", []string{"fileDetails", "processingDetails", "suggestions"}, false},
+		{"paid product placement", []string{"paidProductPlacementDetails"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVideoParts(tt.parts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateVideoParts(%v) error = %v, wantErr %v", tt.parts, err, tt.wantErr)
+			}
+		})
+	}
 }
 
 func TestGetVideo(t *testing.T) {
@@ -328,6 +404,60 @@ func TestVideo_Methods(t *testing.T) {
 	})
 }
 
+func TestVideo_Restrictions(t *testing.T) {
+	jsonData := `{
+		"id": "video123",
+		"contentDetails": {
+			"duration": "PT5M",
+			"regionRestriction": {
+				"allowed": ["US", "CA"],
+				"blocked": ["DE"]
+			},
+			"contentRating": {
+				"ytRating": "ytAgeRestricted"
+			}
+		}
+	}`
+
+	var video Video
+	if err := json.Unmarshal([]byte(jsonData), &video); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+
+	if !video.IsAgeRestricted() {
+		t.Error("IsAgeRestricted() = false, want true")
+	}
+	if got := video.AllowedRegions(); !slices.Equal(got, []string{"US", "CA"}) {
+		t.Errorf("AllowedRegions() = %v, want [US CA]", got)
+	}
+	if got := video.BlockedRegions(); !slices.Equal(got, []string{"DE"}) {
+		t.Errorf("BlockedRegions() = %v, want [DE]", got)
+	}
+
+	tests := []struct {
+		name  string
+		video *Video
+	}{
+		{"nil content details", &Video{}},
+		{"empty content details", &Video{ContentDetails: &VideoContentDetails{}}},
+		{"no yt rating", &Video{ContentDetails: &VideoContentDetails{ContentRating: &ContentRating{}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.video.IsAgeRestricted() {
+				t.Error("IsAgeRestricted() = true, want false")
+			}
+			if got := tt.video.AllowedRegions(); got != nil {
+				t.Errorf("AllowedRegions() = %v, want nil", got)
+			}
+			if got := tt.video.BlockedRegions(); got != nil {
+				t.Errorf("BlockedRegions() = %v, want nil", got)
+			}
+		})
+	}
+}
+
 func TestVideoListResponse_JSON(t *testing.T) {
 	jsonData := `{
 		"kind": "youtube#videoListResponse",
@@ -0,0 +1,33 @@
+// Package websub provides push notifications for new YouTube uploads using
+// PubSubHubbub (WebSub), as an alternative to polling that costs no quota.
+//
+// # Subscribing
+//
+// Ask the hub to push a channel's upload feed to your callback URL:
+//
+//	sub := websub.NewSubscriber(
+//		websub.WithSecret(secret),
+//		websub.WithLeaseSeconds(864000), // 10 days
+//	)
+//	err := sub.Subscribe(ctx, "https://example.com/websub", "UC1234")
+//
+// The hub verifies the subscription asynchronously by sending a challenge to
+// the callback URL. Subscriptions expire; resubscribe before the lease ends.
+//
+// # Receiving Notifications
+//
+// Handler answers the hub's verification challenge and parses notifications
+// into data.Video values. With a secret, notifications are checked against
+// the X-Hub-Signature HMAC and unsigned or forged deliveries are dropped:
+//
+//	h := websub.NewHandler(websub.WithHandlerSecret(secret))
+//	h.OnNotification(func(n *websub.Notification) {
+//		for _, v := range n.Videos {
+//			log.Printf("New upload: %s (%s)", v.Snippet.Title, v.ID)
+//		}
+//	})
+//	http.Handle("/websub", h)
+//
+// Notifications are also sent when a video's title or description changes,
+// so track seen video IDs if only new uploads matter.
+package websub
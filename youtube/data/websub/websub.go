@@ -0,0 +1,437 @@
+package websub
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/data"
+)
+
+// Default endpoints for YouTube push notifications.
+const (
+	// DefaultHubURL is Google's PubSubHubbub hub used by YouTube.
+	DefaultHubURL = "https://pubsubhubbub.appspot.com/subscribe"
+
+	// FeedURL is the base URL of YouTube channel upload feeds.
+	FeedURL = "https://www.youtube.com/xml/feeds/videos.xml"
+
+	// maxNotificationSize limits notification bodies to prevent memory exhaustion.
+	maxNotificationSize = 1024 * 1024
+)
+
+// Hub modes.
+const (
+	ModeSubscribe   = "subscribe"
+	ModeUnsubscribe = "unsubscribe"
+)
+
+// TopicURL returns the feed topic URL for a channel's uploads.
+func TopicURL(channelID string) string {
+	return FeedURL + "?channel_id=" + url.QueryEscape(channelID)
+}
+
+// Subscriber sends subscription requests to a WebSub hub.
+type Subscriber struct {
+	httpClient   *http.Client
+	hubURL       string
+	secret       string
+	leaseSeconds int
+}
+
+// SubscriberOption configures a Subscriber.
+type SubscriberOption func(*Subscriber)
+
+// NewSubscriber creates a new WebSub subscriber.
+func NewSubscriber(opts ...SubscriberOption) *Subscriber {
+	s := &Subscriber{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		hubURL:     DefaultHubURL,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WithHTTPClient sets a custom HTTP client.
+func WithHTTPClient(hc *http.Client) SubscriberOption {
+	return func(s *Subscriber) { s.httpClient = hc }
+}
+
+// WithHubURL sets a custom hub URL (useful for testing).
+func WithHubURL(url string) SubscriberOption {
+	return func(s *Subscriber) { s.hubURL = url }
+}
+
+// WithSecret sets the secret the hub uses to sign notifications.
+// Use the same secret with WithHandlerSecret to verify them.
+func WithSecret(secret string) SubscriberOption {
+	return func(s *Subscriber) { s.secret = secret }
+}
+
+// WithLeaseSeconds requests a subscription lease duration.
+// The hub may choose a different value; resubscribe before it expires.
+func WithLeaseSeconds(seconds int) SubscriberOption {
+	return func(s *Subscriber) { s.leaseSeconds = seconds }
+}
+
+// Subscribe asks the hub to push upload notifications for a channel to
+// callbackURL. The hub confirms asynchronously by sending a verification
+// challenge to the callback, which Handler answers.
+// This does not consume YouTube Data API quota.
+func (s *Subscriber) Subscribe(ctx context.Context, callbackURL, channelID string) error {
+	return s.request(ctx, ModeSubscribe, callbackURL, channelID)
+}
+
+// Unsubscribe asks the hub to stop pushing notifications for a channel.
+func (s *Subscriber) Unsubscribe(ctx context.Context, callbackURL, channelID string) error {
+	return s.request(ctx, ModeUnsubscribe, callbackURL, channelID)
+}
+
+// request sends a subscription change request to the hub.
+func (s *Subscriber) request(ctx context.Context, mode, callbackURL, channelID string) error {
+	if callbackURL == "" {
+		return fmt.Errorf("callback URL cannot be empty")
+	}
+	if channelID == "" {
+		return fmt.Errorf("channel ID cannot be empty")
+	}
+
+	form := url.Values{
+		"hub.callback": {callbackURL},
+		"hub.topic":    {TopicURL(channelID)},
+		"hub.mode":     {mode},
+		"hub.verify":   {"async"},
+	}
+	if s.secret != "" {
+		form.Set("hub.secret", s.secret)
+	}
+	if s.leaseSeconds > 0 {
+		form.Set("hub.lease_seconds", strconv.Itoa(s.leaseSeconds))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.hubURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("executing request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return &HubError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(body))}
+	}
+	return nil
+}
+
+// HubError represents a rejected hub request.
+type HubError struct {
+	StatusCode int
+	Message    string
+}
+
+// Error implements the error interface.
+func (e *HubError) Error() string {
+	return fmt.Sprintf("websub hub error (status %d): %s", e.StatusCode, e.Message)
+}
+
+// Notification is a parsed push notification.
+type Notification struct {
+	// Videos are the new or updated videos in the feed. Only ID and
+	// Snippet (Title, ChannelID, ChannelTitle, PublishedAt) are populated.
+	Videos []*data.Video
+
+	// Deleted lists videos that were removed.
+	Deleted []*DeletedEntry
+}
+
+// DeletedEntry identifies a deleted video.
+type DeletedEntry struct {
+	// VideoID is the deleted video's ID.
+	VideoID string
+
+	// DeletedAt is when the video was deleted.
+	DeletedAt time.Time
+}
+
+// Atom feed structure used by YouTube notifications.
+type atomFeed struct {
+	Entries []atomEntry   `xml:"http://www.w3.org/2005/Atom entry"`
+	Deleted []atomDeleted `xml:"http://purl.org/atompub/tombstones/1.0 deleted-entry"`
+}
+
+type atomEntry struct {
+	VideoID   string    `xml:"http://www.youtube.com/xml/schemas/2015 videoId"`
+	ChannelID string    `xml:"http://www.youtube.com/xml/schemas/2015 channelId"`
+	Title     string    `xml:"http://www.w3.org/2005/Atom title"`
+	Author    string    `xml:"http://www.w3.org/2005/Atom author>name"`
+	Published time.Time `xml:"http://www.w3.org/2005/Atom published"`
+}
+
+type atomDeleted struct {
+	Ref  string    `xml:"ref,attr"`
+	When time.Time `xml:"when,attr"`
+}
+
+// ParseNotification parses a YouTube Atom notification feed.
+func ParseNotification(r io.Reader) (*Notification, error) {
+	var feed atomFeed
+	if err := xml.NewDecoder(r).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("parsing feed: %w", err)
+	}
+
+	n := &Notification{}
+	for _, e := range feed.Entries {
+		n.Videos = append(n.Videos, &data.Video{
+			Kind: "youtube#video",
+			ID:   e.VideoID,
+			Snippet: &data.VideoSnippet{
+				PublishedAt:  e.Published,
+				ChannelID:    e.ChannelID,
+				Title:        e.Title,
+				ChannelTitle: e.Author,
+			},
+		})
+	}
+	for _, d := range feed.Deleted {
+		n.Deleted = append(n.Deleted, &DeletedEntry{
+			VideoID:   strings.TrimPrefix(d.Ref, "yt:video:"),
+			DeletedAt: d.When,
+		})
+	}
+	return n, nil
+}
+
+// VerifySignature checks an X-Hub-Signature header ("sha1=<hex>", or
+// sha256/sha384/sha512) against the HMAC of body using secret.
+func VerifySignature(body []byte, signature, secret string) bool {
+	method, sig, ok := strings.Cut(signature, "=")
+	if !ok {
+		return false
+	}
+
+	var h func() hash.Hash
+	switch method {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	case "sha384":
+		h = sha512.New384
+	case "sha512":
+		h = sha512.New
+	default:
+		return false
+	}
+
+	want, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), want)
+}
+
+// Handler wrapper types for pointer identity.
+type (
+	notificationHandler struct{ fn func(*Notification) }
+	errorHandler        struct{ fn func(error) }
+)
+
+// Handler is an http.Handler for the subscription callback URL. It answers
+// hub verification challenges (GET) and parses notifications (POST).
+type Handler struct {
+	secret      string
+	verifyTopic func(mode, topic string) bool
+
+	handlerMu            sync.RWMutex
+	notificationHandlers []*notificationHandler
+	errorHandlers        []*errorHandler
+}
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*Handler)
+
+// NewHandler creates a new callback handler.
+func NewHandler(opts ...HandlerOption) *Handler {
+	h := &Handler{}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// WithHandlerSecret sets the secret used to verify notification signatures.
+// When set, notifications without a valid X-Hub-Signature are dropped.
+func WithHandlerSecret(secret string) HandlerOption {
+	return func(h *Handler) { h.secret = secret }
+}
+
+// WithTopicVerifier sets a function that decides whether to confirm a hub
+// verification request. By default all challenges are confirmed.
+func WithTopicVerifier(fn func(mode, topic string) bool) HandlerOption {
+	return func(h *Handler) { h.verifyTopic = fn }
+}
+
+// OnNotification registers a handler for parsed notifications.
+// Returns an unsubscribe function.
+func (h *Handler) OnNotification(fn func(*Notification)) func() {
+	h.handlerMu.Lock()
+	defer h.handlerMu.Unlock()
+
+	nh := &notificationHandler{fn: fn}
+	h.notificationHandlers = append(h.notificationHandlers, nh)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			h.handlerMu.Lock()
+			defer h.handlerMu.Unlock()
+			if i := slices.Index(h.notificationHandlers, nh); i >= 0 {
+				h.notificationHandlers = slices.Delete(h.notificationHandlers, i, i+1)
+			}
+		})
+	}
+}
+
+// OnError registers a handler for rejected or malformed notifications.
+// Returns an unsubscribe function.
+func (h *Handler) OnError(fn func(error)) func() {
+	h.handlerMu.Lock()
+	defer h.handlerMu.Unlock()
+
+	eh := &errorHandler{fn: fn}
+	h.errorHandlers = append(h.errorHandlers, eh)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			h.handlerMu.Lock()
+			defer h.handlerMu.Unlock()
+			if i := slices.Index(h.errorHandlers, eh); i >= 0 {
+				h.errorHandlers = slices.Delete(h.errorHandlers, i, i+1)
+			}
+		})
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.serveChallenge(w, r)
+	case http.MethodPost:
+		h.serveNotification(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveChallenge answers a hub verification request by echoing hub.challenge.
+func (h *Handler) serveChallenge(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	mode := q.Get("hub.mode")
+	topic := q.Get("hub.topic")
+	challenge := q.Get("hub.challenge")
+
+	if challenge == "" || (mode != ModeSubscribe && mode != ModeUnsubscribe) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if h.verifyTopic != nil && !h.verifyTopic(mode, topic) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, challenge)
+}
+
+// serveNotification verifies and parses a pushed notification.
+// Per the WebSub spec, the hub always gets a 2xx response, even when the
+// signature is invalid, so it does not retry forged deliveries.
+func (h *Handler) serveNotification(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxNotificationSize+1))
+	if err != nil {
+		h.dispatchError(fmt.Errorf("reading notification: %w", err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxNotificationSize {
+		h.dispatchError(fmt.Errorf("notification exceeds maximum size of %d bytes", maxNotificationSize))
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	if h.secret != "" && !VerifySignature(body, r.Header.Get("X-Hub-Signature"), h.secret) {
+		h.dispatchError(fmt.Errorf("invalid notification signature"))
+		return
+	}
+
+	n, err := ParseNotification(bytes.NewReader(body))
+	if err != nil {
+		h.dispatchError(err)
+		return
+	}
+	h.dispatchNotification(n)
+}
+
+func (h *Handler) dispatchNotification(n *Notification) {
+	h.handlerMu.RLock()
+	handlers := make([]*notificationHandler, len(h.notificationHandlers))
+	copy(handlers, h.notificationHandlers)
+	h.handlerMu.RUnlock()
+
+	for _, nh := range handlers {
+		h.safeCall(func() { nh.fn(n) })
+	}
+}
+
+func (h *Handler) dispatchError(err error) {
+	h.handlerMu.RLock()
+	handlers := make([]*errorHandler, len(h.errorHandlers))
+	copy(handlers, h.errorHandlers)
+	h.handlerMu.RUnlock()
+
+	for _, eh := range handlers {
+		func() {
+			defer func() { _ = recover() }()
+			eh.fn(err)
+		}()
+	}
+}
+
+// safeCall calls fn, dispatching any panic as an error.
+func (h *Handler) safeCall(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			h.dispatchError(fmt.Errorf("handler panic: %v", r))
+		}
+	}()
+	fn()
+}
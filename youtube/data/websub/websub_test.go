@@ -0,0 +1,336 @@
+package websub
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testFeed = `<?xml version='1.0' encoding='UTF-8'?>
+<feed xmlns:yt="http://www.youtube.com/xml/schemas/2015" xmlns="http://www.w3.org/2005/Atom">
+  <link rel="hub" href="https://pubsubhubbub.appspot.com"/>
+  <title>YouTube video feed</title>
+  <updated>2025-03-09T19:05:24.552394234+00:00</updated>
+  <entry>
+    <id>yt:video:video123</id>
+    <yt:videoId>video123</yt:videoId>
+    <yt:channelId>UC1234</yt:channelId>
+    <title>New Video</title>
+    <link rel="alternate" href="https://www.youtube.com/watch?v=video123"/>
+    <author>
+      <name>Test Channel</name>
+      <uri>https://www.youtube.com/channel/UC1234</uri>
+    </author>
+    <published>2025-03-06T21:40:57+00:00</published>
+    <updated>2025-03-09T19:05:24.552394234+00:00</updated>
+  </entry>
+</feed>`
+
+const testDeletedFeed = `<?xml version='1.0' encoding='UTF-8'?>
+<feed xmlns:at="http://purl.org/atompub/tombstones/1.0" xmlns="http://www.w3.org/2005/Atom">
+  <at:deleted-entry ref="yt:video:video456" when="2025-03-10T12:00:00+00:00">
+    <link href="https://www.youtube.com/watch?v=video456"/>
+  </at:deleted-entry>
+</feed>`
+
+func sign(body, secret string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestTopicURL(t *testing.T) {
+	want := "https://www.youtube.com/xml/feeds/videos.xml?channel_id=UC1234"
+	if got := TopicURL("UC1234"); got != want {
+		t.Errorf("TopicURL() = %q, want %q", got, want)
+	}
+}
+
+func TestSubscriber_Subscribe(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			_ = r.ParseForm()
+			want := map[string]string{
+				"hub.callback":      "https://example.com/cb",
+				"hub.topic":         TopicURL("UC1234"),
+				"hub.mode":          ModeSubscribe,
+				"hub.verify":        "async",
+				"hub.secret":        "s3cret",
+				"hub.lease_seconds": "3600",
+			}
+			for k, v := range want {
+				if got := r.PostForm.Get(k); got != v {
+					t.Errorf("%s = %q, want %q", k, got, v)
+				}
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		sub := NewSubscriber(WithHubURL(server.URL), WithSecret("s3cret"), WithLeaseSeconds(3600))
+		if err := sub.Subscribe(context.Background(), "https://example.com/cb", "UC1234"); err != nil {
+			t.Fatalf("Subscribe() error = %v", err)
+		}
+	})
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.ParseForm()
+			if r.PostForm.Get("hub.mode") != ModeUnsubscribe {
+				t.Errorf("hub.mode = %q, want unsubscribe", r.PostForm.Get("hub.mode"))
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		sub := NewSubscriber(WithHubURL(server.URL))
+		if err := sub.Unsubscribe(context.Background(), "https://example.com/cb", "UC1234"); err != nil {
+			t.Fatalf("Unsubscribe() error = %v", err)
+		}
+	})
+
+	t.Run("hub error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("Invalid value for hub.callback"))
+		}))
+		defer server.Close()
+
+		sub := NewSubscriber(WithHubURL(server.URL))
+		err := sub.Subscribe(context.Background(), "bad", "UC1234")
+
+		var hubErr *HubError
+		if !errors.As(err, &hubErr) {
+			t.Fatalf("error = %v, want *HubError", err)
+		}
+		if hubErr.StatusCode != http.StatusBadRequest {
+			t.Errorf("StatusCode = %d, want 400", hubErr.StatusCode)
+		}
+		if hubErr.Message != "Invalid value for hub.callback" {
+			t.Errorf("Message = %q", hubErr.Message)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		sub := NewSubscriber()
+		if err := sub.Subscribe(context.Background(), "", "UC1234"); err == nil {
+			t.Error("expected error for empty callback URL")
+		}
+		if err := sub.Subscribe(context.Background(), "https://example.com/cb", ""); err == nil {
+			t.Error("expected error for empty channel ID")
+		}
+	})
+}
+
+func TestParseNotification(t *testing.T) {
+	t.Run("new video", func(t *testing.T) {
+		n, err := ParseNotification(strings.NewReader(testFeed))
+		if err != nil {
+			t.Fatalf("ParseNotification() error = %v", err)
+		}
+		if len(n.Videos) != 1 {
+			t.Fatalf("len(Videos) = %d, want 1", len(n.Videos))
+		}
+		v := n.Videos[0]
+		if v.ID != "video123" {
+			t.Errorf("ID = %q, want video123", v.ID)
+		}
+		if v.Snippet.ChannelID != "UC1234" {
+			t.Errorf("ChannelID = %q, want UC1234", v.Snippet.ChannelID)
+		}
+		if v.Snippet.Title != "New Video" {
+			t.Errorf("Title = %q, want 'New Video'", v.Snippet.Title)
+		}
+		if v.Snippet.ChannelTitle != "Test Channel" {
+			t.Errorf("ChannelTitle = %q, want 'Test Channel'", v.Snippet.ChannelTitle)
+		}
+		want := time.Date(2025, 3, 6, 21, 40, 57, 0, time.UTC)
+		if !v.Snippet.PublishedAt.Equal(want) {
+			t.Errorf("PublishedAt = %v, want %v", v.Snippet.PublishedAt, want)
+		}
+	})
+
+	t.Run("deleted video", func(t *testing.T) {
+		n, err := ParseNotification(strings.NewReader(testDeletedFeed))
+		if err != nil {
+			t.Fatalf("ParseNotification() error = %v", err)
+		}
+		if len(n.Videos) != 0 {
+			t.Errorf("len(Videos) = %d, want 0", len(n.Videos))
+		}
+		if len(n.Deleted) != 1 || n.Deleted[0].VideoID != "video456" {
+			t.Fatalf("Deleted = %+v, want video456", n.Deleted)
+		}
+	})
+
+	t.Run("invalid xml", func(t *testing.T) {
+		if _, err := ParseNotification(strings.NewReader("not xml")); err == nil {
+			t.Error("expected error for invalid XML")
+		}
+	})
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte("payload")
+	tests := []struct {
+		name      string
+		signature string
+		want      bool
+	}{
+		{"valid sha1", sign("payload", "secret"), true},
+		{"wrong secret", sign("payload", "other"), false},
+		{"wrong body", sign("tampered", "secret"), false},
+		{"unknown method", "md5=abcd", false},
+		{"no method", "abcd", false},
+		{"bad hex", "sha1=zz", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(body, tt.signature, "secret"); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_Challenge(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		verifier func(mode, topic string) bool
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "subscribe",
+			query:    "hub.mode=subscribe&hub.topic=topic&hub.challenge=abc123",
+			wantCode: http.StatusOK,
+			wantBody: "abc123",
+		},
+		{
+			name:     "missing challenge",
+			query:    "hub.mode=subscribe&hub.topic=topic",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "unknown mode",
+			query:    "hub.mode=denied&hub.topic=topic&hub.challenge=abc",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "rejected by verifier",
+			query:    "hub.mode=subscribe&hub.topic=other&hub.challenge=abc",
+			verifier: func(mode, topic string) bool { return topic == "topic" },
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHandler(WithTopicVerifier(tt.verifier))
+			if tt.verifier == nil {
+				h = NewHandler()
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cb?"+tt.query, nil))
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHandler_Notification(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     string
+		signature  string
+		body       string
+		wantNotify bool
+		wantErr    bool
+	}{
+		{"unsigned without secret", "", "", testFeed, true, false},
+		{"valid signature", "secret", sign(testFeed, "secret"), testFeed, true, false},
+		{"invalid signature", "secret", sign(testFeed, "other"), testFeed, false, true},
+		{"missing signature", "secret", "", testFeed, false, true},
+		{"malformed feed", "", "", "not xml", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHandler(WithHandlerSecret(tt.secret))
+
+			var notified, errored atomic.Bool
+			h.OnNotification(func(n *Notification) { notified.Store(true) })
+			h.OnError(func(err error) { errored.Store(true) })
+
+			req := httptest.NewRequest(http.MethodPost, "/cb", strings.NewReader(tt.body))
+			if tt.signature != "" {
+				req.Header.Set("X-Hub-Signature", tt.signature)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusNoContent {
+				t.Errorf("status = %d, want 204", rec.Code)
+			}
+			if notified.Load() != tt.wantNotify {
+				t.Errorf("notified = %v, want %v", notified.Load(), tt.wantNotify)
+			}
+			if errored.Load() != tt.wantErr {
+				t.Errorf("errored = %v, want %v", errored.Load(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandler_Unsubscribe(t *testing.T) {
+	h := NewHandler()
+
+	var count atomic.Int32
+	unsub := h.OnNotification(func(n *Notification) { count.Add(1) })
+	h.OnNotification(func(n *Notification) { panic("boom") })
+
+	var panicErr atomic.Bool
+	h.OnError(func(err error) { panicErr.Store(true) })
+
+	post := func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/cb", strings.NewReader(testFeed)))
+	}
+
+	post()
+	unsub()
+	unsub() // idempotent
+	post()
+
+	if count.Load() != 1 {
+		t.Errorf("count = %d, want 1", count.Load())
+	}
+	if !panicErr.Load() {
+		t.Error("handler panic should be dispatched as error")
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/cb", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("PUT status = %d, want 405", rec.Code)
+	}
+}
@@ -0,0 +1,173 @@
+package streaming
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// AckError is passed to OnError handlers, and sent as a ChatEventError, when
+// an OnMessageAck handler fails. The batch's page token is not committed, so
+// the batch is delivered again on the next poll.
+type AckError struct {
+	// MessageID is the ID of the message that was not acknowledged.
+	MessageID string
+
+	// Err is the error returned by the handler, or the recovered panic.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *AckError) Error() string {
+	return fmt.Sprintf("message %s not acknowledged: %v", e.MessageID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *AckError) Unwrap() error {
+	return e.Err
+}
+
+// OnMessageAck registers a handler for chat messages that acknowledges each
+// message by returning nil, for at-least-once processing such as writing
+// chat to a database:
+//
+//	poller.SetPageToken(loadCheckpoint())
+//	poller.OnMessageAck(func(msg *streaming.LiveChatMessage) error {
+//		return db.UpsertMessage(ctx, msg) // keyed by msg.ID
+//	})
+//	poller.OnCheckpoint(func(pageToken string) {
+//		saveCheckpoint(pageToken)
+//	})
+//
+// Registering an OnMessageAck handler opts the poller in to acknowledgment:
+// the page token of a poll is only committed, and passed to OnCheckpoint,
+// once every OnMessageAck handler has returned nil for every message in the
+// batch. When a handler returns an error or panics, the rest of the batch is
+// skipped, an *AckError is reported to OnError, and the next poll requests
+// the same page again.
+//
+// The trade-off is duplicates. A redelivered batch includes the messages
+// acknowledged before the failure, and every other handler (OnMessage,
+// OnDelete, Events, ...) sees the whole batch again. After a restart,
+// everything after the last saved checkpoint is delivered again. Handlers
+// should therefore be idempotent, e.g. by deduplicating on the message ID.
+// A deduplication window of recent IDs must cover at least one full batch,
+// and an in-memory window does not survive a restart, so a unique key in
+// the database is the simplest guarantee. A message that can never be
+// processed blocks the chat at its batch; return nil for messages that
+// should be skipped.
+//
+// Deletion and ban events are not passed to OnMessageAck handlers.
+// Returns an unsubscribe function that is safe to call multiple times.
+func (p *LiveChatPoller) OnMessageAck(fn func(*LiveChatMessage) error) func() {
+	p.handlerMu.Lock()
+	defer p.handlerMu.Unlock()
+
+	h := &ackHandler{fn: fn}
+	p.ackHandlers = append(p.ackHandlers, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.handlerMu.Lock()
+			defer p.handlerMu.Unlock()
+			for i, handler := range p.ackHandlers {
+				if handler == h {
+					p.ackHandlers = slices.Delete(p.ackHandlers, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
+// OnCheckpoint registers a handler called with the page token after each
+// poll whose messages have been handled, and acknowledged if any
+// OnMessageAck handlers are registered. Persist the token and pass it to
+// SetPageToken when the poller restarts to resume after the last handled
+// batch.
+// Returns an unsubscribe function that is safe to call multiple times.
+func (p *LiveChatPoller) OnCheckpoint(fn func(pageToken string)) func() {
+	p.handlerMu.Lock()
+	defer p.handlerMu.Unlock()
+
+	h := &checkpointHandler{fn: fn}
+	p.checkpointHandlers = append(p.checkpointHandlers, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.handlerMu.Lock()
+			defer p.handlerMu.Unlock()
+			for i, handler := range p.checkpointHandlers {
+				if handler == h {
+					p.checkpointHandlers = slices.Delete(p.checkpointHandlers, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
+// snapshotAckHandlers returns a copy of the registered OnMessageAck
+// handlers. The poll loop takes one snapshot per iteration so that holding
+// back the page token and acknowledging the batch see the same handlers.
+func (p *LiveChatPoller) snapshotAckHandlers() []*ackHandler {
+	p.handlerMu.RLock()
+	defer p.handlerMu.RUnlock()
+	handlers := make([]*ackHandler, len(p.ackHandlers))
+	copy(handlers, p.ackHandlers)
+	return handlers
+}
+
+// dispatchAcks passes chat messages to the given OnMessageAck handlers,
+// stopping at the first failure.
+func dispatchAcks(handlers []*ackHandler, messages []*LiveChatMessage) *AckError {
+	if len(handlers) == 0 {
+		return nil
+	}
+	for _, msg := range messages {
+		switch {
+		case msg.Type() == MessageTypeMessageDeleted && msg.Snippet != nil && msg.Snippet.MessageDeletedDetails != nil,
+			msg.Type() == MessageTypeUserBanned && msg.Snippet != nil && msg.Snippet.UserBannedDetails != nil:
+			continue
+		}
+		for _, h := range handlers {
+			if err := callAck(h, msg); err != nil {
+				return &AckError{MessageID: msg.ID, Err: err}
+			}
+		}
+	}
+	return nil
+}
+
+// callAck calls an OnMessageAck handler, reporting a panic as an error.
+func callAck(h *ackHandler, msg *LiveChatMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panic: %v", r)
+		}
+	}()
+	return h.fn(msg)
+}
+
+// commitCheckpoint commits a page token held back for acknowledgment and
+// passes the current page token to the OnCheckpoint handlers.
+func (p *LiveChatPoller) commitCheckpoint() {
+	p.mu.Lock()
+	if p.hasPendingToken {
+		p.pageToken = p.pendingPageToken
+		p.hasPendingToken = false
+	}
+	token := p.pageToken
+	p.mu.Unlock()
+
+	p.handlerMu.RLock()
+	handlers := make([]*checkpointHandler, len(p.checkpointHandlers))
+	copy(handlers, p.checkpointHandlers)
+	p.handlerMu.RUnlock()
+
+	for _, h := range handlers {
+		p.safeCall(func() { h.fn(token) })
+	}
+}
@@ -0,0 +1,261 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// newAckTestServer serves a chat whose pages are keyed by page token and
+// records the page token of each request.
+func newAckTestServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+	pages := map[string]LiveChatMessageListResponse{
+		"": {
+			NextPageToken: "p1",
+			Items: []*LiveChatMessage{
+				{ID: "m1", Snippet: &MessageSnippet{Type: MessageTypeText}},
+				{ID: "del", Snippet: &MessageSnippet{
+					Type:                  MessageTypeMessageDeleted,
+					MessageDeletedDetails: &MessageDeletedDetails{DeletedMessageID: "m0"},
+				}},
+				{ID: "m2", Snippet: &MessageSnippet{Type: MessageTypeText}},
+			},
+		},
+		"p1": {NextPageToken: "p2", Items: []*LiveChatMessage{{ID: "m3", Snippet: &MessageSnippet{Type: MessageTypeText}}}},
+		"p2": {NextPageToken: "p2"},
+	}
+
+	var (
+		mu     sync.Mutex
+		tokens []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("pageToken")
+		mu.Lock()
+		tokens = append(tokens, token)
+		mu.Unlock()
+		_ = json.NewEncoder(w).Encode(pages[token])
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(tokens)
+	}
+}
+
+// waitForCheckpoint waits until the poller commits the given page token.
+func waitForCheckpoint(t *testing.T, poller *LiveChatPoller, token string) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for poller.PageToken() != token {
+		if time.Now().After(deadline) {
+			t.Fatalf("page token = %q, want %q", poller.PageToken(), token)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestLiveChatPoller_OnMessageAck(t *testing.T) {
+	server, requestedTokens := newAckTestServer(t)
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123", WithMinPollInterval(time.Millisecond))
+
+	var (
+		mu          sync.Mutex
+		acked       []string
+		seen        []string
+		checkpoints []string
+		ackErrs     []*AckError
+	)
+	failed := false
+	poller.OnMessageAck(func(msg *LiveChatMessage) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if msg.ID == "m2" && !failed {
+			failed = true
+			return errors.New("db unavailable")
+		}
+		acked = append(acked, msg.ID)
+		return nil
+	})
+	poller.OnMessage(func(msg *LiveChatMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, msg.ID)
+	})
+	poller.OnCheckpoint(func(token string) {
+		mu.Lock()
+		defer mu.Unlock()
+		checkpoints = append(checkpoints, token)
+	})
+	poller.OnError(func(err error) {
+		var ackErr *AckError
+		if errors.As(err, &ackErr) {
+			mu.Lock()
+			defer mu.Unlock()
+			ackErrs = append(ackErrs, ackErr)
+		}
+	})
+
+	if err := poller.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	waitForCheckpoint(t, poller, "p2")
+	poller.Stop()
+
+	if got := requestedTokens()[:3]; !slices.Equal(got, []string{"", "", "p1"}) {
+		t.Errorf("requested page tokens = %v, want first page again after the failure", got)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(ackErrs) != 1 || ackErrs[0].MessageID != "m2" || ackErrs[0].Err.Error() != "db unavailable" {
+		t.Errorf("AckErrors = %v, want one for m2", ackErrs)
+	}
+	if want := []string{"m1", "m1", "m2", "m3"}; !slices.Equal(acked, want) {
+		t.Errorf("acknowledged = %v, want %v", acked, want)
+	}
+	if want := []string{"m1", "m2", "m1", "m2", "m3"}; !slices.Equal(seen, want) {
+		t.Errorf("OnMessage saw %v, want %v (batch redelivered)", seen, want)
+	}
+	if len(checkpoints) < 2 || checkpoints[0] != "p1" || checkpoints[1] != "p2" {
+		t.Errorf("checkpoints = %v, want [p1 p2 ...]", checkpoints)
+	}
+}
+
+func TestLiveChatPoller_OnMessageAck_Panic(t *testing.T) {
+	server, _ := newAckTestServer(t)
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123", WithMinPollInterval(time.Millisecond))
+
+	var calls int
+	poller.OnMessageAck(func(*LiveChatMessage) error {
+		calls++
+		panic("boom")
+	})
+
+	acks := poller.snapshotAckHandlers()
+	messages, _, err := poller.poll(context.Background(), len(acks) > 0)
+	if err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	ackErr := dispatchAcks(acks, messages)
+	if ackErr == nil || ackErr.MessageID != "m1" || ackErr.Err.Error() != "handler panic: boom" {
+		t.Fatalf("dispatchAcks() = %v, want panic reported for m1", ackErr)
+	}
+	if calls != 1 {
+		t.Errorf("handler called %d times, want 1 (rest of batch skipped)", calls)
+	}
+	if poller.PageToken() != "" {
+		t.Errorf("page token = %q, want it held back", poller.PageToken())
+	}
+}
+
+func TestLiveChatPoller_OnCheckpoint_WithoutAck(t *testing.T) {
+	server, _ := newAckTestServer(t)
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123", WithMinPollInterval(time.Millisecond))
+
+	var (
+		mu          sync.Mutex
+		checkpoints []string
+	)
+	poller.OnCheckpoint(func(token string) {
+		mu.Lock()
+		defer mu.Unlock()
+		checkpoints = append(checkpoints, token)
+	})
+
+	if err := poller.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	waitForCheckpoint(t, poller, "p2")
+	poller.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(checkpoints) < 1 || checkpoints[0] != "p1" {
+		t.Errorf("checkpoints = %v, want to start with p1", checkpoints)
+	}
+}
+
+func TestLiveChatPoller_OnMessageAck_Unsubscribe(t *testing.T) {
+	server, _ := newAckTestServer(t)
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123")
+
+	unsub := poller.OnMessageAck(func(*LiveChatMessage) error { return errors.New("fail") })
+	if _, _, err := poller.poll(context.Background(), len(poller.snapshotAckHandlers()) > 0); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	if poller.PageToken() != "" {
+		t.Errorf("page token = %q, want it held back", poller.PageToken())
+	}
+
+	unsub()
+	unsub() // idempotent
+	if len(poller.snapshotAckHandlers()) > 0 {
+		t.Fatal("ack handler still registered")
+	}
+
+	// Without ack handlers the token advances in poll, and a stale held-back
+	// token is not committed.
+	if _, _, err := poller.poll(context.Background(), false); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	poller.commitCheckpoint()
+	if poller.PageToken() != "p1" {
+		t.Errorf("page token = %q, want p1", poller.PageToken())
+	}
+}
+
+func TestAckError(t *testing.T) {
+	cause := errors.New("db unavailable")
+	err := &AckError{MessageID: "m1", Err: cause}
+	if got, want := err.Error(), "message m1 not acknowledged: db unavailable"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("AckError does not unwrap to the handler error")
+	}
+}
+
+func TestLiveChatPoller_AckSnapshot(t *testing.T) {
+	server, _ := newAckTestServer(t)
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123")
+
+	// A handler registered after the iteration's snapshot is not asked to
+	// acknowledge a batch whose token was not held back.
+	acks := poller.snapshotAckHandlers()
+	var calls int
+	poller.OnMessageAck(func(*LiveChatMessage) error {
+		calls++
+		return nil
+	})
+
+	messages, _, err := poller.poll(context.Background(), len(acks) > 0)
+	if err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	if ackErr := dispatchAcks(acks, messages); ackErr != nil {
+		t.Fatalf("dispatchAcks() = %v", ackErr)
+	}
+	if calls != 0 {
+		t.Errorf("handler called %d times, want 0 until the next iteration", calls)
+	}
+	if poller.PageToken() != "p1" {
+		t.Errorf("page token = %q, want p1", poller.PageToken())
+	}
+}
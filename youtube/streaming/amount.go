@@ -0,0 +1,115 @@
+package streaming
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Amount is a monetary amount in a single currency, as reported for Super
+// Chats and Super Stickers.
+type Amount struct {
+	// Micros is the amount in micros (1/1,000,000 of the currency unit).
+	Micros int64
+
+	// Currency is the ISO 4217 currency code.
+	Currency string
+}
+
+// currencyDecimals lists the ISO 4217 currencies whose minor unit is not
+// two decimal places.
+var currencyDecimals = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// CurrencyDecimals returns the number of decimal places conventionally
+// shown for an ISO 4217 currency code, e.g. 0 for "JPY", 2 for "USD", and
+// 3 for "KWD". Unknown codes return 2.
+func CurrencyDecimals(currency string) int {
+	if d, ok := currencyDecimals[strings.ToUpper(currency)]; ok {
+		return d
+	}
+	return 2
+}
+
+// Float returns the amount in currency units, e.g. 5.0 for 5000000 micros.
+// Use Micros for exact arithmetic such as totals.
+func (a Amount) Float() float64 {
+	return float64(a.Micros) / 1e6
+}
+
+// Decimal returns the amount in currency units as a decimal string rounded
+// to the currency's decimal places (see CurrencyDecimals), e.g. "5.00" for
+// USD and "500" for JPY. Halves are rounded away from zero.
+func (a Amount) Decimal() string {
+	decimals := CurrencyDecimals(a.Currency)
+	scale := int64(1)
+	for range 6 - decimals {
+		scale *= 10
+	}
+
+	neg := a.Micros < 0
+	micros := a.Micros
+	if neg {
+		micros = -micros
+	}
+	minor := (micros + scale/2) / scale
+
+	s := strconv.FormatInt(minor, 10)
+	if decimals > 0 {
+		if len(s) <= decimals {
+			s = strings.Repeat("0", decimals-len(s)+1) + s
+		}
+		s = s[:len(s)-decimals] + "." + s[len(s)-decimals:]
+	}
+	if neg && minor != 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// String returns the currency code followed by the decimal amount, e.g.
+// "USD 5.00" or "JPY 500". Without a currency it returns the decimal amount
+// alone.
+func (a Amount) String() string {
+	if a.Currency == "" {
+		return a.Decimal()
+	}
+	return a.Currency + " " + a.Decimal()
+}
+
+// Amount returns the donation amount.
+func (d *SuperChatDetails) Amount() Amount {
+	return Amount{Micros: d.AmountMicros, Currency: d.Currency}
+}
+
+// AmountFloat returns the donation amount in currency units, e.g. 5.0 for
+// a $5.00 Super Chat.
+func (d *SuperChatDetails) AmountFloat() float64 {
+	return d.Amount().Float()
+}
+
+// Amount returns the sticker cost.
+func (d *SuperStickerDetails) Amount() Amount {
+	return Amount{Micros: d.AmountMicros, Currency: d.Currency}
+}
+
+// Amount returns the donation amount.
+func (s *SuperChatEventResourceSnippet) Amount() Amount {
+	return Amount{Micros: s.AmountMicros, Currency: s.Currency}
+}
+
+// Value returns the donation amount. (The Amount field holds YouTube's
+// display string.)
+func (e *SuperChatEvent) Value() Amount {
+	return Amount{Micros: e.AmountMicros, Currency: e.Currency}
+}
+
+// Value returns the sticker cost. (The Amount field holds YouTube's display
+// string.)
+func (e *SuperStickerEvent) Value() Amount {
+	return Amount{Micros: e.AmountMicros, Currency: e.Currency}
+}
@@ -0,0 +1,90 @@
+package streaming
+
+import "testing"
+
+func TestCurrencyDecimals(t *testing.T) {
+	tests := []struct {
+		currency string
+		want     int
+	}{
+		{"USD", 2},
+		{"EUR", 2},
+		{"JPY", 0},
+		{"jpy", 0},
+		{"KRW", 0},
+		{"KWD", 3},
+		{"CLF", 4},
+		{"", 2},
+		{"XYZ", 2},
+	}
+	for _, tt := range tests {
+		if got := CurrencyDecimals(tt.currency); got != tt.want {
+			t.Errorf("CurrencyDecimals(%q) = %d, want %d", tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_Decimal(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Amount
+		want   string
+		str    string
+	}{
+		{"usd", Amount{Micros: 5000000, Currency: "USD"}, "5.00", "USD 5.00"},
+		{"usd cents", Amount{Micros: 1990000, Currency: "USD"}, "1.99", "USD 1.99"},
+		{"usd below one", Amount{Micros: 50000, Currency: "USD"}, "0.05", "USD 0.05"},
+		{"usd round half up", Amount{Micros: 1005000, Currency: "USD"}, "1.01", "USD 1.01"},
+		{"usd round down", Amount{Micros: 1004999, Currency: "USD"}, "1.00", "USD 1.00"},
+		{"jpy", Amount{Micros: 500000000, Currency: "JPY"}, "500", "JPY 500"},
+		{"jpy rounds", Amount{Micros: 500500000, Currency: "JPY"}, "501", "JPY 501"},
+		{"kwd", Amount{Micros: 1250000, Currency: "KWD"}, "1.250", "KWD 1.250"},
+		{"zero", Amount{Currency: "EUR"}, "0.00", "EUR 0.00"},
+		{"negative", Amount{Micros: -2500000, Currency: "USD"}, "-2.50", "USD -2.50"},
+		{"negative rounds to zero", Amount{Micros: -1000, Currency: "USD"}, "0.00", "USD 0.00"},
+		{"no currency", Amount{Micros: 3000000}, "3.00", "3.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.Decimal(); got != tt.want {
+				t.Errorf("Decimal() = %q, want %q", got, tt.want)
+			}
+			if got := tt.amount.String(); got != tt.str {
+				t.Errorf("String() = %q, want %q", got, tt.str)
+			}
+		})
+	}
+}
+
+func TestAmount_Float(t *testing.T) {
+	if got := (Amount{Micros: 5250000, Currency: "USD"}).Float(); got != 5.25 {
+		t.Errorf("Float() = %v, want 5.25", got)
+	}
+	if got := (Amount{Micros: 500000000, Currency: "JPY"}).Float(); got != 500 {
+		t.Errorf("Float() = %v, want 500", got)
+	}
+}
+
+func TestAmount_Accessors(t *testing.T) {
+	want := Amount{Micros: 1000000000, Currency: "JPY"}
+
+	sc := &SuperChatDetails{AmountMicros: 1000000000, Currency: "JPY"}
+	if got := sc.Amount(); got != want {
+		t.Errorf("SuperChatDetails.Amount() = %v, want %v", got, want)
+	}
+	if got := sc.AmountFloat(); got != 1000 {
+		t.Errorf("SuperChatDetails.AmountFloat() = %v, want 1000", got)
+	}
+	if got := (&SuperStickerDetails{AmountMicros: 1000000000, Currency: "JPY"}).Amount(); got != want {
+		t.Errorf("SuperStickerDetails.Amount() = %v, want %v", got, want)
+	}
+	if got := (&SuperChatEventResourceSnippet{AmountMicros: 1000000000, Currency: "JPY"}).Amount(); got != want {
+		t.Errorf("SuperChatEventResourceSnippet.Amount() = %v, want %v", got, want)
+	}
+	if got := (&SuperChatEvent{AmountMicros: 1000000000, Currency: "JPY"}).Value(); got != want {
+		t.Errorf("SuperChatEvent.Value() = %v, want %v", got, want)
+	}
+	if got := (&SuperStickerEvent{AmountMicros: 1000000000, Currency: "JPY"}).Value(); got != want {
+		t.Errorf("SuperStickerEvent.Value() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,85 @@
+package streaming
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// API error reasons returned by liveBroadcasts.bind for binding conflicts.
+var (
+	streamAlreadyBoundReasons = []string{"liveStreamAlreadyBound", "streamAlreadyBound"}
+	incompatibleStreamReasons = []string{"incompatibleLiveStream", "incompatibleStream", "invalidStreamResolution"}
+)
+
+// StreamAlreadyBoundError indicates that BindBroadcast failed because the
+// stream is already bound to another broadcast. Automation that rebinds
+// reusable streams may treat this as non-fatal, or unbind the other
+// broadcast first.
+type StreamAlreadyBoundError struct {
+	// BroadcastID is the broadcast the stream was being bound to.
+	BroadcastID string
+
+	// StreamID is the stream that is already bound.
+	StreamID string
+
+	// Err is the underlying API error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *StreamAlreadyBoundError) Error() string {
+	return fmt.Sprintf("stream %s is already bound to another broadcast (binding to %s): %v", e.StreamID, e.BroadcastID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *StreamAlreadyBoundError) Unwrap() error {
+	return e.Err
+}
+
+// IncompatibleStreamError indicates that BindBroadcast failed because the
+// stream's settings (e.g., resolution or frame rate) are incompatible with
+// the broadcast.
+type IncompatibleStreamError struct {
+	// BroadcastID is the broadcast the stream was being bound to.
+	BroadcastID string
+
+	// StreamID is the incompatible stream.
+	StreamID string
+
+	// Reason is the API's explanation of the incompatibility.
+	Reason string
+
+	// Err is the underlying API error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *IncompatibleStreamError) Error() string {
+	return fmt.Sprintf("stream %s is incompatible with broadcast %s: %s", e.StreamID, e.BroadcastID, e.Reason)
+}
+
+// Unwrap returns the underlying error.
+func (e *IncompatibleStreamError) Unwrap() error {
+	return e.Err
+}
+
+// bindError converts a liveBroadcasts.bind API error into a typed binding
+// error based on its reason. Other errors are returned unchanged.
+func bindError(broadcastID, streamID string, err error) error {
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch {
+	case slices.Contains(streamAlreadyBoundReasons, apiErr.Code):
+		return &StreamAlreadyBoundError{BroadcastID: broadcastID, StreamID: streamID, Err: err}
+	case slices.Contains(incompatibleStreamReasons, apiErr.Code):
+		return &IncompatibleStreamError{BroadcastID: broadcastID, StreamID: streamID, Reason: apiErr.Message, Err: err}
+	default:
+		return err
+	}
+}
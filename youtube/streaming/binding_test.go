@@ -0,0 +1,92 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestBindBroadcast_BindingErrors(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           int
+		reason           string
+		wantAlreadyBound bool
+		wantIncompatible bool
+	}{
+		{"already bound", http.StatusForbidden, "liveStreamAlreadyBound", true, false},
+		{"incompatible", http.StatusBadRequest, "incompatibleLiveStream", false, true},
+		{"other reason", http.StatusNotFound, "liveStreamNotFound", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprintf(w, `{"error":{"code":%d,"message":"Stream resolution does not match","errors":[{"reason":%q}]}}`, tt.status, tt.reason)
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			_, err := BindBroadcast(context.Background(), client, &BindBroadcastParams{
+				BroadcastID: "broadcast123",
+				StreamID:    "stream456",
+			})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			var boundErr *StreamAlreadyBoundError
+			if got := errors.As(err, &boundErr); got != tt.wantAlreadyBound {
+				t.Errorf("errors.As(StreamAlreadyBoundError) = %v, want %v (err: %v)", got, tt.wantAlreadyBound, err)
+			}
+			if boundErr != nil && (boundErr.BroadcastID != "broadcast123" || boundErr.StreamID != "stream456") {
+				t.Errorf("StreamAlreadyBoundError IDs = %q/%q", boundErr.BroadcastID, boundErr.StreamID)
+			}
+
+			var incompatibleErr *IncompatibleStreamError
+			if got := errors.As(err, &incompatibleErr); got != tt.wantIncompatible {
+				t.Errorf("errors.As(IncompatibleStreamError) = %v, want %v (err: %v)", got, tt.wantIncompatible, err)
+			}
+			if incompatibleErr != nil {
+				if incompatibleErr.StreamID != "stream456" || incompatibleErr.BroadcastID != "broadcast123" {
+					t.Errorf("IncompatibleStreamError IDs = %q/%q", incompatibleErr.BroadcastID, incompatibleErr.StreamID)
+				}
+				if incompatibleErr.Reason != "Stream resolution does not match" {
+					t.Errorf("Reason = %q", incompatibleErr.Reason)
+				}
+			}
+
+			var apiErr *core.APIError
+			if !errors.As(err, &apiErr) || apiErr.Code != tt.reason {
+				t.Errorf("underlying APIError not preserved: %v", err)
+			}
+		})
+	}
+}
+
+func TestBindingErrors_Error(t *testing.T) {
+	apiErr := &core.APIError{StatusCode: 403, Code: "liveStreamAlreadyBound", Message: "bound"}
+
+	bound := &StreamAlreadyBoundError{BroadcastID: "b1", StreamID: "s1", Err: apiErr}
+	if got := bound.Error(); got != "stream s1 is already bound to another broadcast (binding to b1): "+apiErr.Error() {
+		t.Errorf("Error() = %q", got)
+	}
+	if !errors.Is(bound, apiErr) {
+		t.Error("StreamAlreadyBoundError should unwrap to the API error")
+	}
+
+	incompatible := &IncompatibleStreamError{BroadcastID: "b1", StreamID: "s1", Reason: "1080p stream on 720p broadcast", Err: apiErr}
+	if got := incompatible.Error(); got != "stream s1 is incompatible with broadcast b1: 1080p stream on 720p broadcast" {
+		t.Errorf("Error() = %q", got)
+	}
+	if !errors.Is(incompatible, apiErr) {
+		t.Error("IncompatibleStreamError should unwrap to the API error")
+	}
+}
@@ -0,0 +1,62 @@
+package streaming
+
+import "time"
+
+// FilterByScheduledStart returns the broadcasts whose scheduled start time
+// is in [from, to), in their original order. A zero from or to leaves that
+// end of the window open. Broadcasts without a snippet or a scheduled start
+// time are dropped.
+//
+// It filters an already-fetched list, e.g. from GetBroadcasts, and makes no
+// API calls.
+func FilterByScheduledStart(broadcasts []*LiveBroadcast, from, to time.Time) []*LiveBroadcast {
+	var filtered []*LiveBroadcast
+	for _, b := range broadcasts {
+		start, ok := b.scheduledStart()
+		if !ok {
+			continue
+		}
+		if !from.IsZero() && start.Before(from) {
+			continue
+		}
+		if !to.IsZero() && !start.Before(to) {
+			continue
+		}
+		filtered = append(filtered, b)
+	}
+	return filtered
+}
+
+// FilterUpcoming returns the broadcasts scheduled to start within the given
+// duration from now, in their original order, e.g. 24*time.Hour for the next
+// day's events. A within of zero or less returns every broadcast scheduled
+// in the future. Broadcasts whose status shows they are live, complete, or
+// revoked are dropped even if their scheduled start is still ahead.
+func FilterUpcoming(broadcasts []*LiveBroadcast, within time.Duration) []*LiveBroadcast {
+	return filterUpcoming(broadcasts, time.Now(), within)
+}
+
+// filterUpcoming implements FilterUpcoming relative to now.
+func filterUpcoming(broadcasts []*LiveBroadcast, now time.Time, within time.Duration) []*LiveBroadcast {
+	var to time.Time
+	if within > 0 {
+		to = now.Add(within)
+	}
+
+	var upcoming []*LiveBroadcast
+	for _, b := range FilterByScheduledStart(broadcasts, now, to) {
+		if b.IsLive() || b.IsComplete() || (b.Status != nil && b.Status.LifeCycleStatus == BroadcastStatusRevoked) {
+			continue
+		}
+		upcoming = append(upcoming, b)
+	}
+	return upcoming
+}
+
+// scheduledStart returns the broadcast's scheduled start time, if set.
+func (b *LiveBroadcast) scheduledStart() (time.Time, bool) {
+	if b == nil || b.Snippet == nil || b.Snippet.ScheduledStartTime == nil || b.Snippet.ScheduledStartTime.IsZero() {
+		return time.Time{}, false
+	}
+	return *b.Snippet.ScheduledStartTime, true
+}
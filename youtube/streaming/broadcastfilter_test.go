@@ -0,0 +1,106 @@
+package streaming
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// scheduledBroadcast returns a broadcast scheduled to start at start, with
+// the given life cycle status if not empty.
+func scheduledBroadcast(id string, start time.Time, status string) *LiveBroadcast {
+	b := &LiveBroadcast{ID: id, Snippet: &BroadcastSnippet{Title: id}}
+	if !start.IsZero() {
+		b.Snippet.ScheduledStartTime = &start
+	}
+	if status != "" {
+		b.Status = &BroadcastStatus{LifeCycleStatus: status}
+	}
+	return b
+}
+
+func broadcastIDs(broadcasts []*LiveBroadcast) []string {
+	var ids []string
+	for _, b := range broadcasts {
+		ids = append(ids, b.ID)
+	}
+	return ids
+}
+
+func TestFilterByScheduledStart(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	broadcasts := []*LiveBroadcast{
+		scheduledBroadcast("b3", base.Add(3*time.Hour), ""),
+		scheduledBroadcast("b1", base.Add(time.Hour), ""),
+		scheduledBroadcast("none", time.Time{}, ""),
+		{ID: "nosnippet"},
+		nil,
+		scheduledBroadcast("b0", base, ""),
+		scheduledBroadcast("b2", base.Add(2*time.Hour), ""),
+	}
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     []string
+	}{
+		{name: "window", from: base.Add(time.Hour), to: base.Add(3 * time.Hour), want: []string{"b1", "b2"}},
+		{name: "from inclusive", from: base, to: base.Add(time.Minute), want: []string{"b0"}},
+		{name: "open start", to: base.Add(2 * time.Hour), want: []string{"b1", "b0"}},
+		{name: "open end", from: base.Add(2 * time.Hour), want: []string{"b3", "b2"}},
+		{name: "open both", want: []string{"b3", "b1", "b0", "b2"}},
+		{name: "empty window", from: base.Add(5 * time.Hour), to: base.Add(6 * time.Hour), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := broadcastIDs(FilterByScheduledStart(broadcasts, tt.from, tt.to))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FilterByScheduledStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterUpcoming(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	broadcasts := []*LiveBroadcast{
+		scheduledBroadcast("past", now.Add(-time.Hour), BroadcastStatusCreated),
+		scheduledBroadcast("soon", now.Add(time.Hour), BroadcastStatusReady),
+		scheduledBroadcast("tomorrow", now.Add(23*time.Hour), ""),
+		scheduledBroadcast("nextweek", now.Add(7*24*time.Hour), BroadcastStatusCreated),
+		scheduledBroadcast("early", now.Add(2*time.Hour), BroadcastStatusLive),
+		scheduledBroadcast("done", now.Add(3*time.Hour), BroadcastStatusComplete),
+		scheduledBroadcast("revoked", now.Add(4*time.Hour), BroadcastStatusRevoked),
+		scheduledBroadcast("testing", now.Add(5*time.Hour), BroadcastStatusTesting),
+	}
+
+	tests := []struct {
+		name   string
+		within time.Duration
+		want   []string
+	}{
+		{name: "next 24h", within: 24 * time.Hour, want: []string{"soon", "tomorrow", "testing"}},
+		{name: "next 2h", within: 2 * time.Hour, want: []string{"soon"}},
+		{name: "unbounded", within: 0, want: []string{"soon", "tomorrow", "nextweek", "testing"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := broadcastIDs(filterUpcoming(broadcasts, now, tt.within))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FilterUpcoming() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("relative to now", func(t *testing.T) {
+		list := []*LiveBroadcast{
+			scheduledBroadcast("later", time.Now().Add(time.Hour), ""),
+			scheduledBroadcast("earlier", time.Now().Add(-time.Hour), ""),
+		}
+		if got := broadcastIDs(FilterUpcoming(list, 24*time.Hour)); !slices.Equal(got, []string{"later"}) {
+			t.Errorf("FilterUpcoming() = %v, want [later]", got)
+		}
+	})
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Its-donkey/yougopher/youtube/core"
+	"github.com/Its-donkey/yougopher/youtube/data"
 )
 
 // LiveBroadcast represents a YouTube live broadcast resource.
@@ -97,7 +99,7 @@ type BroadcastStatus struct {
 	LifeCycleStatus string `json:"lifeCycleStatus,omitempty"`
 
 	// PrivacyStatus is the broadcast's privacy status.
-	// Values: "private", "public", "unlisted"
+	// Values: "private", "public", "unlisted" (see BroadcastPrivacyPublic)
 	PrivacyStatus string `json:"privacyStatus,omitempty"`
 
 	// RecordingStatus indicates if the broadcast is being recorded.
@@ -214,6 +216,13 @@ const (
 	BroadcastStatusTesting      = "testing"
 )
 
+// Broadcast privacy status constants.
+const (
+	BroadcastPrivacyPrivate  = "private"
+	BroadcastPrivacyPublic   = "public"
+	BroadcastPrivacyUnlisted = "unlisted"
+)
+
 // LiveBroadcastListResponse is the response from liveBroadcasts.list.
 type LiveBroadcastListResponse struct {
 	// Kind is the resource type.
@@ -265,6 +274,7 @@ type GetBroadcastsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -307,9 +317,7 @@ func GetBroadcasts(ctx context.Context, client *core.Client, params *GetBroadcas
 	if params.BroadcastType != "" {
 		query.Set("broadcastType", params.BroadcastType)
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -462,13 +470,19 @@ type BindBroadcastParams struct {
 
 // InsertBroadcast creates a new live broadcast.
 // Requires OAuth authentication with youtube.force-ssl scope.
+//
+// The broadcast must have a Snippet.Title, a Snippet.ScheduledStartTime,
+// and a Status.PrivacyStatus of "public", "private", or "unlisted". For a
+// broadcast that starts immediately, schedule it for the current time.
+// A missing or invalid field is returned as a *core.ValidationError before
+// the request is sent.
 // Quota cost: 50 units.
 func InsertBroadcast(ctx context.Context, client *core.Client, broadcast *LiveBroadcast, parts ...string) (*LiveBroadcast, error) {
 	if broadcast == nil {
 		return nil, fmt.Errorf("broadcast cannot be nil")
 	}
-	if broadcast.Snippet == nil || broadcast.Snippet.Title == "" {
-		return nil, fmt.Errorf("broadcast title is required")
+	if err := validateNewBroadcast(broadcast); err != nil {
+		return nil, err
 	}
 
 	if len(parts) == 0 {
@@ -487,6 +501,32 @@ func InsertBroadcast(ctx context.Context, client *core.Client, broadcast *LiveBr
 	return &resp, nil
 }
 
+// validateNewBroadcast checks the fields liveBroadcasts.insert requires.
+func validateNewBroadcast(broadcast *LiveBroadcast) error {
+	if broadcast.Snippet == nil || broadcast.Snippet.Title == "" {
+		return &core.ValidationError{Field: "snippet.title", Message: "broadcast title is required"}
+	}
+	if broadcast.Snippet.ScheduledStartTime == nil || broadcast.Snippet.ScheduledStartTime.IsZero() {
+		return &core.ValidationError{
+			Field:   "snippet.scheduledStartTime",
+			Message: "scheduled start time is required; use the current time to start immediately",
+		}
+	}
+	if broadcast.Status == nil || broadcast.Status.PrivacyStatus == "" {
+		return &core.ValidationError{Field: "status.privacyStatus", Message: "privacy status is required"}
+	}
+	switch broadcast.Status.PrivacyStatus {
+	case BroadcastPrivacyPublic, BroadcastPrivacyPrivate, BroadcastPrivacyUnlisted:
+	default:
+		return &core.ValidationError{
+			Field: "status.privacyStatus",
+			Message: fmt.Sprintf("%q is not one of %q, %q, or %q", broadcast.Status.PrivacyStatus,
+				BroadcastPrivacyPublic, BroadcastPrivacyPrivate, BroadcastPrivacyUnlisted),
+		}
+	}
+	return nil
+}
+
 // UpdateBroadcast updates an existing live broadcast.
 // Requires OAuth authentication with youtube.force-ssl scope.
 // The broadcast must include the ID field.
@@ -532,6 +572,8 @@ func DeleteBroadcast(ctx context.Context, client *core.Client, broadcastID strin
 // BindBroadcast binds a stream to a broadcast.
 // This associates the video stream with the broadcast, allowing the broadcast
 // to receive video from the stream.
+// Binding conflicts are returned as *StreamAlreadyBoundError or
+// *IncompatibleStreamError.
 // Requires OAuth authentication with youtube.force-ssl scope.
 // Quota cost: 50 units.
 func BindBroadcast(ctx context.Context, client *core.Client, params *BindBroadcastParams) (*LiveBroadcast, error) {
@@ -559,7 +601,7 @@ func BindBroadcast(ctx context.Context, client *core.Client, params *BindBroadca
 	var resp LiveBroadcast
 	err := client.Post(ctx, "liveBroadcasts/bind", query, nil, "liveBroadcasts.bind", &resp)
 	if err != nil {
-		return nil, err
+		return nil, bindError(params.BroadcastID, params.StreamID, err)
 	}
 
 	return &resp, nil
@@ -655,6 +697,86 @@ func (b *LiveBroadcast) CuepointRepeatInterval() int {
 	return b.MonetizationDetails.CuepointSchedule.RepeatIntervalSecs
 }
 
+// Latency preference values for BroadcastContentDetails.LatencyPreference.
+const (
+	LatencyNormal   = "normal"
+	LatencyLow      = "low"
+	LatencyUltraLow = "ultraLow"
+)
+
+// LatencyPreference returns the broadcast's configured latency preference
+// (LatencyNormal, LatencyLow, or LatencyUltraLow).
+// Returns empty string if content details are not available.
+func (b *LiveBroadcast) LatencyPreference() string {
+	if b.ContentDetails == nil {
+		return ""
+	}
+	return b.ContentDetails.LatencyPreference
+}
+
+// BroadcastStats combines a broadcast with live statistics from its video.
+type BroadcastStats struct {
+	// Broadcast is the broadcast resource.
+	Broadcast *LiveBroadcast
+
+	// ConcurrentViewers is the current number of viewers.
+	// It is 0 when the broadcast is not live or the count is hidden.
+	ConcurrentViewers uint64
+
+	// LatencyPreference is the broadcast's configured latency preference.
+	LatencyPreference string
+}
+
+// GetBroadcastStats retrieves a broadcast together with its concurrent
+// viewer count. The viewer count comes from the broadcast's video
+// (liveStreamingDetails), which shares the broadcast ID.
+// Quota cost: 2 units (liveBroadcasts.list + videos.list).
+func GetBroadcastStats(ctx context.Context, client *core.Client, broadcastID string) (*BroadcastStats, error) {
+	if broadcastID == "" {
+		return nil, fmt.Errorf("broadcast ID cannot be empty")
+	}
+
+	broadcast, err := GetBroadcast(ctx, client, broadcastID, "snippet", "status", "contentDetails")
+	if err != nil {
+		return nil, err
+	}
+
+	viewers, err := GetConcurrentViewers(ctx, client, broadcastID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BroadcastStats{
+		Broadcast:         broadcast,
+		ConcurrentViewers: viewers,
+		LatencyPreference: broadcast.LatencyPreference(),
+	}, nil
+}
+
+// GetConcurrentViewers returns the current number of viewers of a live
+// broadcast. Returns 0 when the broadcast is not live or the channel hides
+// the count.
+// Quota cost: 1 unit.
+func GetConcurrentViewers(ctx context.Context, client *core.Client, broadcastID string) (uint64, error) {
+	if broadcastID == "" {
+		return 0, fmt.Errorf("broadcast ID cannot be empty")
+	}
+
+	video, err := data.GetVideo(ctx, client, broadcastID, "liveStreamingDetails")
+	if err != nil {
+		return 0, err
+	}
+
+	if video.LiveStreamingDetails == nil || video.LiveStreamingDetails.ConcurrentViewers == "" {
+		return 0, nil
+	}
+	viewers, err := strconv.ParseUint(video.LiveStreamingDetails.ConcurrentViewers, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing concurrent viewers: %w", err)
+	}
+	return viewers, nil
+}
+
 // InsertCuepointParams contains parameters for inserting a cuepoint.
 type InsertCuepointParams struct {
 	// BroadcastID is the ID of the broadcast (required).
@@ -682,7 +804,9 @@ const CuepointInsertImmediate int64 = -1
 // This triggers mid-roll ads for viewers who have ad-supported viewing.
 //
 // Note: Cuepoints can only be inserted into broadcasts that are currently live.
-// The broadcast must have monetization enabled for ads to play.
+// The broadcast must have monetization enabled for ads to play. A 403 response
+// is returned as *CuepointIneligibleError. Use WaitForCuepoint to wait for the
+// ad break to finish.
 //
 // Requires OAuth authentication with youtube.force-ssl scope.
 // Quota cost: 50 units.
@@ -716,9 +840,10 @@ func InsertCuepoint(ctx context.Context, client *core.Client, params *InsertCuep
 	var resp Cuepoint
 	err := client.Post(ctx, "liveBroadcasts/cuepoint", query, req, "liveBroadcasts.cuepoint", &resp)
 	if err != nil {
-		return nil, err
+		return nil, cuepointError(params.BroadcastID, err)
 	}
 
+	recordCuepoint(&resp, params)
 	return &resp, nil
 }
 
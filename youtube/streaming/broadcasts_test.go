@@ -197,6 +197,27 @@ func TestGetBroadcasts(t *testing.T) {
 			t.Fatalf("unexpected error: %v", err)
 		}
 	})
+
+	t.Run("max results clamped", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("maxResults") != "50" {
+				t.Errorf("unexpected maxResults: %s", r.URL.Query().Get("maxResults"))
+			}
+			resp := LiveBroadcastListResponse{Items: []*LiveBroadcast{{ID: "broadcast123"}}}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := GetBroadcasts(context.Background(), client, &GetBroadcastsParams{
+			Mine:       true,
+			MaxResults: 100,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
 }
 
 func TestGetBroadcast(t *testing.T) {
@@ -588,10 +609,13 @@ func TestInsertBroadcast(t *testing.T) {
 		defer server.Close()
 
 		client := core.NewClient(core.WithBaseURL(server.URL))
+		start := time.Now().Add(time.Hour)
 		broadcast, err := InsertBroadcast(context.Background(), client, &LiveBroadcast{
 			Snippet: &BroadcastSnippet{
-				Title: "New Broadcast",
+				Title:              "New Broadcast",
+				ScheduledStartTime: &start,
 			},
+			Status: &BroadcastStatus{PrivacyStatus: BroadcastPrivacyUnlisted},
 		})
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -644,8 +668,10 @@ func TestInsertBroadcast(t *testing.T) {
 		defer server.Close()
 
 		client := core.NewClient(core.WithBaseURL(server.URL))
+		start := time.Now()
 		_, err := InsertBroadcast(context.Background(), client, &LiveBroadcast{
-			Snippet: &BroadcastSnippet{Title: "Test"},
+			Snippet: &BroadcastSnippet{Title: "Test", ScheduledStartTime: &start},
+			Status:  &BroadcastStatus{PrivacyStatus: BroadcastPrivacyPrivate},
 		}, "snippet", "contentDetails")
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -653,6 +679,51 @@ func TestInsertBroadcast(t *testing.T) {
 	})
 }
 
+func TestInsertBroadcast_Validation(t *testing.T) {
+	start := time.Now().Add(time.Hour)
+	valid := func() *LiveBroadcast {
+		return &LiveBroadcast{
+			Snippet: &BroadcastSnippet{Title: "Stream", ScheduledStartTime: &start},
+			Status:  &BroadcastStatus{PrivacyStatus: BroadcastPrivacyPublic},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(*LiveBroadcast)
+		wantField string
+	}{
+		{"missing title", func(b *LiveBroadcast) { b.Snippet.Title = "" }, "snippet.title"},
+		{"missing schedule", func(b *LiveBroadcast) { b.Snippet.ScheduledStartTime = nil }, "snippet.scheduledStartTime"},
+		{"zero schedule", func(b *LiveBroadcast) { b.Snippet.ScheduledStartTime = &time.Time{} }, "snippet.scheduledStartTime"},
+		{"nil status", func(b *LiveBroadcast) { b.Status = nil }, "status.privacyStatus"},
+		{"empty privacy", func(b *LiveBroadcast) { b.Status.PrivacyStatus = "" }, "status.privacyStatus"},
+		{"invalid privacy", func(b *LiveBroadcast) { b.Status.PrivacyStatus = "Public" }, "status.privacyStatus"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requested = true
+			}))
+			defer server.Close()
+
+			broadcast := valid()
+			tt.modify(broadcast)
+			_, err := InsertBroadcast(context.Background(), core.NewClient(core.WithBaseURL(server.URL)), broadcast)
+
+			var validationErr *core.ValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != tt.wantField {
+				t.Errorf("error = %v, want ValidationError for %s", err, tt.wantField)
+			}
+			if requested {
+				t.Error("request sent for invalid broadcast")
+			}
+		})
+	}
+}
+
 func TestUpdateBroadcast(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2265,3 +2336,104 @@ func TestInsertCuepoint_ValidationError_EmptyBroadcastID(t *testing.T) {
 		t.Errorf("expected nil result on validation error, got %+v", result)
 	}
 }
+
+func TestLiveBroadcast_LatencyPreference(t *testing.T) {
+	tests := []struct {
+		name      string
+		broadcast *LiveBroadcast
+		want      string
+	}{
+		{"no content details", &LiveBroadcast{}, ""},
+		{"low", &LiveBroadcast{ContentDetails: &BroadcastContentDetails{LatencyPreference: LatencyLow}}, LatencyLow},
+		{"ultra low", &LiveBroadcast{ContentDetails: &BroadcastContentDetails{LatencyPreference: LatencyUltraLow}}, LatencyUltraLow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.broadcast.LatencyPreference(); got != tt.want {
+				t.Errorf("LatencyPreference() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBroadcastStats(t *testing.T) {
+	tests := []struct {
+		name        string
+		videoJSON   string
+		wantViewers uint64
+		wantErr     bool
+	}{
+		{
+			name:        "live",
+			videoJSON:   `{"items":[{"id":"b1","liveStreamingDetails":{"concurrentViewers":"1234"}}]}`,
+			wantViewers: 1234,
+		},
+		{
+			name:        "no viewer count",
+			videoJSON:   `{"items":[{"id":"b1","liveStreamingDetails":{}}]}`,
+			wantViewers: 0,
+		},
+		{
+			name:      "invalid viewer count",
+			videoJSON: `{"items":[{"id":"b1","liveStreamingDetails":{"concurrentViewers":"lots"}}]}`,
+			wantErr:   true,
+		},
+		{
+			name:      "video not found",
+			videoJSON: `{"items":[]}`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/liveBroadcasts":
+					_ = json.NewEncoder(w).Encode(LiveBroadcastListResponse{Items: []*LiveBroadcast{{
+						ID:             "b1",
+						ContentDetails: &BroadcastContentDetails{LatencyPreference: LatencyUltraLow},
+					}}})
+				case "/videos":
+					if r.URL.Query().Get("part") != "liveStreamingDetails" {
+						t.Errorf("unexpected part: %s", r.URL.Query().Get("part"))
+					}
+					_, _ = w.Write([]byte(tt.videoJSON))
+				default:
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			stats, err := GetBroadcastStats(context.Background(), client, "b1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetBroadcastStats() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if stats.ConcurrentViewers != tt.wantViewers {
+				t.Errorf("ConcurrentViewers = %d, want %d", stats.ConcurrentViewers, tt.wantViewers)
+			}
+			if stats.LatencyPreference != LatencyUltraLow {
+				t.Errorf("LatencyPreference = %q, want %q", stats.LatencyPreference, LatencyUltraLow)
+			}
+			if stats.Broadcast == nil || stats.Broadcast.ID != "b1" {
+				t.Errorf("Broadcast = %+v, want b1", stats.Broadcast)
+			}
+		})
+	}
+
+	t.Run("empty ID", func(t *testing.T) {
+		client := core.NewClient()
+		if _, err := GetBroadcastStats(context.Background(), client, ""); err == nil {
+			t.Error("expected error for empty broadcast ID")
+		}
+		if _, err := GetConcurrentViewers(context.Background(), client, ""); err == nil {
+			t.Error("expected error for empty broadcast ID")
+		}
+	})
+}
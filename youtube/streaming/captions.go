@@ -0,0 +1,135 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// captionTimeFormat is the timestamp format of the caption ingestion
+// protocol, in UTC.
+const captionTimeFormat = "2006-01-02T15:04:05.000"
+
+// defaultCaptionTimeout bounds each caption request when no HTTP client is
+// set with WithCaptionHTTPClient.
+const defaultCaptionTimeout = 10 * time.Second
+
+// CaptionsIngestURL returns the URL to send live captions to with
+// NewCaptionSender. Requires the contentDetails part.
+// Returns empty string if the stream has no caption ingestion configured,
+// e.g. when the broadcast's closed captions type is not
+// "closedCaptionsHttpPost".
+func (s *LiveStream) CaptionsIngestURL() string {
+	if s.ContentDetails == nil {
+		return ""
+	}
+	return s.ContentDetails.ClosedCaptionsIngestionURL
+}
+
+// CaptionSender posts live captions to one stream's caption ingestion URL
+// (see LiveStream.CaptionsIngestURL). Create one per stream with
+// NewCaptionSender and keep it for the whole broadcast: it numbers requests
+// with the increasing sequence numbers the ingestion protocol requires.
+//
+// A CaptionSender is safe for concurrent use.
+type CaptionSender struct {
+	ingestURL  *url.URL
+	httpClient *http.Client
+	seq        atomic.Int64
+}
+
+// CaptionSenderOption configures a CaptionSender.
+type CaptionSenderOption func(*CaptionSender)
+
+// WithCaptionHTTPClient sets the HTTP client used to post captions, e.g. to
+// use a proxy or a different timeout. Default is a client with a 10 second
+// timeout.
+func WithCaptionHTTPClient(client *http.Client) CaptionSenderOption {
+	return func(s *CaptionSender) { s.httpClient = client }
+}
+
+// NewCaptionSender creates a CaptionSender for a stream's caption ingestion
+// URL:
+//
+//	captionsURL := stream.CaptionsIngestURL()
+//	if captionsURL == "" {
+//		return errors.New("captions not configured")
+//	}
+//	captions, err := streaming.NewCaptionSender(captionsURL)
+//	if err != nil {
+//		return err
+//	}
+//	err = captions.Send(ctx, "Welcome to the stream!")
+//
+// The broadcast must use the "closedCaptionsHttpPost" closed captions type.
+// The URL contains the stream's caption key, so treat it like a stream key;
+// it is left out of returned errors.
+func NewCaptionSender(ingestURL string, opts ...CaptionSenderOption) (*CaptionSender, error) {
+	if ingestURL == "" {
+		return nil, errors.New("captions ingestion URL cannot be empty")
+	}
+	u, err := url.Parse(ingestURL)
+	if err != nil {
+		// Leave out the URL, which holds the caption key
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("parsing captions ingestion URL: %w", err)
+	}
+
+	s := &CaptionSender{
+		ingestURL:  u,
+		httpClient: &http.Client{Timeout: defaultCaptionTimeout},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// Send posts a line of live captions, timestamped with the current time.
+// Newlines in text start a new caption line.
+func (s *CaptionSender) Send(ctx context.Context, text string) error {
+	if strings.TrimSpace(text) == "" {
+		return errors.New("caption text cannot be empty")
+	}
+
+	u := *s.ingestURL
+	query := u.Query()
+	query.Set("seq", strconv.FormatInt(s.seq.Add(1), 10))
+	u.RawQuery = query.Encode()
+
+	text = strings.ReplaceAll(strings.TrimSpace(text), "\r\n", "\n")
+	body := time.Now().UTC().Format(captionTimeFormat) + "\n" + strings.ReplaceAll(text, "\n", "<br>") + "\n"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating caption request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		// The *url.Error message includes the URL and its caption key
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("sending caption: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("sending caption: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLiveStream_CaptionsIngestURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		stream *LiveStream
+		want   string
+	}{
+		{"no content details", &LiveStream{}, ""},
+		{"not configured", &LiveStream{ContentDetails: &StreamContentDetails{IsReusable: true}}, ""},
+		{
+			"configured",
+			&LiveStream{ContentDetails: &StreamContentDetails{
+				ClosedCaptionsIngestionURL: "http://upload.youtube.com/closedcaption?cid=abc",
+			}},
+			"http://upload.youtube.com/closedcaption?cid=abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stream.CaptionsIngestURL(); got != tt.want {
+				t.Errorf("CaptionsIngestURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCaptionSender_Send(t *testing.T) {
+	type request struct {
+		cid, seq, contentType, body string
+	}
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{
+			cid:         r.URL.Query().Get("cid"),
+			seq:         r.URL.Query().Get("seq"),
+			contentType: r.Header.Get("Content-Type"),
+			body:        string(body),
+		})
+		if r.URL.Query().Get("cid") == "bad" {
+			http.Error(w, "invalid cid", http.StatusBadRequest)
+			return
+		}
+		_, _ = io.WriteString(w, time.Now().UTC().Format(captionTimeFormat))
+	}))
+	defer server.Close()
+
+	sender, err := NewCaptionSender(server.URL + "/closedcaption?cid=abc")
+	if err != nil {
+		t.Fatalf("NewCaptionSender() error = %v", err)
+	}
+	before := time.Now().UTC().Truncate(time.Millisecond)
+
+	if err := sender.Send(context.Background(), "Hello everyone"); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if err := sender.Send(context.Background(), "two\r\nlines\n"); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+	first := requests[0]
+	if first.cid != "abc" || first.seq != "1" || first.contentType != "text/plain" {
+		t.Errorf("request = %+v, want cid abc, seq 1, text/plain", first)
+	}
+	stamp, text, ok := strings.Cut(first.body, "\n")
+	if !ok || text != "Hello everyone\n" {
+		t.Errorf("body = %q", first.body)
+	}
+	if ts, err := time.Parse(captionTimeFormat, stamp); err != nil || ts.Before(before) {
+		t.Errorf("timestamp = %q (%v), want current UTC time", stamp, err)
+	}
+	if requests[1].seq != "2" || !strings.HasSuffix(requests[1].body, "\ntwo<br>lines\n") {
+		t.Errorf("second request = %+v, want seq 2 and <br> line break", requests[1])
+	}
+
+	// Each sender numbers its own requests.
+	other, _ := NewCaptionSender(server.URL + "/closedcaption?cid=abc")
+	if err := other.Send(context.Background(), "again"); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got := requests[2].seq; got != "1" {
+		t.Errorf("new sender seq = %s, want 1", got)
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := NewCaptionSender(""); err == nil {
+			t.Error("expected error for empty URL")
+		}
+		if err := sender.Send(context.Background(), " \n"); err == nil {
+			t.Error("expected error for empty text")
+		}
+		bad, _ := NewCaptionSender(server.URL + "/closedcaption?cid=bad")
+		err := bad.Send(context.Background(), "text")
+		if err == nil || !strings.Contains(err.Error(), "status 400: invalid cid") {
+			t.Errorf("error = %v, want status 400", err)
+		}
+	})
+}
+
+func TestCaptionSender_HTTPClient(t *testing.T) {
+	var used bool
+	client := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		used = true
+		return nil, errors.New("proxy unreachable")
+	})}
+
+	const key = "secret-caption-key"
+	sender, err := NewCaptionSender("http://upload.youtube.com/closedcaption?cid="+key, WithCaptionHTTPClient(client))
+	if err != nil {
+		t.Fatalf("NewCaptionSender() error = %v", err)
+	}
+	err = sender.Send(context.Background(), "text")
+	if !used {
+		t.Error("custom HTTP client was not used")
+	}
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if strings.Contains(err.Error(), key) {
+		t.Errorf("error %q contains the caption key", err)
+	}
+
+	if _, err := NewCaptionSender("http://upload.youtube.com/%zz?cid=" + key); err == nil || strings.Contains(err.Error(), key) {
+		t.Errorf("parse error = %v, want an error without the caption key", err)
+	}
+}
+
+// roundTripFunc adapts a function to an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
@@ -2,14 +2,42 @@ package streaming
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/Its-donkey/yougopher/youtube/auth"
 	"github.com/Its-donkey/yougopher/youtube/core"
 )
 
+// ErrShuttingDown is returned by ChatBotClient actions once Shutdown has begun.
+var ErrShuttingDown = errors.New("chat bot is shutting down")
+
+// ShutdownError is returned by Shutdown when the context ends before all
+// pending work has completed.
+type ShutdownError struct {
+	// Undelivered is the number of Say calls still in flight when the
+	// deadline was reached. Their delivery is unconfirmed.
+	Undelivered int
+
+	// Err is the context error that ended the drain.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ShutdownError) Error() string {
+	return fmt.Sprintf("shutdown: %d undelivered message(s): %v", e.Undelivered, e.Err)
+}
+
+// Unwrap returns the underlying context error.
+func (e *ShutdownError) Unwrap() error {
+	return e.Err
+}
+
 // TokenProvider provides access tokens for authentication.
 // This interface allows ChatBotClient to work with any auth implementation.
 type TokenProvider interface {
@@ -17,6 +45,17 @@ type TokenProvider interface {
 	AccessToken(ctx context.Context) (string, error)
 }
 
+// ScopeProvider is implemented by token providers that know which OAuth
+// scopes were granted (e.g., *auth.AuthClient). ChatBotClient uses it to
+// reject actions that would fail with 403 before sending them.
+type ScopeProvider interface {
+	// GrantedScopes returns the granted scopes, or nil if unknown.
+	GrantedScopes() []string
+}
+
+// chatWriteScopes are the scopes that allow sending and moderating chat.
+var chatWriteScopes = []string{auth.ScopeLiveChat, auth.ScopeLiveChatModerate}
+
 // ChatMessage represents a parsed chat message with author information.
 type ChatMessage struct {
 	// ID is the unique message identifier.
@@ -58,6 +97,76 @@ type Author struct {
 
 	// IsVerified indicates if the author is verified.
 	IsVerified bool
+
+	// MemberMonths is the author's membership tenure in months, as last
+	// reported by a member milestone event seen by this ChatBotClient.
+	// Zero when unknown. The API does not expose a membership start date.
+	MemberMonths int
+
+	// MemberLevelName is the author's membership level, as last reported
+	// by a membership, milestone, or gift received event seen by this
+	// ChatBotClient. Empty when unknown. The API does not expose badge images.
+	MemberLevelName string
+}
+
+// messageRing is a fixed-size ring buffer of chat messages.
+type messageRing struct {
+	buf   []*ChatMessage
+	start int // index of the oldest message
+	count int
+}
+
+// newMessageRing creates a ring holding up to size messages.
+func newMessageRing(size int) *messageRing {
+	return &messageRing{buf: make([]*ChatMessage, size)}
+}
+
+// add appends a message, overwriting the oldest when full.
+func (r *messageRing) add(msg *ChatMessage) {
+	if r.count < len(r.buf) {
+		r.buf[(r.start+r.count)%len(r.buf)] = msg
+		r.count++
+		return
+	}
+	r.buf[r.start] = msg
+	r.start = (r.start + 1) % len(r.buf)
+}
+
+// messages returns the buffered messages from oldest to newest.
+func (r *messageRing) messages() []*ChatMessage {
+	out := make([]*ChatMessage, r.count)
+	for i := range out {
+		out[i] = r.buf[(r.start+i)%len(r.buf)]
+	}
+	return out
+}
+
+// remove drops the message with the given ID, keeping the order of the rest,
+// and returns it. Returns nil if no buffered message has the ID.
+func (r *messageRing) remove(id string) *ChatMessage {
+	msgs := r.messages()
+	kept := msgs[:0]
+	var removed *ChatMessage
+	for _, m := range msgs {
+		if m.ID != id {
+			kept = append(kept, m)
+		} else if removed == nil {
+			removed = m
+		}
+	}
+	if removed == nil {
+		return nil
+	}
+	clear(r.buf)
+	r.start = 0
+	r.count = copy(r.buf, kept)
+	return removed
+}
+
+// memberInfo holds membership data observed from chat events.
+type memberInfo struct {
+	months    int
+	levelName string
 }
 
 // SuperChatEvent represents a Super Chat donation.
@@ -195,6 +304,24 @@ type GiftMembershipReceivedEvent struct {
 	Raw *LiveChatMessage
 }
 
+// MessageDeletedEvent represents a chat message deleted by a moderator.
+type MessageDeletedEvent struct {
+	// MessageID is the ID of the deleted message.
+	MessageID string
+
+	// Author is the user who sent the deleted message, or nil if the
+	// message was not in the history buffer.
+	Author *Author
+
+	// Message is the display text of the deleted message, or empty if the
+	// message was not in the history buffer.
+	Message string
+
+	// Original is the deleted message as it was received, or nil if it was
+	// not in the history buffer (see WithHistoryBuffer).
+	Original *ChatMessage
+}
+
 // BanEvent represents a user ban in chat.
 type BanEvent struct {
 	// BannedUser contains information about the banned user.
@@ -206,6 +333,21 @@ type BanEvent struct {
 	// Duration is the ban duration for temporary bans.
 	Duration time.Duration
 
+	// ModeratorChannelID is the channel ID of the moderator or owner who
+	// issued the ban. Empty if the API did not report the ban's author.
+	ModeratorChannelID string
+
+	// Moderator is the moderator or owner who issued the ban, or nil if
+	// the API did not report the ban's author.
+	Moderator *Author
+
+	// ByBot is true if this ChatBotClient issued the ban through Ban or
+	// Timeout in the last 5 minutes, e.g. from automated moderation rules.
+	// It is false for bans made in YouTube's interface, by other clients or
+	// processes, and for repeat events of a ban already reported. The API
+	// itself does not report which client issued a ban.
+	ByBot bool
+
 	// Raw is the underlying UserBannedDetails.
 	Raw *UserBannedDetails
 }
@@ -222,7 +364,9 @@ type (
 		fn func(*GiftMembershipReceivedEvent)
 	}
 	messageDeletedHandler struct{ fn func(string) }
+	deletionHandler       struct{ fn func(*MessageDeletedEvent) }
 	userBannedHandler     struct{ fn func(*BanEvent) }
+	moderatorHandler      struct{ fn func(*ModeratorEvent) }
 	chatConnectHandler    struct{ fn func() }
 	chatDisconnectHandler struct{ fn func() }
 	chatErrorHandler      struct{ fn func(error) }
@@ -245,7 +389,10 @@ type ChatBotClient struct {
 	giftMembershipHandlers         []*giftMembershipHandler
 	giftMembershipReceivedHandlers []*giftMembershipReceivedHandler
 	messageDeletedHandlers         []*messageDeletedHandler
+	deletionHandlers               []*deletionHandler
 	userBannedHandlers             []*userBannedHandler
+	moderatorAddedHandlers         []*moderatorHandler
+	moderatorRemovedHandlers       []*moderatorHandler
 	connectHandlers                []*chatConnectHandler
 	disconnectHandlers             []*chatDisconnectHandler
 	errorHandlers                  []*chatErrorHandler
@@ -255,6 +402,45 @@ type ChatBotClient struct {
 	tokenRefreshStop chan struct{} // Signal to stop token refresh loop
 	tokenRefreshDone chan struct{} // Token refresh loop completed
 	refreshInterval  time.Duration // How often to refresh token (default 45 minutes)
+
+	// Shutdown state
+	workMu       sync.Mutex     // Guards shuttingDown and inflight.Add
+	shuttingDown bool           // Reject new actions while draining
+	inflight     sync.WaitGroup // In-flight actions (sends and moderation)
+	pendingSends atomic.Int64   // In-flight Say calls
+
+	// Membership data observed from events, keyed by channel ID
+	memberMu sync.RWMutex
+	members  map[string]memberInfo
+
+	// Bans issued by this bot awaiting their ban event, keyed by channel ID
+	ownBansMu sync.Mutex
+	ownBans   map[string]time.Time
+
+	// Check granted scopes before actions (see WithScopeValidation)
+	scopeValidation bool
+
+	// Recent chat messages (nil unless WithHistoryBuffer is used)
+	historyMu sync.RWMutex
+	history   *messageRing
+
+	// Heartbeat state (see StartHeartbeat)
+	heartbeatMu    sync.Mutex
+	heartbeatStop  chan struct{} // Signal to stop heartbeat loop
+	heartbeatDone  chan struct{} // Heartbeat loop completed
+	lastManualSend atomic.Int64  // Unix nanoseconds of the last successful Say
+
+	// Moderator tracking (see WithModeratorPollInterval)
+	modPollInterval time.Duration
+	modMu           sync.Mutex
+	moderators      map[string]*LiveChatModerator // Known moderators by entry ID
+	modsKnown       bool                          // Baseline moderator list fetched
+	modVersion      int                           // Bumped on local moderator changes
+	modStop         chan struct{}                 // Signal to stop moderator poll loop
+	modDone         chan struct{}                 // Moderator poll loop completed
+
+	// Structured logger for events and diagnostics (see SetLogger)
+	logger atomic.Pointer[slog.Logger]
 }
 
 // ChatBotOption configures a ChatBotClient.
@@ -279,6 +465,8 @@ func NewChatBotClient(client *core.Client, tokenProvider TokenProvider, liveChat
 		tokenProvider:   tokenProvider,
 		liveChatID:      liveChatID,
 		refreshInterval: DefaultTokenRefreshInterval,
+		members:         make(map[string]memberInfo),
+		scopeValidation: true,
 	}
 
 	for _, opt := range opts {
@@ -293,6 +481,26 @@ func WithPoller(poller *LiveChatPoller) ChatBotOption {
 	return func(c *ChatBotClient) { c.poller = poller }
 }
 
+// WithHistoryBuffer keeps the last n chat messages in memory so they can be
+// read with RecentMessages, e.g. to redraw an overlay after reconnecting.
+// Default is 0 (no history).
+func WithHistoryBuffer(n int) ChatBotOption {
+	return func(c *ChatBotClient) {
+		if n > 0 {
+			c.history = newMessageRing(n)
+		}
+	}
+}
+
+// WithScopeValidation controls the client-side scope check for sending and
+// moderation actions. When enabled (the default) and the token provider
+// implements ScopeProvider, actions return *auth.InsufficientScopeError
+// without a network call if no suitable scope was granted. The check is
+// skipped when scopes are unknown.
+func WithScopeValidation(enabled bool) ChatBotOption {
+	return func(c *ChatBotClient) { c.scopeValidation = enabled }
+}
+
 // WithTokenRefreshInterval sets the interval for refreshing the access token.
 // Default is 45 minutes. Set to 0 to disable auto-refresh.
 func WithTokenRefreshInterval(d time.Duration) ChatBotOption {
@@ -315,6 +523,10 @@ func (c *ChatBotClient) Connect(ctx context.Context) error {
 		c.poller = NewLiveChatPoller(c.client, c.liveChatID)
 	}
 
+	c.workMu.Lock()
+	c.shuttingDown = false
+	c.workMu.Unlock()
+
 	// Clean up any existing subscription to prevent handler duplication
 	if c.pollerUnsub != nil {
 		c.pollerUnsub()
@@ -335,13 +547,23 @@ func (c *ChatBotClient) Connect(ctx context.Context) error {
 	}
 
 	// Start polling
-	return c.poller.Start(ctx)
+	if err := c.poller.Start(ctx); err != nil {
+		return err
+	}
+
+	// Start moderator list polling if enabled
+	if c.modPollInterval > 0 {
+		c.startModeratorWatch(ctx)
+	}
+	return nil
 }
 
 // Close stops the chat bot.
 func (c *ChatBotClient) Close() error {
-	// Stop token refresh loop first
+	// Stop token refresh, heartbeat, and moderator loops first
 	c.stopTokenRefresh()
+	c.stopHeartbeat()
+	c.stopModeratorWatch()
 
 	// Stop poller (this will trigger disconnect handlers)
 	if c.poller != nil {
@@ -355,6 +577,66 @@ func (c *ChatBotClient) Close() error {
 	return nil
 }
 
+// Shutdown gracefully stops the chat bot. It stops accepting new actions
+// (which then return ErrShuttingDown), waits for in-flight sends and
+// moderation actions to complete, then closes the bot.
+//
+// If ctx ends before pending work drains, the bot is closed anyway and a
+// *ShutdownError is returned reporting the number of undelivered messages.
+//
+// To post a final message before exiting, call Say and then Shutdown:
+//
+//	_ = bot.Say(ctx, "Stream ending, thanks for watching!")
+//	if err := bot.Shutdown(shutdownCtx); err != nil {
+//		log.Printf("shutdown: %v", err)
+//	}
+func (c *ChatBotClient) Shutdown(ctx context.Context) error {
+	c.workMu.Lock()
+	c.shuttingDown = true
+	c.workMu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		c.inflight.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return c.Close()
+	case <-ctx.Done():
+		undelivered := int(c.pendingSends.Load())
+		_ = c.Close()
+		return &ShutdownError{Undelivered: undelivered, Err: ctx.Err()}
+	}
+}
+
+// beginAction registers an in-flight action. It returns ErrShuttingDown
+// during Shutdown and ErrNotRunning if the bot is not connected.
+// The returned function must be called when the action completes.
+func (c *ChatBotClient) beginAction(send bool) (func(), error) {
+	c.workMu.Lock()
+	defer c.workMu.Unlock()
+
+	if c.shuttingDown {
+		return nil, ErrShuttingDown
+	}
+	if !c.IsConnected() {
+		return nil, ErrNotRunning
+	}
+
+	c.inflight.Add(1)
+	if send {
+		c.pendingSends.Add(1)
+	}
+	return func() {
+		if send {
+			c.pendingSends.Add(-1)
+		}
+		c.inflight.Done()
+	}, nil
+}
+
 // stopTokenRefresh stops the token refresh loop if running.
 func (c *ChatBotClient) stopTokenRefresh() {
 	if c.tokenRefreshStop != nil {
@@ -405,12 +687,13 @@ func (c *ChatBotClient) subscribeToPoller() {
 
 	// Delete handler
 	unsubs = append(unsubs, c.poller.OnDelete(func(id string) {
-		c.dispatchMessageDeleted(id)
+		original := c.forgetMessage(id)
+		c.dispatchMessageDeleted(id, original)
 	}))
 
 	// Ban handler
-	unsubs = append(unsubs, c.poller.OnBan(func(details *UserBannedDetails) {
-		c.dispatchUserBanned(details)
+	unsubs = append(unsubs, c.poller.onBanMessage(func(msg *LiveChatMessage) {
+		c.dispatchUserBanned(msg)
 	}))
 
 	// Error handler
@@ -442,6 +725,9 @@ func (c *ChatBotClient) handleMessage(msg *LiveChatMessage) {
 		return
 	}
 
+	c.recordMembership(msg)
+	c.logMessage(msg)
+
 	switch msg.Snippet.Type {
 	case MessageTypeText:
 		c.dispatchChatMessage(msg)
@@ -460,67 +746,198 @@ func (c *ChatBotClient) handleMessage(msg *LiveChatMessage) {
 	}
 }
 
+// checkScopes verifies that the granted scopes allow a chat write operation.
+func (c *ChatBotClient) checkScopes(operation string) error {
+	if !c.scopeValidation {
+		return nil
+	}
+	sp, ok := c.tokenProvider.(ScopeProvider)
+	if !ok {
+		return nil
+	}
+	return auth.CheckScopes(sp.GrantedScopes(), operation, chatWriteScopes...)
+}
+
 // Say sends a message to the chat.
 func (c *ChatBotClient) Say(ctx context.Context, message string) error {
-	if !c.IsConnected() {
-		return ErrNotRunning
+	if err := c.checkScopes("liveChatMessages.insert"); err != nil {
+		return err
 	}
-	_, err := c.poller.SendMessage(ctx, message)
+	if err := c.send(ctx, message); err != nil {
+		return err
+	}
+	c.lastManualSend.Store(time.Now().UnixNano())
+	return nil
+}
+
+// send posts a message as an in-flight action.
+func (c *ChatBotClient) send(ctx context.Context, message string) error {
+	done, err := c.beginAction(true)
+	if err != nil {
+		return err
+	}
+	defer done()
+	_, err = c.poller.SendMessage(ctx, message)
+	c.logActionError("say", err)
 	return err
 }
 
 // Delete deletes a message from the chat.
 func (c *ChatBotClient) Delete(ctx context.Context, messageID string) error {
-	if !c.IsConnected() {
-		return ErrNotRunning
+	if err := c.checkScopes("liveChatMessages.delete"); err != nil {
+		return err
 	}
-	return c.poller.DeleteMessage(ctx, messageID)
+	done, err := c.beginAction(false)
+	if err != nil {
+		return err
+	}
+	defer done()
+	err = c.poller.DeleteMessage(ctx, messageID)
+	c.logActionError("delete", err)
+	return err
 }
 
 // Ban permanently bans a user from the chat.
 func (c *ChatBotClient) Ban(ctx context.Context, channelID string) error {
-	if !c.IsConnected() {
-		return ErrNotRunning
+	if err := c.checkScopes("liveChatBans.insert"); err != nil {
+		return err
 	}
-	_, err := c.poller.BanUser(ctx, channelID)
+	done, err := c.beginAction(false)
+	if err != nil {
+		return err
+	}
+	defer done()
+	_, err = c.poller.BanUser(ctx, channelID)
+	if err == nil {
+		c.recordOwnBan(channelID)
+	}
+	c.logActionError("ban", err)
 	return err
 }
 
 // Timeout temporarily bans a user from the chat.
 func (c *ChatBotClient) Timeout(ctx context.Context, channelID string, seconds int) error {
-	if !c.IsConnected() {
-		return ErrNotRunning
+	if err := c.checkScopes("liveChatBans.insert"); err != nil {
+		return err
 	}
+	done, err := c.beginAction(false)
+	if err != nil {
+		return err
+	}
+	defer done()
 	if seconds <= 0 {
 		return fmt.Errorf("timeout duration must be positive")
 	}
-	_, err := c.poller.TimeoutUser(ctx, channelID, int64(seconds))
+	_, err = c.poller.TimeoutUser(ctx, channelID, int64(seconds))
+	if err == nil {
+		c.recordOwnBan(channelID)
+	}
+	c.logActionError("timeout", err)
 	return err
 }
 
 // Unban removes a ban from the chat.
 func (c *ChatBotClient) Unban(ctx context.Context, banID string) error {
-	if !c.IsConnected() {
-		return ErrNotRunning
+	if err := c.checkScopes("liveChatBans.delete"); err != nil {
+		return err
 	}
-	return c.poller.UnbanUser(ctx, banID)
+	done, err := c.beginAction(false)
+	if err != nil {
+		return err
+	}
+	defer done()
+	err = c.poller.UnbanUser(ctx, banID)
+	c.logActionError("unban", err)
+	return err
 }
 
-// AddModerator adds a moderator to the chat.
+// AddModerator adds a moderator to the chat and notifies the
+// OnModeratorAdded handlers.
 func (c *ChatBotClient) AddModerator(ctx context.Context, channelID string) error {
-	if !c.IsConnected() {
-		return ErrNotRunning
+	if err := c.checkScopes("liveChatModerators.insert"); err != nil {
+		return err
 	}
-	_, err := c.poller.AddModerator(ctx, channelID)
-	return err
+	done, err := c.beginAction(false)
+	if err != nil {
+		return err
+	}
+	defer done()
+	mod, err := c.poller.AddModerator(ctx, channelID)
+	if err != nil {
+		c.logActionError("add moderator", err)
+		return err
+	}
+	c.moderatorAdded(mod, channelID)
+	return nil
 }
 
-// RemoveModerator removes a moderator from the chat.
+// RemoveModerator removes a moderator from the chat by moderator entry ID
+// and notifies the OnModeratorRemoved handlers.
 func (c *ChatBotClient) RemoveModerator(ctx context.Context, moderatorID string) error {
-	if !c.IsConnected() {
-		return ErrNotRunning
+	if err := c.checkScopes("liveChatModerators.delete"); err != nil {
+		return err
+	}
+	done, err := c.beginAction(false)
+	if err != nil {
+		return err
+	}
+	defer done()
+	if err := c.poller.RemoveModerator(ctx, moderatorID); err != nil {
+		c.logActionError("remove moderator", err)
+		return err
+	}
+	c.moderatorRemoved(moderatorID)
+	return nil
+}
+
+// SetSlowMode enables slow mode, requiring viewers to wait delayMs
+// milliseconds between messages. Costs 50 quota units.
+func (c *ChatBotClient) SetSlowMode(ctx context.Context, delayMs int) error {
+	if delayMs <= 0 {
+		return fmt.Errorf("slow mode delay must be positive")
 	}
-	return c.poller.RemoveModerator(ctx, moderatorID)
+	return c.transitionChatMode(ctx, ChatModeSlowMode, int64(delayMs))
+}
+
+// SetSubscribersOnly turns subscribers-only mode on or off. Turning it off
+// transitions the chat to normal mode, which also clears any other
+// restriction. Costs 50 quota units.
+func (c *ChatBotClient) SetSubscribersOnly(ctx context.Context, on bool) error {
+	if !on {
+		return c.SetNormalMode(ctx)
+	}
+	return c.transitionChatMode(ctx, ChatModeSubscribersOnly, 0)
+}
+
+// SetMembersOnly turns members-only mode on or off. Turning it off
+// transitions the chat to normal mode, which also clears any other
+// restriction. Costs 50 quota units.
+func (c *ChatBotClient) SetMembersOnly(ctx context.Context, on bool) error {
+	if !on {
+		return c.SetNormalMode(ctx)
+	}
+	return c.transitionChatMode(ctx, ChatModeMembersOnly, 0)
+}
+
+// SetNormalMode removes slow, subscribers-only, and members-only
+// restrictions. Costs 50 quota units.
+func (c *ChatBotClient) SetNormalMode(ctx context.Context) error {
+	return c.transitionChatMode(ctx, ChatModeNormal, 0)
+}
+
+// transitionChatMode changes the chat mode as an in-flight action.
+func (c *ChatBotClient) transitionChatMode(ctx context.Context, mode string, delayMs int64) error {
+	if err := c.checkScopes("liveChatMessages.transition"); err != nil {
+		return err
+	}
+	done, err := c.beginAction(false)
+	if err != nil {
+		return err
+	}
+	defer done()
+	err = c.poller.TransitionChatModeWithDelay(ctx, mode, delayMs)
+	c.logActionError("set chat mode", err)
+	return err
 }
 
 // OnMessage registers a handler for chat messages.
@@ -707,6 +1124,42 @@ func (c *ChatBotClient) OnMessageDeleted(fn func(string)) func() {
 	}
 }
 
+// OnMessageDeletedEvent registers a handler for message deletion events
+// that need more than the message ID. When the deleted message is in the
+// history buffer (see WithHistoryBuffer), the event includes its author and
+// text, so a moderation log can record whose message was removed:
+//
+//	bot.OnMessageDeletedEvent(func(e *streaming.MessageDeletedEvent) {
+//		if e.Author != nil {
+//			log.Printf("%s's message %q was deleted", e.Author.DisplayName, e.Message)
+//			return
+//		}
+//		log.Printf("message %s was deleted", e.MessageID)
+//	})
+//
+// Otherwise only MessageID is set.
+func (c *ChatBotClient) OnMessageDeletedEvent(fn func(*MessageDeletedEvent)) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h := &deletionHandler{fn: fn}
+	c.deletionHandlers = append(c.deletionHandlers, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			for i, handler := range c.deletionHandlers {
+				if handler == h {
+					c.deletionHandlers = slices.Delete(c.deletionHandlers, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
 // OnUserBanned registers a handler for user ban events.
 func (c *ChatBotClient) OnUserBanned(fn func(*BanEvent)) func() {
 	c.mu.Lock()
@@ -776,7 +1229,8 @@ func (c *ChatBotClient) OnDisconnect(fn func()) func() {
 	}
 }
 
-// OnError registers a handler for errors.
+// OnError registers a handler for errors. Failed polls are reported as a
+// *PollError (see LiveChatPoller.OnError).
 func (c *ChatBotClient) OnError(fn func(error)) func() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -810,10 +1264,11 @@ func (c *ChatBotClient) dispatchChatMessage(msg *LiveChatMessage) {
 	chatMsg := &ChatMessage{
 		ID:          msg.ID,
 		Message:     msg.Message(),
-		Author:      parseAuthor(msg.AuthorDetails),
+		Author:      c.author(msg.AuthorDetails),
 		PublishedAt: msg.Snippet.PublishedAt,
 		Raw:         msg,
 	}
+	c.rememberMessage(chatMsg)
 
 	for _, h := range handlers {
 		c.safeCall(func() { h.fn(chatMsg) })
@@ -833,7 +1288,7 @@ func (c *ChatBotClient) dispatchSuperChat(msg *LiveChatMessage) {
 	sc := msg.Snippet.SuperChatDetails
 	event := &SuperChatEvent{
 		ID:           msg.ID,
-		Author:       parseAuthor(msg.AuthorDetails),
+		Author:       c.author(msg.AuthorDetails),
 		Message:      sc.UserComment,
 		Amount:       sc.AmountDisplayString,
 		AmountMicros: sc.AmountMicros,
@@ -860,7 +1315,7 @@ func (c *ChatBotClient) dispatchSuperSticker(msg *LiveChatMessage) {
 	ss := msg.Snippet.SuperStickerDetails
 	event := &SuperStickerEvent{
 		ID:           msg.ID,
-		Author:       parseAuthor(msg.AuthorDetails),
+		Author:       c.author(msg.AuthorDetails),
 		StickerID:    ss.SuperStickerID,
 		Amount:       ss.AmountDisplayString,
 		AmountMicros: ss.AmountMicros,
@@ -891,7 +1346,7 @@ func (c *ChatBotClient) dispatchMembership(msg *LiveChatMessage) {
 	ns := msg.Snippet.NewSponsorDetails
 	event := &MembershipEvent{
 		ID:        msg.ID,
-		Author:    parseAuthor(msg.AuthorDetails),
+		Author:    c.author(msg.AuthorDetails),
 		LevelName: ns.MemberLevelName,
 		IsUpgrade: ns.IsUpgrade,
 		Raw:       msg,
@@ -915,7 +1370,7 @@ func (c *ChatBotClient) dispatchMemberMilestone(msg *LiveChatMessage) {
 	ms := msg.Snippet.MemberMilestoneChatDetails
 	event := &MemberMilestoneEvent{
 		ID:        msg.ID,
-		Author:    parseAuthor(msg.AuthorDetails),
+		Author:    c.author(msg.AuthorDetails),
 		Message:   ms.UserComment,
 		LevelName: ms.MemberLevelName,
 		Months:    ms.MemberMonth,
@@ -940,7 +1395,7 @@ func (c *ChatBotClient) dispatchGiftMembership(msg *LiveChatMessage) {
 	gm := msg.Snippet.MembershipGiftingDetails
 	event := &GiftMembershipEvent{
 		ID:        msg.ID,
-		Author:    parseAuthor(msg.AuthorDetails),
+		Author:    c.author(msg.AuthorDetails),
 		LevelName: gm.MemberLevelName,
 		Count:     gm.GiftMembershipsCount,
 		Raw:       msg,
@@ -964,7 +1419,7 @@ func (c *ChatBotClient) dispatchGiftMembershipReceived(msg *LiveChatMessage) {
 	gr := msg.Snippet.GiftMembershipReceivedDetails
 	event := &GiftMembershipReceivedEvent{
 		ID:                         msg.ID,
-		Author:                     parseAuthor(msg.AuthorDetails),
+		Author:                     c.author(msg.AuthorDetails),
 		LevelName:                  gr.MemberLevelName,
 		GifterChannelID:            gr.GifterChannelID,
 		AssociatedGiftingMessageID: gr.AssociatedMembershipGiftingMessageID,
@@ -976,21 +1431,38 @@ func (c *ChatBotClient) dispatchGiftMembershipReceived(msg *LiveChatMessage) {
 	}
 }
 
-func (c *ChatBotClient) dispatchMessageDeleted(id string) {
+func (c *ChatBotClient) dispatchMessageDeleted(id string, original *ChatMessage) {
 	c.mu.RLock()
 	handlers := make([]*messageDeletedHandler, len(c.messageDeletedHandlers))
 	copy(handlers, c.messageDeletedHandlers)
+	eventHandlers := make([]*deletionHandler, len(c.deletionHandlers))
+	copy(eventHandlers, c.deletionHandlers)
 	c.mu.RUnlock()
 
+	c.logDeletion(id, original)
 	for _, h := range handlers {
 		c.safeCall(func() { h.fn(id) })
 	}
+
+	if len(eventHandlers) == 0 {
+		return
+	}
+	event := &MessageDeletedEvent{MessageID: id}
+	if original != nil {
+		event.Author = original.Author
+		event.Message = original.Message
+		event.Original = original
+	}
+	for _, h := range eventHandlers {
+		c.safeCall(func() { h.fn(event) })
+	}
 }
 
-func (c *ChatBotClient) dispatchUserBanned(details *UserBannedDetails) {
-	if details == nil {
+func (c *ChatBotClient) dispatchUserBanned(msg *LiveChatMessage) {
+	if msg == nil || msg.Snippet == nil || msg.Snippet.UserBannedDetails == nil {
 		return
 	}
+	details := msg.Snippet.UserBannedDetails
 
 	c.mu.RLock()
 	handlers := make([]*userBannedHandler, len(c.userBannedHandlers))
@@ -1009,8 +1481,16 @@ func (c *ChatBotClient) dispatchUserBanned(details *UserBannedDetails) {
 			DisplayName:     details.BannedUserDetails.DisplayName,
 			ProfileImageURL: details.BannedUserDetails.ProfileImageURL,
 		}
+		event.ByBot = c.takeOwnBan(details.BannedUserDetails.ChannelID)
+	}
+
+	event.ModeratorChannelID = msg.Snippet.AuthorChannelID
+	if msg.AuthorDetails != nil && msg.AuthorDetails.ChannelID != "" {
+		event.Moderator = parseAuthor(msg.AuthorDetails)
+		event.ModeratorChannelID = msg.AuthorDetails.ChannelID
 	}
 
+	c.logBan(event)
 	for _, h := range handlers {
 		c.safeCall(func() { h.fn(event) })
 	}
@@ -1022,6 +1502,7 @@ func (c *ChatBotClient) dispatchConnect() {
 	copy(handlers, c.connectHandlers)
 	c.mu.RUnlock()
 
+	c.log(slog.LevelInfo, "connected", slog.String("live_chat_id", c.liveChatID))
 	for _, h := range handlers {
 		c.safeCall(func() { h.fn() })
 	}
@@ -1033,6 +1514,7 @@ func (c *ChatBotClient) dispatchDisconnect() {
 	copy(handlers, c.disconnectHandlers)
 	c.mu.RUnlock()
 
+	c.log(slog.LevelInfo, "disconnected", slog.String("live_chat_id", c.liveChatID))
 	for _, h := range handlers {
 		c.safeCall(func() { h.fn() })
 	}
@@ -1044,9 +1526,15 @@ func (c *ChatBotClient) dispatchError(err error) {
 	copy(handlers, c.errorHandlers)
 	c.mu.RUnlock()
 
+	c.logError(err)
 	for _, h := range handlers {
 		func() {
-			defer func() { _ = recover() }() // Silently ignore panic in error handler
+			defer func() {
+				// Log, but don't dispatch, a panic in an error handler
+				if r := recover(); r != nil {
+					c.log(slog.LevelError, "error handler panic", slog.Any("panic", r))
+				}
+			}()
 			h.fn(err)
 		}()
 	}
@@ -1062,6 +1550,139 @@ func (c *ChatBotClient) safeCall(fn func()) {
 	fn()
 }
 
+// recordMembership stores membership tenure and level from membership events.
+func (c *ChatBotClient) recordMembership(msg *LiveChatMessage) {
+	if msg.AuthorDetails == nil || msg.AuthorDetails.ChannelID == "" {
+		return
+	}
+
+	c.memberMu.Lock()
+	defer c.memberMu.Unlock()
+
+	id := msg.AuthorDetails.ChannelID
+	info := c.members[id]
+	switch {
+	case msg.Snippet.MemberMilestoneChatDetails != nil:
+		info.months = msg.Snippet.MemberMilestoneChatDetails.MemberMonth
+		info.levelName = msg.Snippet.MemberMilestoneChatDetails.MemberLevelName
+	case msg.Snippet.NewSponsorDetails != nil:
+		info.levelName = msg.Snippet.NewSponsorDetails.MemberLevelName
+	case msg.Snippet.GiftMembershipReceivedDetails != nil:
+		info.levelName = msg.Snippet.GiftMembershipReceivedDetails.MemberLevelName
+	default:
+		return
+	}
+	c.members[id] = info
+}
+
+// rememberMessage adds a chat message to the history buffer, if enabled.
+func (c *ChatBotClient) rememberMessage(msg *ChatMessage) {
+	if c.history == nil {
+		return
+	}
+	// Store a copy so handlers that modify msg don't race with readers.
+	msg = cloneChatMessage(msg)
+	c.historyMu.Lock()
+	c.history.add(msg)
+	c.historyMu.Unlock()
+}
+
+// forgetMessage removes a deleted message from the history buffer, if
+// enabled, and returns it. Returns nil if the message was not buffered.
+func (c *ChatBotClient) forgetMessage(id string) *ChatMessage {
+	if c.history == nil {
+		return nil
+	}
+	c.historyMu.Lock()
+	removed := c.history.remove(id)
+	c.historyMu.Unlock()
+	return removed
+}
+
+// RecentMessages returns the buffered chat messages from oldest to newest.
+// Messages deleted by moderators are removed from the buffer.
+// Returns nil unless the client was created with WithHistoryBuffer.
+//
+// The returned messages are copies and may be modified freely; the Raw
+// field still points at the shared LiveChatMessage and should be treated
+// as read-only.
+func (c *ChatBotClient) RecentMessages() []*ChatMessage {
+	if c.history == nil {
+		return nil
+	}
+
+	c.historyMu.RLock()
+	msgs := c.history.messages()
+	c.historyMu.RUnlock()
+
+	for i, m := range msgs {
+		msgs[i] = cloneChatMessage(m)
+	}
+	return msgs
+}
+
+// cloneChatMessage copies a chat message and its author.
+func cloneChatMessage(m *ChatMessage) *ChatMessage {
+	cp := *m
+	if m.Author != nil {
+		author := *m.Author
+		cp.Author = &author
+	}
+	return &cp
+}
+
+// author parses author details and adds any observed membership data.
+func (c *ChatBotClient) author(ad *AuthorDetails) *Author {
+	a := parseAuthor(ad)
+	if a == nil {
+		return nil
+	}
+
+	c.memberMu.RLock()
+	info, ok := c.members[a.ChannelID]
+	c.memberMu.RUnlock()
+	if ok {
+		a.MemberMonths = info.months
+		a.MemberLevelName = info.levelName
+	}
+	return a
+}
+
+// ownBanWindow is how long a ban issued by the bot is remembered while
+// waiting for its ban event, so the event can be marked ByBot.
+const ownBanWindow = 5 * time.Minute
+
+// recordOwnBan remembers that the bot banned channelID.
+func (c *ChatBotClient) recordOwnBan(channelID string) {
+	c.ownBansMu.Lock()
+	defer c.ownBansMu.Unlock()
+
+	now := time.Now()
+	if c.ownBans == nil {
+		c.ownBans = make(map[string]time.Time)
+	}
+	for id, expires := range c.ownBans {
+		if now.After(expires) {
+			delete(c.ownBans, id)
+		}
+	}
+	c.ownBans[channelID] = now.Add(ownBanWindow)
+}
+
+// takeOwnBan reports whether the bot recently banned channelID, forgetting
+// the ban so later bans by moderators are not misattributed.
+func (c *ChatBotClient) takeOwnBan(channelID string) bool {
+	c.ownBansMu.Lock()
+	defer c.ownBansMu.Unlock()
+
+	expires, ok := c.ownBans[channelID]
+	if !ok {
+		return false
+	}
+	delete(c.ownBans, channelID)
+	return time.Now().Before(expires)
+}
+
 // parseAuthor converts AuthorDetails to Author.
 func parseAuthor(ad *AuthorDetails) *Author {
 	if ad == nil {
@@ -3,13 +3,17 @@ package streaming
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/Its-donkey/yougopher/youtube/auth"
 	"github.com/Its-donkey/yougopher/youtube/core"
 )
 
@@ -738,6 +742,81 @@ func TestChatBotClient_OnUserBanned(t *testing.T) {
 	_ = bot.Close()
 }
 
+func TestChatBotClient_BanEventAttribution(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/liveChat/bans" {
+			_ = json.NewEncoder(w).Encode(LiveChatBan{ID: "ban1"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 5000})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	bot, _ := NewChatBotClient(client, nil, "chat123")
+
+	var events []*BanEvent
+	bot.OnUserBanned(func(event *BanEvent) { events = append(events, event) })
+
+	ctx := context.Background()
+	_ = bot.Connect(ctx)
+	defer func() { _ = bot.Close() }()
+
+	if err := bot.Ban(ctx, "UCauto"); err != nil {
+		t.Fatalf("Ban() error = %v", err)
+	}
+
+	banMessage := func(bannedID string, author *AuthorDetails, authorChannelID string) *LiveChatMessage {
+		return &LiveChatMessage{
+			Snippet: &MessageSnippet{
+				Type:            MessageTypeUserBanned,
+				AuthorChannelID: authorChannelID,
+				UserBannedDetails: &UserBannedDetails{
+					BanType:           BanTypePermanent,
+					BannedUserDetails: &BannedUserDetails{ChannelID: bannedID},
+				},
+			},
+			AuthorDetails: author,
+		}
+	}
+	owner := &AuthorDetails{ChannelID: "UCowner", DisplayName: "Owner", IsChatOwner: true}
+
+	tests := []struct {
+		name          string
+		msg           *LiveChatMessage
+		wantModerator string
+		wantDetails   bool
+		wantByBot     bool
+	}{
+		{"bot-issued ban", banMessage("UCauto", owner, "UCowner"), "UCowner", true, true},
+		{"repeat ban is not attributed to the bot", banMessage("UCauto", owner, "UCowner"), "UCowner", true, false},
+		{"moderator ban", banMessage("UCother", &AuthorDetails{ChannelID: "UCmod", IsChatModerator: true}, "UCmod"), "UCmod", true, false},
+		{"author channel ID only", banMessage("UCother", nil, "UCmod"), "UCmod", false, false},
+		{"no author", banMessage("UCother", nil, ""), "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events = nil
+			bot.dispatchUserBanned(tt.msg)
+			if len(events) != 1 {
+				t.Fatalf("got %d events, want 1", len(events))
+			}
+			got := events[0]
+			if got.ModeratorChannelID != tt.wantModerator {
+				t.Errorf("ModeratorChannelID = %q, want %q", got.ModeratorChannelID, tt.wantModerator)
+			}
+			if (got.Moderator != nil) != tt.wantDetails {
+				t.Errorf("Moderator = %+v, want details %v", got.Moderator, tt.wantDetails)
+			}
+			if got.ByBot != tt.wantByBot {
+				t.Errorf("ByBot = %v, want %v", got.ByBot, tt.wantByBot)
+			}
+		})
+	}
+}
+
 func TestChatBotClient_OnConnectDisconnect(t *testing.T) {
 	var connectCalled atomic.Bool
 	var disconnectCalled atomic.Bool
@@ -924,6 +1003,82 @@ func TestChatBotClient_RemoveModerator_NotConnected(t *testing.T) {
 	}
 }
 
+// scopedTokenProvider implements TokenProvider and ScopeProvider for testing.
+type scopedTokenProvider struct {
+	mockTokenProvider
+	scopes []string
+}
+
+func (m *scopedTokenProvider) GrantedScopes() []string {
+	return m.scopes
+}
+
+func TestChatBotClient_ScopeValidation(t *testing.T) {
+	readOnly := &scopedTokenProvider{
+		mockTokenProvider: mockTokenProvider{token: "test-token"},
+		scopes:            []string{auth.ScopeReadOnly},
+	}
+
+	actions := []struct {
+		name      string
+		operation string
+		call      func(*ChatBotClient) error
+	}{
+		{"Say", "liveChatMessages.insert", func(b *ChatBotClient) error { return b.Say(context.Background(), "hi") }},
+		{"Delete", "liveChatMessages.delete", func(b *ChatBotClient) error { return b.Delete(context.Background(), "msg123") }},
+		{"Ban", "liveChatBans.insert", func(b *ChatBotClient) error { return b.Ban(context.Background(), "channel123") }},
+		{"Timeout", "liveChatBans.insert", func(b *ChatBotClient) error { return b.Timeout(context.Background(), "channel123", 60) }},
+		{"Unban", "liveChatBans.delete", func(b *ChatBotClient) error { return b.Unban(context.Background(), "ban123") }},
+		{"AddModerator", "liveChatModerators.insert", func(b *ChatBotClient) error { return b.AddModerator(context.Background(), "channel123") }},
+		{"RemoveModerator", "liveChatModerators.delete", func(b *ChatBotClient) error { return b.RemoveModerator(context.Background(), "mod123") }},
+		{"SetNormalMode", "liveChatMessages.transition", func(b *ChatBotClient) error { return b.SetNormalMode(context.Background()) }},
+	}
+
+	for _, tt := range actions {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, _ := NewChatBotClient(core.NewClient(), readOnly, "chat123")
+
+			err := tt.call(bot)
+			var scopeErr *auth.InsufficientScopeError
+			if !errors.As(err, &scopeErr) {
+				t.Fatalf("%s() error = %v, want *auth.InsufficientScopeError", tt.name, err)
+			}
+			if scopeErr.Operation != tt.operation {
+				t.Errorf("Operation = %q, want %q", scopeErr.Operation, tt.operation)
+			}
+		})
+	}
+
+	t.Run("sufficient scope", func(t *testing.T) {
+		tp := &scopedTokenProvider{
+			mockTokenProvider: mockTokenProvider{token: "test-token"},
+			scopes:            []string{auth.ScopeLiveChatModerate},
+		}
+		bot, _ := NewChatBotClient(core.NewClient(), tp, "chat123")
+
+		if err := bot.Ban(context.Background(), "channel123"); err != ErrNotRunning {
+			t.Errorf("Ban() error = %v, want ErrNotRunning", err)
+		}
+	})
+
+	t.Run("unknown scopes", func(t *testing.T) {
+		tp := &scopedTokenProvider{mockTokenProvider: mockTokenProvider{token: "test-token"}}
+		bot, _ := NewChatBotClient(core.NewClient(), tp, "chat123")
+
+		if err := bot.Ban(context.Background(), "channel123"); err != ErrNotRunning {
+			t.Errorf("Ban() error = %v, want ErrNotRunning", err)
+		}
+	})
+
+	t.Run("validation disabled", func(t *testing.T) {
+		bot, _ := NewChatBotClient(core.NewClient(), readOnly, "chat123", WithScopeValidation(false))
+
+		if err := bot.Ban(context.Background(), "channel123"); err != ErrNotRunning {
+			t.Errorf("Ban() error = %v, want ErrNotRunning", err)
+		}
+	})
+}
+
 func TestChatBotClient_HandlerUnsubscribe(t *testing.T) {
 	client := core.NewClient()
 	bot, _ := NewChatBotClient(client, nil, "chat123")
@@ -1002,6 +1157,245 @@ func TestChatBotClient_HandleMessageNilSnippet(t *testing.T) {
 	}
 }
 
+func TestChatBotClient_RecentMessages(t *testing.T) {
+	text := func(id string) *LiveChatMessage {
+		return &LiveChatMessage{
+			ID:            id,
+			Snippet:       &MessageSnippet{Type: MessageTypeText, DisplayMessage: "msg " + id},
+			AuthorDetails: &AuthorDetails{ChannelID: "user1", DisplayName: "User"},
+		}
+	}
+	ids := func(msgs []*ChatMessage) []string {
+		var out []string
+		for _, m := range msgs {
+			out = append(out, m.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		size     int
+		received []string
+		deleted  []string
+		want     []string
+	}{
+		{"disabled", 0, []string{"1", "2"}, nil, nil},
+		{"partially filled", 3, []string{"1", "2"}, nil, []string{"1", "2"}},
+		{"wraps around", 3, []string{"1", "2", "3", "4", "5"}, nil, []string{"3", "4", "5"}},
+		{"deleted message removed", 3, []string{"1", "2", "3", "4"}, []string{"3"}, []string{"2", "4"}},
+		{"add after delete", 3, []string{"1", "2", "3", "4", "5"}, []string{"4"}, []string{"3", "5"}},
+		{"unknown delete", 2, []string{"1", "2"}, []string{"9"}, []string{"1", "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123", WithHistoryBuffer(tt.size))
+			for _, id := range tt.received {
+				bot.handleMessage(text(id))
+			}
+			for _, id := range tt.deleted {
+				bot.forgetMessage(id)
+			}
+
+			got := ids(bot.RecentMessages())
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("RecentMessages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("add after delete keeps capacity", func(t *testing.T) {
+		bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123", WithHistoryBuffer(3))
+		for _, id := range []string{"1", "2", "3"} {
+			bot.handleMessage(text(id))
+		}
+		bot.forgetMessage("2")
+		bot.handleMessage(text("4"))
+		bot.handleMessage(text("5"))
+
+		if got := ids(bot.RecentMessages()); !slices.Equal(got, []string{"3", "4", "5"}) {
+			t.Errorf("RecentMessages() = %v, want [3 4 5]", got)
+		}
+	})
+
+	t.Run("returns copies", func(t *testing.T) {
+		bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123", WithHistoryBuffer(2))
+		bot.OnMessage(func(msg *ChatMessage) { msg.Message = "changed by handler" })
+		bot.handleMessage(text("1"))
+
+		first := bot.RecentMessages()
+		first[0].Message = "changed"
+		first[0].Author.DisplayName = "changed"
+
+		again := bot.RecentMessages()
+		if again[0].Message != "msg 1" {
+			t.Errorf("Message = %q, want 'msg 1'", again[0].Message)
+		}
+		if again[0].Author.DisplayName != "User" {
+			t.Errorf("DisplayName = %q, want 'User'", again[0].Author.DisplayName)
+		}
+	})
+
+	t.Run("concurrent access", func(t *testing.T) {
+		bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123", WithHistoryBuffer(10))
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				bot.handleMessage(text(fmt.Sprint(i)))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				_ = bot.RecentMessages()
+			}
+		}()
+		wg.Wait()
+
+		if n := len(bot.RecentMessages()); n != 10 {
+			t.Errorf("len(RecentMessages()) = %d, want 10", n)
+		}
+	})
+}
+
+func TestChatBotClient_OnMessageDeletedEvent(t *testing.T) {
+	text := &LiveChatMessage{
+		ID:            "msg1",
+		Snippet:       &MessageSnippet{Type: MessageTypeText, DisplayMessage: "spam link"},
+		AuthorDetails: &AuthorDetails{ChannelID: "user1", DisplayName: "Spammer"},
+	}
+
+	tests := []struct {
+		name       string
+		history    int
+		deletedID  string
+		wantAuthor string // "" for no author
+		wantText   string
+	}{
+		{"buffered", 10, "msg1", "Spammer", "spam link"},
+		{"not buffered", 10, "other", "", ""},
+		{"history disabled", 0, "msg1", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123", WithHistoryBuffer(tt.history))
+
+			var events []*MessageDeletedEvent
+			var ids []string
+			bot.OnMessageDeletedEvent(func(e *MessageDeletedEvent) { events = append(events, e) })
+			bot.OnMessageDeleted(func(id string) { ids = append(ids, id) })
+
+			bot.handleMessage(text)
+			bot.dispatchMessageDeleted(tt.deletedID, bot.forgetMessage(tt.deletedID))
+
+			if len(ids) != 1 || ids[0] != tt.deletedID {
+				t.Errorf("OnMessageDeleted ids = %v, want [%s]", ids, tt.deletedID)
+			}
+			if len(events) != 1 {
+				t.Fatalf("got %d events, want 1", len(events))
+			}
+			e := events[0]
+			if e.MessageID != tt.deletedID {
+				t.Errorf("MessageID = %q, want %q", e.MessageID, tt.deletedID)
+			}
+			if e.Message != tt.wantText {
+				t.Errorf("Message = %q, want %q", e.Message, tt.wantText)
+			}
+			if tt.wantAuthor == "" {
+				if e.Author != nil || e.Original != nil {
+					t.Errorf("Author = %v, Original = %v, want nil", e.Author, e.Original)
+				}
+				return
+			}
+			if e.Author == nil || e.Author.DisplayName != tt.wantAuthor {
+				t.Errorf("Author = %v, want %s", e.Author, tt.wantAuthor)
+			}
+			if e.Original == nil || e.Original.ID != tt.deletedID {
+				t.Errorf("Original = %v, want message %s", e.Original, tt.deletedID)
+			}
+		})
+	}
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+		calls := 0
+		unsub := bot.OnMessageDeletedEvent(func(e *MessageDeletedEvent) { calls++ })
+		unsub()
+		unsub()
+
+		bot.dispatchMessageDeleted("msg1", nil)
+		if calls != 0 {
+			t.Errorf("calls = %d after unsubscribe, want 0", calls)
+		}
+	})
+}
+
+func TestChatBotClient_AuthorMembership(t *testing.T) {
+	bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+
+	var got []*Author
+	bot.OnMessage(func(msg *ChatMessage) { got = append(got, msg.Author) })
+
+	text := func(channelID string) *LiveChatMessage {
+		return &LiveChatMessage{
+			Snippet:       &MessageSnippet{Type: MessageTypeText, DisplayMessage: "hi"},
+			AuthorDetails: &AuthorDetails{ChannelID: channelID, IsChatSponsor: true},
+		}
+	}
+
+	bot.handleMessage(text("member1"))
+	bot.handleMessage(&LiveChatMessage{
+		Snippet: &MessageSnippet{
+			Type: MessageTypeMembership,
+			NewSponsorDetails: &NewSponsorDetails{
+				MemberLevelName: "Silver",
+			},
+		},
+		AuthorDetails: &AuthorDetails{ChannelID: "member1"},
+	})
+	bot.handleMessage(text("member1"))
+	bot.handleMessage(&LiveChatMessage{
+		Snippet: &MessageSnippet{
+			Type: MessageTypeMemberMilestone,
+			MemberMilestoneChatDetails: &MemberMilestoneChatDetails{
+				MemberLevelName: "Gold",
+				MemberMonth:     14,
+			},
+		},
+		AuthorDetails: &AuthorDetails{ChannelID: "member1"},
+	})
+	bot.handleMessage(text("member1"))
+	bot.handleMessage(text("other"))
+
+	tests := []struct {
+		name       string
+		author     *Author
+		wantMonths int
+		wantLevel  string
+	}{
+		{"before any membership event", got[0], 0, ""},
+		{"after new membership", got[1], 0, "Silver"},
+		{"after milestone", got[2], 14, "Gold"},
+		{"unrelated author", got[3], 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.author.MemberMonths != tt.wantMonths {
+				t.Errorf("MemberMonths = %d, want %d", tt.author.MemberMonths, tt.wantMonths)
+			}
+			if tt.author.MemberLevelName != tt.wantLevel {
+				t.Errorf("MemberLevelName = %q, want %q", tt.author.MemberLevelName, tt.wantLevel)
+			}
+		})
+	}
+}
+
 func TestParseAuthor(t *testing.T) {
 	t.Run("nil input", func(t *testing.T) {
 		author := parseAuthor(nil)
@@ -1066,6 +1460,124 @@ func TestChatBotClient_Close_Idempotent(t *testing.T) {
 	_ = bot.Close()
 }
 
+func TestChatBotClient_Shutdown(t *testing.T) {
+	newServer := func(sendDelay time.Duration, sent *atomic.Int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/liveChat/messages" && r.Method == http.MethodPost {
+				select {
+				case <-time.After(sendDelay):
+				case <-r.Context().Done():
+					return
+				}
+				sent.Add(1)
+				_ = json.NewEncoder(w).Encode(LiveChatMessage{ID: "sent123"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+				PollingIntervalMillis: 5000,
+				Items:                 []*LiveChatMessage{},
+			})
+		}))
+	}
+
+	t.Run("drains pending sends", func(t *testing.T) {
+		var sent atomic.Int32
+		server := newServer(50*time.Millisecond, &sent)
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		bot, _ := NewChatBotClient(client, nil, "chat123")
+		_ = bot.Connect(context.Background())
+
+		sayDone := make(chan error, 1)
+		go func() { sayDone <- bot.Say(context.Background(), "Stream ending") }()
+		time.Sleep(10 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := bot.Shutdown(ctx); err != nil {
+			t.Fatalf("Shutdown() error = %v", err)
+		}
+		if err := <-sayDone; err != nil {
+			t.Errorf("Say() error = %v", err)
+		}
+		if sent.Load() != 1 {
+			t.Errorf("sent = %d, want 1", sent.Load())
+		}
+		if bot.IsConnected() {
+			t.Error("bot should not be connected after Shutdown")
+		}
+	})
+
+	t.Run("rejects new actions", func(t *testing.T) {
+		var sent atomic.Int32
+		server := newServer(200*time.Millisecond, &sent)
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		bot, _ := NewChatBotClient(client, nil, "chat123")
+		_ = bot.Connect(context.Background())
+
+		go func() { _ = bot.Say(context.Background(), "slow") }()
+		time.Sleep(10 * time.Millisecond)
+
+		shutdownDone := make(chan struct{})
+		go func() {
+			_ = bot.Shutdown(context.Background())
+			close(shutdownDone)
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		if err := bot.Say(context.Background(), "late"); !errors.Is(err, ErrShuttingDown) {
+			t.Errorf("Say() error = %v, want ErrShuttingDown", err)
+		}
+		if err := bot.Ban(context.Background(), "user1"); !errors.Is(err, ErrShuttingDown) {
+			t.Errorf("Ban() error = %v, want ErrShuttingDown", err)
+		}
+		<-shutdownDone
+	})
+
+	t.Run("deadline reports undelivered", func(t *testing.T) {
+		var sent atomic.Int32
+		server := newServer(time.Second, &sent)
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		bot, _ := NewChatBotClient(client, nil, "chat123")
+		_ = bot.Connect(context.Background())
+
+		sayCtx, sayCancel := context.WithCancel(context.Background())
+		defer sayCancel()
+		for range 2 {
+			go func() { _ = bot.Say(sayCtx, "slow") }()
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := bot.Shutdown(ctx)
+		var shutdownErr *ShutdownError
+		if !errors.As(err, &shutdownErr) {
+			t.Fatalf("Shutdown() error = %v, want *ShutdownError", err)
+		}
+		if shutdownErr.Undelivered != 2 {
+			t.Errorf("Undelivered = %d, want 2", shutdownErr.Undelivered)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Shutdown() error should wrap context.DeadlineExceeded")
+		}
+	})
+
+	t.Run("not connected", func(t *testing.T) {
+		bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+		if err := bot.Shutdown(context.Background()); err != nil {
+			t.Errorf("Shutdown() error = %v", err)
+		}
+	})
+}
+
 func TestWithTokenRefreshInterval(t *testing.T) {
 	client := core.NewClient()
 
@@ -1095,6 +1607,75 @@ func TestWithTokenRefreshInterval(t *testing.T) {
 	})
 }
 
+func TestChatBotClient_ChatModes(t *testing.T) {
+	var mu sync.Mutex
+	var snippets []TransitionChatModeSnippet
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/liveChat/messages/transition" && r.Method == http.MethodPost {
+			var req TransitionChatModeRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			mu.Lock()
+			snippets = append(snippets, *req.Snippet)
+			mu.Unlock()
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 5000})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	bot, _ := NewChatBotClient(client, nil, "chat123")
+	ctx := context.Background()
+	_ = bot.Connect(ctx)
+	defer func() { _ = bot.Close() }()
+	time.Sleep(20 * time.Millisecond)
+
+	tests := []struct {
+		name    string
+		call    func() error
+		want    string
+		delayMs int64
+	}{
+		{"slow mode", func() error { return bot.SetSlowMode(ctx, 5000) }, ChatModeSlowMode, 5000},
+		{"subscribers only on", func() error { return bot.SetSubscribersOnly(ctx, true) }, ChatModeSubscribersOnly, 0},
+		{"subscribers only off", func() error { return bot.SetSubscribersOnly(ctx, false) }, ChatModeNormal, 0},
+		{"members only on", func() error { return bot.SetMembersOnly(ctx, true) }, ChatModeMembersOnly, 0},
+		{"members only off", func() error { return bot.SetMembersOnly(ctx, false) }, ChatModeNormal, 0},
+		{"normal", func() error { return bot.SetNormalMode(ctx) }, ChatModeNormal, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			mu.Lock()
+			got := snippets[len(snippets)-1]
+			mu.Unlock()
+			if got.LiveChatID != "chat123" || got.Type != tt.want || got.SlowModeDelayMs != tt.delayMs {
+				t.Errorf("transition = %+v, want type %q delay %d", got, tt.want, tt.delayMs)
+			}
+		})
+	}
+
+	t.Run("invalid slow mode delay", func(t *testing.T) {
+		if err := bot.SetSlowMode(ctx, 0); err == nil {
+			t.Error("SetSlowMode(0) expected error")
+		}
+	})
+}
+
+func TestChatBotClient_ChatModes_NotConnected(t *testing.T) {
+	bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+
+	if err := bot.SetMembersOnly(context.Background(), true); err != ErrNotRunning {
+		t.Errorf("SetMembersOnly() error = %v, want ErrNotRunning", err)
+	}
+}
+
 func TestChatBotClient_Delete_Error(t *testing.T) {
 	t.Run("empty message ID", func(t *testing.T) {
 		client := core.NewClient()
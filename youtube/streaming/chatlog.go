@@ -0,0 +1,295 @@
+package streaming
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Chat log entry types.
+const (
+	ChatLogMessage   = "message"
+	ChatLogSuperChat = "superChat"
+	ChatLogBan       = "ban"
+)
+
+// DefaultChatLogBufferSize is the default write buffer size of a ChatLogger.
+const DefaultChatLogBufferSize = 4096
+
+// ChatLogEntry is one line of a chat log.
+type ChatLogEntry struct {
+	// Type is ChatLogMessage, ChatLogSuperChat, or ChatLogBan.
+	Type string `json:"type"`
+
+	// Timestamp is when the message was published, or when the event was
+	// logged if the API provides no publish time (always for bans).
+	Timestamp time.Time `json:"timestamp"`
+
+	// ID is the message ID. Empty for bans.
+	ID string `json:"id,omitempty"`
+
+	// Author is the message author, or the banned user for bans.
+	Author *ChatLogAuthor `json:"author,omitempty"`
+
+	// Text is the message text.
+	Text string `json:"text,omitempty"`
+
+	// Amount is the formatted Super Chat amount (e.g., "$5.00").
+	Amount string `json:"amount,omitempty"`
+
+	// AmountMicros is the Super Chat amount in micros.
+	AmountMicros int64 `json:"amountMicros,omitempty"`
+
+	// Currency is the Super Chat ISO 4217 currency code.
+	Currency string `json:"currency,omitempty"`
+
+	// BanType is "permanent" or "temporary".
+	BanType string `json:"banType,omitempty"`
+
+	// BanDurationSeconds is the duration of a temporary ban.
+	BanDurationSeconds int64 `json:"banDurationSeconds,omitempty"`
+
+	// Moderator is who issued a ban, if known.
+	Moderator *ChatLogAuthor `json:"moderator,omitempty"`
+
+	// ByBot is true for bans issued by the bot (see BanEvent.ByBot).
+	ByBot bool `json:"byBot,omitempty"`
+}
+
+// ChatLogAuthor identifies the author of a chat log entry.
+type ChatLogAuthor struct {
+	ChannelID   string `json:"channelId"`
+	DisplayName string `json:"displayName,omitempty"`
+	IsModerator bool   `json:"isModerator,omitempty"`
+	IsOwner     bool   `json:"isOwner,omitempty"`
+	IsMember    bool   `json:"isMember,omitempty"`
+}
+
+// ChatLogger writes chat messages, Super Chats, and bans as JSON lines, e.g.
+// to archive a stream's chat:
+//
+//	f, err := os.Create("chat.jsonl")
+//	logger := streaming.NewChatLogger(f)
+//	detach := logger.Attach(bot)
+//	// ... after the stream
+//	detach()
+//	_ = logger.Close() // flushes; f is still open
+//	_ = f.Close()
+//
+// Output is buffered; each underlying Write contains only whole lines, so
+// the writer can rotate files between writes. The caller owns the writer:
+// Close flushes but does not close it.
+//
+// ChatLogger is safe for concurrent use.
+type ChatLogger struct {
+	mu     sync.Mutex
+	out    io.Writer
+	buf    *bufio.Writer // nil when unbuffered
+	err    error
+	closed bool
+}
+
+// ChatLoggerOption configures a ChatLogger.
+type ChatLoggerOption func(*ChatLogger)
+
+// WithChatLogBufferSize sets the write buffer size. Zero disables buffering
+// so each entry is written immediately. Default is DefaultChatLogBufferSize.
+func WithChatLogBufferSize(n int) ChatLoggerOption {
+	return func(l *ChatLogger) {
+		if n <= 0 {
+			l.buf = nil
+			return
+		}
+		l.buf = bufio.NewWriterSize(l.out, n)
+	}
+}
+
+// NewChatLogger creates a ChatLogger writing to w.
+func NewChatLogger(w io.Writer, opts ...ChatLoggerOption) *ChatLogger {
+	l := &ChatLogger{out: w}
+	l.buf = bufio.NewWriterSize(w, DefaultChatLogBufferSize)
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Attach registers the logger's handlers on bot and returns a function that
+// unregisters them.
+func (l *ChatLogger) Attach(bot *ChatBotClient) func() {
+	unsubs := []func(){
+		bot.OnMessage(l.LogMessage),
+		bot.OnSuperChat(l.LogSuperChat),
+		bot.OnUserBanned(l.LogBan),
+	}
+	return func() {
+		for _, unsub := range unsubs {
+			unsub()
+		}
+	}
+}
+
+// LogMessage writes a chat message. It can be registered directly with
+// ChatBotClient.OnMessage.
+func (l *ChatLogger) LogMessage(msg *ChatMessage) {
+	if msg == nil {
+		return
+	}
+	l.write(&ChatLogEntry{
+		Type:      ChatLogMessage,
+		Timestamp: logTimestamp(msg.PublishedAt),
+		ID:        msg.ID,
+		Author:    logAuthor(msg.Author),
+		Text:      msg.Message,
+	})
+}
+
+// LogSuperChat writes a Super Chat. It can be registered directly with
+// ChatBotClient.OnSuperChat.
+func (l *ChatLogger) LogSuperChat(event *SuperChatEvent) {
+	if event == nil {
+		return
+	}
+	var published time.Time
+	if event.Raw != nil && event.Raw.Snippet != nil {
+		published = event.Raw.Snippet.PublishedAt
+	}
+	l.write(&ChatLogEntry{
+		Type:         ChatLogSuperChat,
+		Timestamp:    logTimestamp(published),
+		ID:           event.ID,
+		Author:       logAuthor(event.Author),
+		Text:         event.Message,
+		Amount:       event.Amount,
+		AmountMicros: event.AmountMicros,
+		Currency:     event.Currency,
+	})
+}
+
+// LogBan writes a ban. It can be registered directly with
+// ChatBotClient.OnUserBanned.
+func (l *ChatLogger) LogBan(event *BanEvent) {
+	if event == nil {
+		return
+	}
+	l.write(&ChatLogEntry{
+		Type:               ChatLogBan,
+		Timestamp:          time.Now().UTC(),
+		Author:             logAuthor(event.BannedUser),
+		BanType:            event.BanType,
+		BanDurationSeconds: int64(event.Duration / time.Second),
+		Moderator:          logModerator(event),
+		ByBot:              event.ByBot,
+	})
+}
+
+// Flush writes any buffered entries to the underlying writer.
+// Returns the first write error, if any.
+func (l *ChatLogger) Flush() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.flush()
+}
+
+// Close flushes buffered entries and stops logging; later entries are
+// dropped. It does not close the underlying writer. Returns the first write
+// error, if any. Safe to call multiple times.
+func (l *ChatLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	err := l.flush()
+	l.closed = true
+	return err
+}
+
+// Err returns the first write error, if any. Logging stops after an error.
+func (l *ChatLogger) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// write encodes entry as one line.
+func (l *ChatLogger) write(entry *ChatLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		l.mu.Lock()
+		l.setErr(fmt.Errorf("encoding chat log entry: %w", err))
+		l.mu.Unlock()
+		return
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed || l.err != nil {
+		return
+	}
+	if l.buf == nil {
+		_, err = l.out.Write(line)
+		l.setErr(err)
+		return
+	}
+	// Flush first rather than splitting the line across two writes.
+	if l.buf.Available() < len(line) && l.buf.Buffered() > 0 {
+		if l.setErr(l.buf.Flush()) {
+			return
+		}
+	}
+	_, err = l.buf.Write(line)
+	l.setErr(err)
+}
+
+// flush flushes the buffer. Must be called with mu held.
+func (l *ChatLogger) flush() error {
+	if l.buf != nil && !l.closed && l.err == nil {
+		l.setErr(l.buf.Flush())
+	}
+	return l.err
+}
+
+// setErr records err if it is the first error and reports whether err is
+// non-nil. Must be called with mu held.
+func (l *ChatLogger) setErr(err error) bool {
+	if err != nil && l.err == nil {
+		l.err = fmt.Errorf("writing chat log: %w", err)
+	}
+	return err != nil
+}
+
+// logTimestamp returns t in UTC, or the current time if t is zero.
+func logTimestamp(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Now().UTC()
+	}
+	return t.UTC()
+}
+
+// logModerator returns who issued a ban, falling back to the channel ID
+// alone when the API reported no author details.
+func logModerator(event *BanEvent) *ChatLogAuthor {
+	if event.Moderator != nil {
+		return logAuthor(event.Moderator)
+	}
+	if event.ModeratorChannelID != "" {
+		return &ChatLogAuthor{ChannelID: event.ModeratorChannelID}
+	}
+	return nil
+}
+
+// logAuthor converts an Author for logging.
+func logAuthor(a *Author) *ChatLogAuthor {
+	if a == nil {
+		return nil
+	}
+	return &ChatLogAuthor{
+		ChannelID:   a.ChannelID,
+		DisplayName: a.DisplayName,
+		IsModerator: a.IsModerator,
+		IsOwner:     a.IsOwner,
+		IsMember:    a.IsMember,
+	}
+}
@@ -0,0 +1,201 @@
+package streaming
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// decodeChatLog parses JSON lines into entries.
+func decodeChatLog(t *testing.T, data []byte) []ChatLogEntry {
+	t.Helper()
+	var entries []ChatLogEntry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var e ChatLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestChatLogger_Entries(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewChatLogger(&out)
+	published := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	logger.LogMessage(&ChatMessage{
+		ID:          "msg1",
+		Message:     "hello",
+		Author:      &Author{ChannelID: "UC1", DisplayName: "Viewer", IsMember: true},
+		PublishedAt: published,
+	})
+	logger.LogSuperChat(&SuperChatEvent{
+		ID:           "sc1",
+		Author:       &Author{ChannelID: "UC2", DisplayName: "Donor"},
+		Message:      "great stream",
+		Amount:       "$5.00",
+		AmountMicros: 5000000,
+		Currency:     "USD",
+		Raw:          &LiveChatMessage{Snippet: &MessageSnippet{PublishedAt: published}},
+	})
+	logger.LogBan(&BanEvent{
+		BannedUser: &Author{ChannelID: "UC3", DisplayName: "Spammer"},
+		BanType:    "temporary",
+		Duration:   5 * time.Minute,
+	})
+	logger.LogMessage(nil)
+
+	if out.Len() != 0 {
+		t.Error("entries written before Flush with buffering enabled")
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	entries := decodeChatLog(t, out.Bytes())
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+
+	msg := entries[0]
+	if msg.Type != ChatLogMessage || msg.ID != "msg1" || msg.Text != "hello" ||
+		!msg.Timestamp.Equal(published) || msg.Author == nil || !msg.Author.IsMember {
+		t.Errorf("message entry = %+v", msg)
+	}
+
+	sc := entries[1]
+	if sc.Type != ChatLogSuperChat || sc.Amount != "$5.00" || sc.AmountMicros != 5000000 ||
+		sc.Currency != "USD" || sc.Text != "great stream" || !sc.Timestamp.Equal(published) {
+		t.Errorf("super chat entry = %+v", sc)
+	}
+
+	ban := entries[2]
+	if ban.Type != ChatLogBan || ban.BanType != "temporary" || ban.BanDurationSeconds != 300 ||
+		ban.Author == nil || ban.Author.ChannelID != "UC3" || ban.Timestamp.IsZero() {
+		t.Errorf("ban entry = %+v", ban)
+	}
+
+	// Entries after Close are dropped.
+	logger.LogMessage(&ChatMessage{ID: "late"})
+	_ = logger.Flush()
+	if got := len(decodeChatLog(t, out.Bytes())); got != 3 {
+		t.Errorf("got %d entries after Close, want 3", got)
+	}
+}
+
+// writeRecorder records each Write call.
+type writeRecorder struct {
+	writes [][]byte
+}
+
+func (w *writeRecorder) Write(p []byte) (int, error) {
+	w.writes = append(w.writes, bytes.Clone(p))
+	return len(p), nil
+}
+
+func TestChatLogger_WholeLineWrites(t *testing.T) {
+	rec := &writeRecorder{}
+	logger := NewChatLogger(rec, WithChatLogBufferSize(128))
+
+	for range 10 {
+		logger.LogMessage(&ChatMessage{ID: "msg", Message: strings.Repeat("x", 40)})
+	}
+	_ = logger.Close()
+
+	if len(rec.writes) < 2 {
+		t.Fatalf("got %d writes, want buffer flushed several times", len(rec.writes))
+	}
+	lines := 0
+	for i, w := range rec.writes {
+		if !bytes.HasSuffix(w, []byte("\n")) {
+			t.Errorf("write %d does not end at a line boundary: %q", i, w)
+		}
+		lines += bytes.Count(w, []byte("\n"))
+	}
+	if lines != 10 {
+		t.Errorf("wrote %d lines, want 10", lines)
+	}
+}
+
+func TestChatLogger_Unbuffered(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewChatLogger(&out, WithChatLogBufferSize(0))
+
+	logger.LogMessage(&ChatMessage{ID: "msg1"})
+	if got := len(decodeChatLog(t, out.Bytes())); got != 1 {
+		t.Errorf("got %d entries before Flush, want 1", got)
+	}
+}
+
+// failingWriter fails every write.
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestChatLogger_WriteError(t *testing.T) {
+	logger := NewChatLogger(failingWriter{}, WithChatLogBufferSize(0))
+
+	logger.LogMessage(&ChatMessage{ID: "msg1"})
+	if err := logger.Err(); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("Err() = %v, want disk full", err)
+	}
+	if err := logger.Close(); err == nil {
+		t.Error("Close() error = nil, want write error")
+	}
+}
+
+func TestChatLogger_Attach(t *testing.T) {
+	var out bytes.Buffer
+	logger := NewChatLogger(&out)
+
+	bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+	detach := logger.Attach(bot)
+
+	bot.handleMessage(&LiveChatMessage{
+		ID: "msg1",
+		Snippet: &MessageSnippet{
+			Type:               MessageTypeText,
+			DisplayMessage:     "hi",
+			TextMessageDetails: &TextMessageDetails{MessageText: "hi"},
+		},
+		AuthorDetails: &AuthorDetails{ChannelID: "UC1"},
+	})
+	bot.dispatchUserBanned(&LiveChatMessage{
+		Snippet: &MessageSnippet{
+			Type: MessageTypeUserBanned,
+			UserBannedDetails: &UserBannedDetails{
+				BanType:           "permanent",
+				BannedUserDetails: &BannedUserDetails{ChannelID: "UC2"},
+			},
+		},
+		AuthorDetails: &AuthorDetails{ChannelID: "UCmod", DisplayName: "Mod", IsChatModerator: true},
+	})
+
+	detach()
+	bot.handleMessage(&LiveChatMessage{
+		ID:      "msg2",
+		Snippet: &MessageSnippet{Type: MessageTypeText, DisplayMessage: "after detach"},
+	})
+
+	_ = logger.Close()
+	entries := decodeChatLog(t, out.Bytes())
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if entries[0].Type != ChatLogMessage || entries[0].Text != "hi" {
+		t.Errorf("entry[0] = %+v", entries[0])
+	}
+	if entries[1].Type != ChatLogBan || entries[1].Author.ChannelID != "UC2" ||
+		entries[1].Moderator == nil || entries[1].Moderator.ChannelID != "UCmod" {
+		t.Errorf("entry[1] = %+v", entries[1])
+	}
+}
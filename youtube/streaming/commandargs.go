@@ -0,0 +1,167 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrMissingArgument is returned by CommandContext getters when the command
+// has fewer arguments than requested.
+var ErrMissingArgument = errors.New("missing argument")
+
+// ArgumentError describes a missing or malformed command argument, so the
+// command can reply with its usage.
+type ArgumentError struct {
+	// Command is the command name, without the prefix.
+	Command string
+
+	// Index is the argument's position, starting at 0.
+	Index int
+
+	// Value is the malformed argument, or empty if it is missing.
+	Value string
+
+	// Err is ErrMissingArgument or the parse error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ArgumentError) Error() string {
+	if errors.Is(e.Err, ErrMissingArgument) {
+		return fmt.Sprintf("%s: argument %d: %v", e.Command, e.Index+1, e.Err)
+	}
+	return fmt.Sprintf("%s: argument %d (%q): %v", e.Command, e.Index+1, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ArgumentError) Unwrap() error {
+	return e.Err
+}
+
+// CommandContext is a parsed chat command with typed access to its
+// arguments. For "!timeout viewer 300 spamming links":
+//
+//	user, _ := cmd.ArgString(0)   // "viewer"
+//	d, _ := cmd.ArgDuration(1)    // 5m0s
+//	reason := cmd.RestFrom(2)     // "spamming links"
+//
+// Getters return an *ArgumentError wrapping ErrMissingArgument or the parse
+// error, so a handler can reply with usage when any of them fails.
+type CommandContext struct {
+	// Name is the command name in lower case, without the prefix.
+	Name string
+
+	// Message is the chat message that invoked the command.
+	Message *ChatMessage
+
+	// Args are the words that followed the command name.
+	Args []string
+
+	// rest is the text after the command name, with its original spacing.
+	rest string
+}
+
+// CommandContextHandler handles a chat command registered with
+// CommandRouter.HandleContext.
+type CommandContextHandler func(ctx context.Context, cmd *CommandContext)
+
+// ParseCommand parses msg as a command with the given prefix, e.g. "!" for
+// "!timeout viewer 300". It returns false if msg is not a command. Use it
+// to get typed arguments when dispatching commands yourself; CommandRouter
+// does this for handlers registered with HandleContext.
+func ParseCommand(msg *ChatMessage, prefix string) (*CommandContext, bool) {
+	if msg == nil {
+		return nil, false
+	}
+	text, ok := strings.CutPrefix(strings.TrimSpace(msg.Message), prefix)
+	if !ok {
+		return nil, false
+	}
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return nil, false
+	}
+	return &CommandContext{
+		Name:    strings.ToLower(fields[0]),
+		Message: msg,
+		Args:    fields[1:],
+		rest:    strings.TrimLeftFunc(strings.TrimLeftFunc(text, unicode.IsSpace)[len(fields[0]):], unicode.IsSpace),
+	}, true
+}
+
+// NArg returns the number of arguments.
+func (c *CommandContext) NArg() int {
+	return len(c.Args)
+}
+
+// ArgString returns argument i.
+func (c *CommandContext) ArgString(i int) (string, error) {
+	if i < 0 || i >= len(c.Args) {
+		return "", &ArgumentError{Command: c.Name, Index: i, Err: ErrMissingArgument}
+	}
+	return c.Args[i], nil
+}
+
+// ArgInt returns argument i parsed as a base-10 integer.
+func (c *CommandContext) ArgInt(i int) (int, error) {
+	s, err := c.ArgString(i)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, &ArgumentError{Command: c.Name, Index: i, Value: s, Err: errors.New("not a whole number")}
+	}
+	return n, nil
+}
+
+// ArgDuration returns argument i parsed as a duration. It accepts Go
+// durations such as "90s", "5m", or "1h30m", and bare numbers as seconds
+// ("300"), the form chat moderators usually type. Negative durations are
+// rejected.
+func (c *CommandContext) ArgDuration(i int) (time.Duration, error) {
+	s, err := c.ArgString(i)
+	if err != nil {
+		return 0, err
+	}
+
+	var d time.Duration
+	if secs, err := strconv.ParseUint(s, 10, 32); err == nil {
+		d = time.Duration(secs) * time.Second
+	} else if d, err = time.ParseDuration(s); err != nil {
+		return 0, &ArgumentError{Command: c.Name, Index: i, Value: s, Err: errors.New("not a duration (e.g. 300, 90s, 5m)")}
+	}
+	if d < 0 {
+		return 0, &ArgumentError{Command: c.Name, Index: i, Value: s, Err: errors.New("duration cannot be negative")}
+	}
+	return d, nil
+}
+
+// Rest returns the text after the command name with its original spacing,
+// e.g. "hello   world" for "!say hello   world".
+func (c *CommandContext) Rest() string {
+	return c.rest
+}
+
+// RestFrom returns the text from argument i to the end with its original
+// spacing, e.g. the free-text reason in "!ban viewer being rude". Returns
+// empty string if there are not more than i arguments.
+func (c *CommandContext) RestFrom(i int) string {
+	if i <= 0 {
+		return c.rest
+	}
+	if i >= len(c.Args) {
+		return ""
+	}
+	rest := c.rest
+	for range i {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		rest = rest[strings.IndexFunc(rest, unicode.IsSpace):]
+	}
+	return strings.TrimLeftFunc(rest, unicode.IsSpace)
+}
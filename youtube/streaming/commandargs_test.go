@@ -0,0 +1,139 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		text     string
+		wantOK   bool
+		wantName string
+		wantArgs int
+		wantRest string
+	}{
+		{"!timeout viewer 300", true, "timeout", 2, "viewer 300"},
+		{"  !Say   hello   world  ", true, "say", 2, "hello   world"},
+		{"!ping", true, "ping", 0, ""},
+		{"ping", false, "", 0, ""},
+		{"!  ", false, "", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			cmd, ok := ParseCommand(chatFrom("u1", tt.text), "!")
+			if ok != tt.wantOK {
+				t.Fatalf("ParseCommand() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if cmd.Name != tt.wantName || cmd.NArg() != tt.wantArgs || cmd.Rest() != tt.wantRest {
+				t.Errorf("ParseCommand() = %q %d args, rest %q; want %q %d args, rest %q",
+					cmd.Name, cmd.NArg(), cmd.Rest(), tt.wantName, tt.wantArgs, tt.wantRest)
+			}
+		})
+	}
+
+	if _, ok := ParseCommand(nil, "!"); ok {
+		t.Error("ParseCommand(nil) ok = true")
+	}
+}
+
+func TestCommandContext_Args(t *testing.T) {
+	cmd, _ := ParseCommand(chatFrom("u1", "!timeout viewer 300 -5 5m abc  being   rude"), "!")
+
+	if s, err := cmd.ArgString(0); err != nil || s != "viewer" {
+		t.Errorf("ArgString(0) = %q, %v", s, err)
+	}
+	if n, err := cmd.ArgInt(1); err != nil || n != 300 {
+		t.Errorf("ArgInt(1) = %d, %v", n, err)
+	}
+	if n, err := cmd.ArgInt(2); err != nil || n != -5 {
+		t.Errorf("ArgInt(2) = %d, %v", n, err)
+	}
+
+	durations := []struct {
+		index int
+		want  time.Duration
+		err   bool
+	}{
+		{1, 300 * time.Second, false},
+		{3, 5 * time.Minute, false},
+		{2, 0, true},
+		{4, 0, true},
+		{0, 0, true},
+	}
+	for _, tt := range durations {
+		d, err := cmd.ArgDuration(tt.index)
+		if (err != nil) != tt.err || d != tt.want {
+			t.Errorf("ArgDuration(%d) = %v, %v; want %v, error %v", tt.index, d, err, tt.want, tt.err)
+		}
+	}
+
+	if got := cmd.RestFrom(5); got != "being   rude" {
+		t.Errorf("RestFrom(5) = %q", got)
+	}
+	if got := cmd.RestFrom(0); got != cmd.Rest() {
+		t.Errorf("RestFrom(0) = %q, want Rest()", got)
+	}
+	if got := cmd.RestFrom(7); got != "" {
+		t.Errorf("RestFrom(7) = %q, want empty", got)
+	}
+}
+
+func TestCommandContext_Errors(t *testing.T) {
+	cmd, _ := ParseCommand(chatFrom("u1", "!timeout viewer soon"), "!")
+
+	_, err := cmd.ArgInt(2)
+	var argErr *ArgumentError
+	if !errors.As(err, &argErr) || !errors.Is(err, ErrMissingArgument) || argErr.Index != 2 {
+		t.Errorf("ArgInt(2) error = %v, want missing argument 2", err)
+	}
+	if got := err.Error(); got != "timeout: argument 3: missing argument" {
+		t.Errorf("Error() = %q", got)
+	}
+
+	_, err = cmd.ArgDuration(1)
+	if !errors.As(err, &argErr) || errors.Is(err, ErrMissingArgument) || argErr.Value != "soon" {
+		t.Errorf("ArgDuration(1) error = %v, want malformed argument", err)
+	}
+	if got := err.Error(); got != `timeout: argument 2 ("soon"): not a duration (e.g. 300, 90s, 5m)` {
+		t.Errorf("Error() = %q", got)
+	}
+
+	if _, err := cmd.ArgString(-1); !errors.Is(err, ErrMissingArgument) {
+		t.Errorf("ArgString(-1) error = %v, want ErrMissingArgument", err)
+	}
+}
+
+func TestCommandRouter_HandleContext(t *testing.T) {
+	var got *CommandContext
+	router := NewCommandRouter()
+	router.HandleContext("Timeout", func(ctx context.Context, cmd *CommandContext) {
+		got = cmd
+	})
+
+	msg := chatFrom("u1", "!TIMEOUT viewer 10m spamming")
+	if !router.Dispatch(context.Background(), msg) {
+		t.Fatal("Dispatch() = false, want true")
+	}
+	if got == nil || got.Name != "timeout" || got.Message != msg || got.RestFrom(2) != "spamming" {
+		t.Fatalf("handler got %+v", got)
+	}
+	if d, err := got.ArgDuration(1); err != nil || d != 10*time.Minute {
+		t.Errorf("ArgDuration(1) = %v, %v", d, err)
+	}
+
+	// Re-registering with Handle replaces the context handler.
+	called := false
+	router.Handle("timeout", func(context.Context, *ChatMessage, []string) { called = true })
+	got = nil
+	router.Dispatch(context.Background(), msg)
+	if !called || got != nil {
+		t.Error("Handle did not replace HandleContext handler")
+	}
+}
@@ -0,0 +1,237 @@
+package streaming
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCommandPrefix is the prefix that marks a chat message as a bot
+// command, e.g. "!hello".
+const DefaultCommandPrefix = "!"
+
+// CommandHandler handles a chat command. args are the words that followed
+// the command name, e.g. ["5"] for "!slowmode 5".
+type CommandHandler func(ctx context.Context, msg *ChatMessage, args []string)
+
+// CooldownNotice is called when a user invokes a command that is still on
+// cooldown for them. remaining is how long until they can use it again.
+type CooldownNotice func(ctx context.Context, msg *ChatMessage, command string, remaining time.Duration)
+
+// command is a registered command and its per-user cooldown state.
+type command struct {
+	handler        CommandHandler
+	contextHandler CommandContextHandler
+	cooldown       time.Duration
+	notice         CooldownNotice
+
+	// lastUsed and lastNotice are keyed by author channel ID. lastNotice
+	// holds users notified during their current cooldown.
+	lastUsed   map[string]time.Time
+	lastNotice map[string]time.Time
+	nextPrune  time.Time
+}
+
+// CommandOption configures a command registered with CommandRouter.Handle.
+type CommandOption func(*command)
+
+// WithCooldown sets how long each user must wait between invocations of a
+// command. Invocations during the cooldown are dropped without calling the
+// handler. Each user has their own cooldown, so one user spamming a command
+// does not lock it for everyone else.
+func WithCooldown(d time.Duration) CommandOption {
+	return func(c *command) { c.cooldown = d }
+}
+
+// WithCooldownNotice sets a function called when an invocation is dropped
+// because the command is on cooldown, e.g. to tell the user to wait:
+//
+//	streaming.WithCooldownNotice(func(ctx context.Context, msg *streaming.ChatMessage, name string, remaining time.Duration) {
+//		_ = bot.Say(ctx, fmt.Sprintf("@%s !%s is on cooldown (%ds)",
+//			msg.Author.DisplayName, name, int(remaining.Seconds()+0.5)))
+//	})
+//
+// To avoid the notice itself becoming spam, it is sent at most once each
+// time a user's cooldown starts. Without a notice, cooldown drops are silent.
+func WithCooldownNotice(fn CooldownNotice) CommandOption {
+	return func(c *command) { c.notice = fn }
+}
+
+// CommandRouter dispatches chat commands such as "!hello" to handlers.
+// Command names are case-insensitive. It is safe for concurrent use.
+//
+//	router := streaming.NewCommandRouter()
+//	router.Handle("hello", func(ctx context.Context, msg *streaming.ChatMessage, args []string) {
+//		_ = bot.Say(ctx, "Hello, "+msg.Author.DisplayName+"!")
+//	}, streaming.WithCooldown(10*time.Second))
+//	unsubscribe := router.Attach(ctx, bot)
+type CommandRouter struct {
+	prefix string
+
+	mu       sync.Mutex
+	commands map[string]*command
+
+	now func() time.Time
+}
+
+// CommandRouterOption configures a CommandRouter.
+type CommandRouterOption func(*CommandRouter)
+
+// WithCommandPrefix sets the prefix that marks a message as a command.
+// Default is DefaultCommandPrefix ("!").
+func WithCommandPrefix(prefix string) CommandRouterOption {
+	return func(r *CommandRouter) { r.prefix = prefix }
+}
+
+// NewCommandRouter creates a CommandRouter with no commands.
+func NewCommandRouter(opts ...CommandRouterOption) *CommandRouter {
+	r := &CommandRouter{
+		prefix:   DefaultCommandPrefix,
+		commands: make(map[string]*command),
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Handle registers handler for the named command, without the prefix
+// (e.g. "hello" for "!hello"). Registering a name again replaces the
+// previous handler and resets its cooldowns.
+func (r *CommandRouter) Handle(name string, handler CommandHandler, opts ...CommandOption) {
+	cmd := &command{
+		handler:    handler,
+		lastUsed:   make(map[string]time.Time),
+		lastNotice: make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(cmd)
+	}
+
+	r.register(name, cmd)
+}
+
+// HandleContext registers handler for the named command like Handle, with
+// the arguments parsed into a CommandContext for typed access:
+//
+//	router.HandleContext("timeout", func(ctx context.Context, cmd *streaming.CommandContext) {
+//		user, err := cmd.ArgString(0)
+//		if err != nil {
+//			_ = bot.Say(ctx, "Usage: !timeout <user> <duration>")
+//			return
+//		}
+//		d, err := cmd.ArgDuration(1)
+//		// ...
+//	})
+func (r *CommandRouter) HandleContext(name string, handler CommandContextHandler, opts ...CommandOption) {
+	cmd := &command{
+		contextHandler: handler,
+		lastUsed:       make(map[string]time.Time),
+		lastNotice:     make(map[string]time.Time),
+	}
+	for _, opt := range opts {
+		opt(cmd)
+	}
+
+	r.register(name, cmd)
+}
+
+// register adds cmd under name, replacing any previous command.
+func (r *CommandRouter) register(name string, cmd *command) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commands[strings.ToLower(name)] = cmd
+}
+
+// Attach dispatches the bot's chat messages to the router until the
+// returned function is called. ctx is passed to command handlers.
+func (r *CommandRouter) Attach(ctx context.Context, bot *ChatBotClient) func() {
+	return bot.OnMessage(func(msg *ChatMessage) {
+		r.Dispatch(ctx, msg)
+	})
+}
+
+// Dispatch runs the handler for the command in msg, if any. It reports
+// whether msg was a registered command, including invocations dropped
+// because the command is on cooldown for the author.
+func (r *CommandRouter) Dispatch(ctx context.Context, msg *ChatMessage) bool {
+	parsed, ok := ParseCommand(msg, r.prefix)
+	if !ok {
+		return false
+	}
+	name := parsed.Name
+
+	var userID string
+	if msg.Author != nil {
+		userID = msg.Author.ChannelID
+	}
+
+	r.mu.Lock()
+	cmd, ok := r.commands[name]
+	if !ok {
+		r.mu.Unlock()
+		return false
+	}
+	remaining, notify := cmd.acquire(userID, r.now())
+	r.mu.Unlock()
+
+	if remaining > 0 {
+		if notify {
+			cmd.notice(ctx, msg, name, remaining)
+		}
+		return true
+	}
+	if cmd.contextHandler != nil {
+		cmd.contextHandler(ctx, parsed)
+	} else {
+		cmd.handler(ctx, msg, parsed.Args)
+	}
+	return true
+}
+
+// acquire records an invocation by userID at now. If the command is on
+// cooldown for the user, it returns the time remaining and whether a
+// cooldown notice is due. The caller must hold the router's lock.
+func (c *command) acquire(userID string, now time.Time) (time.Duration, bool) {
+	if c.cooldown <= 0 {
+		return 0, false
+	}
+	c.prune(now)
+
+	if last, ok := c.lastUsed[userID]; ok {
+		if remaining := last.Add(c.cooldown).Sub(now); remaining > 0 {
+			if c.notice == nil {
+				return remaining, false
+			}
+			if _, noticed := c.lastNotice[userID]; noticed {
+				return remaining, false
+			}
+			c.lastNotice[userID] = now
+			return remaining, true
+		}
+	}
+	c.lastUsed[userID] = now
+	delete(c.lastNotice, userID)
+	return 0, false
+}
+
+// prune forgets users whose cooldowns have expired, at most once per
+// cooldown period, so a long stream doesn't accumulate every chatter.
+func (c *command) prune(now time.Time) {
+	if now.Before(c.nextPrune) {
+		return
+	}
+	c.nextPrune = now.Add(c.cooldown)
+	for id, t := range c.lastUsed {
+		if now.Sub(t) >= c.cooldown {
+			delete(c.lastUsed, id)
+		}
+	}
+	for id, t := range c.lastNotice {
+		if now.Sub(t) >= c.cooldown {
+			delete(c.lastNotice, id)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func chatFrom(userID, text string) *ChatMessage {
+	return &ChatMessage{Message: text, Author: &Author{ChannelID: userID}}
+}
+
+func TestCommandRouter_Dispatch(t *testing.T) {
+	var calls []string
+	router := NewCommandRouter()
+	router.Handle("Hello", func(ctx context.Context, msg *ChatMessage, args []string) {
+		calls = append(calls, fmt.Sprintf("hello %v", args))
+	})
+
+	tests := []struct {
+		text      string
+		wantOK    bool
+		wantCalls []string
+	}{
+		{"!hello", true, []string{"hello []"}},
+		{"  !HELLO there  friend ", true, []string{"hello [there friend]"}},
+		{"!unknown", false, nil},
+		{"hello", false, nil},
+		{"!", false, nil},
+		{"", false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			calls = nil
+			if got := router.Dispatch(context.Background(), chatFrom("u1", tt.text)); got != tt.wantOK {
+				t.Errorf("Dispatch() = %v, want %v", got, tt.wantOK)
+			}
+			if fmt.Sprint(calls) != fmt.Sprint(tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+
+	if router.Dispatch(context.Background(), nil) {
+		t.Error("Dispatch(nil) = true, want false")
+	}
+}
+
+func TestCommandRouter_Prefix(t *testing.T) {
+	called := 0
+	router := NewCommandRouter(WithCommandPrefix("?"))
+	router.Handle("help", func(context.Context, *ChatMessage, []string) { called++ })
+
+	router.Dispatch(context.Background(), chatFrom("u1", "!help"))
+	router.Dispatch(context.Background(), chatFrom("u1", "?help"))
+	if called != 1 {
+		t.Errorf("called = %d, want 1", called)
+	}
+}
+
+func TestCommandRouter_Cooldown(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var calls, notices []string
+	router := NewCommandRouter()
+	router.now = func() time.Time { return now }
+	router.Handle("hello", func(ctx context.Context, msg *ChatMessage, args []string) {
+		calls = append(calls, msg.Author.ChannelID)
+	},
+		WithCooldown(10*time.Second),
+		WithCooldownNotice(func(ctx context.Context, msg *ChatMessage, name string, remaining time.Duration) {
+			notices = append(notices, fmt.Sprintf("%s %s %v", msg.Author.ChannelID, name, remaining))
+		}),
+	)
+	router.Handle("time", func(ctx context.Context, msg *ChatMessage, args []string) {
+		calls = append(calls, "time")
+	})
+
+	steps := []struct {
+		advance time.Duration
+		user    string
+		text    string
+	}{
+		{0, "u1", "!hello"},               // runs
+		{0, "u2", "!hello"},               // other user unaffected
+		{2 * time.Second, "u1", "!hello"}, // dropped, notice
+		{1 * time.Second, "u1", "!hello"}, // dropped, notice already sent
+		{0, "u1", "!time"},                // no cooldown
+		{0, "u1", "!time"},
+		{7 * time.Second, "u1", "!hello"}, // cooldown over
+		{1 * time.Second, "u1", "!hello"}, // dropped, new notice
+	}
+	for _, s := range steps {
+		now = now.Add(s.advance)
+		if !router.Dispatch(context.Background(), chatFrom(s.user, s.text)) {
+			t.Errorf("Dispatch(%q) = false, want true", s.text)
+		}
+	}
+
+	wantCalls := []string{"u1", "u2", "time", "time", "u1"}
+	if fmt.Sprint(calls) != fmt.Sprint(wantCalls) {
+		t.Errorf("calls = %v, want %v", calls, wantCalls)
+	}
+	wantNotices := []string{"u1 hello 8s", "u1 hello 9s"}
+	if fmt.Sprint(notices) != fmt.Sprint(wantNotices) {
+		t.Errorf("notices = %v, want %v", notices, wantNotices)
+	}
+}
+
+func TestCommandRouter_CooldownSilentAndPruned(t *testing.T) {
+	now := time.Unix(1000, 0)
+	called := 0
+	router := NewCommandRouter()
+	router.now = func() time.Time { return now }
+	router.Handle("hello", func(context.Context, *ChatMessage, []string) { called++ },
+		WithCooldown(time.Minute))
+
+	for i := range 100 {
+		router.Dispatch(context.Background(), chatFrom(fmt.Sprintf("u%d", i), "!hello"))
+		router.Dispatch(context.Background(), chatFrom(fmt.Sprintf("u%d", i), "!hello"))
+	}
+	if called != 100 {
+		t.Errorf("called = %d, want 100", called)
+	}
+
+	now = now.Add(2 * time.Minute)
+	router.Dispatch(context.Background(), chatFrom("late", "!hello"))
+	if n := len(router.commands["hello"].lastUsed); n != 1 {
+		t.Errorf("tracked users = %d after expiry, want 1", n)
+	}
+}
+
+func TestCommandRouter_Attach(t *testing.T) {
+	bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+	router := NewCommandRouter()
+	var args []string
+	router.Handle("slowmode", func(ctx context.Context, msg *ChatMessage, a []string) { args = a })
+
+	unsubscribe := router.Attach(context.Background(), bot)
+	bot.handleMessage(&LiveChatMessage{
+		ID:            "msg1",
+		Snippet:       &MessageSnippet{Type: MessageTypeText, DisplayMessage: "!slowmode 5"},
+		AuthorDetails: &AuthorDetails{ChannelID: "mod1", IsChatModerator: true},
+	})
+	if fmt.Sprint(args) != "[5]" {
+		t.Errorf("args = %v, want [5]", args)
+	}
+
+	unsubscribe()
+	args = nil
+	bot.handleMessage(&LiveChatMessage{
+		ID:            "msg2",
+		Snippet:       &MessageSnippet{Type: MessageTypeText, DisplayMessage: "!slowmode 10"},
+		AuthorDetails: &AuthorDetails{ChannelID: "mod1"},
+	})
+	if args != nil {
+		t.Errorf("args = %v after unsubscribe, want nil", args)
+	}
+}
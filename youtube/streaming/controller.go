@@ -2,6 +2,7 @@ package streaming
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -48,7 +49,7 @@ type CreateBroadcastParams struct {
 	Description string
 
 	// ScheduledStartTime is when the broadcast is scheduled to start.
-	// If nil, the broadcast can start immediately.
+	// If nil, the broadcast is scheduled for now and can start immediately.
 	ScheduledStartTime *time.Time
 
 	// PrivacyStatus is the privacy setting.
@@ -131,7 +132,12 @@ func (c *StreamController) CreateBroadcastWithStream(ctx context.Context, params
 	// Set defaults
 	privacyStatus := params.PrivacyStatus
 	if privacyStatus == "" {
-		privacyStatus = "unlisted"
+		privacyStatus = BroadcastPrivacyUnlisted
+	}
+	scheduledStart := params.ScheduledStartTime
+	if scheduledStart == nil {
+		now := time.Now()
+		scheduledStart = &now
 	}
 	resolution := params.Resolution
 	if resolution == "" {
@@ -155,7 +161,7 @@ func (c *StreamController) CreateBroadcastWithStream(ctx context.Context, params
 		Snippet: &BroadcastSnippet{
 			Title:              params.Title,
 			Description:        params.Description,
-			ScheduledStartTime: params.ScheduledStartTime,
+			ScheduledStartTime: scheduledStart,
 		},
 		Status: &BroadcastStatus{
 			PrivacyStatus:           privacyStatus,
@@ -338,6 +344,107 @@ func (c *StreamController) DeleteStream(ctx context.Context, streamID string) er
 	return DeleteStream(ctx, c.client, streamID)
 }
 
+// ReconcileOption configures StreamController.Reconcile.
+type ReconcileOption func(*reconcileConfig)
+
+// DefaultReconcileMinAge is how long ago a broadcast must have been
+// published before Reconcile treats it as orphaned.
+const DefaultReconcileMinAge = 24 * time.Hour
+
+// reconcileConfig holds Reconcile settings.
+type reconcileConfig struct {
+	delete bool
+	minAge time.Duration
+}
+
+// WithReconcileDelete makes Reconcile delete the orphaned broadcasts it
+// finds. Without it Reconcile only reports them.
+func WithReconcileDelete() ReconcileOption {
+	return func(c *reconcileConfig) { c.delete = true }
+}
+
+// WithReconcileMinAge skips broadcasts published less than d ago, so a
+// broadcast another process is still setting up, or one scheduled by hand
+// that is not bound yet, is not treated as orphaned. Default is
+// DefaultReconcileMinAge. Zero or less disables the check.
+func WithReconcileMinAge(d time.Duration) ReconcileOption {
+	return func(c *reconcileConfig) { c.minAge = d }
+}
+
+// Reconcile finds orphaned broadcasts: the authenticated user's upcoming
+// broadcasts still in the created state with no bound stream, typically left
+// behind when a process crashed between creating a broadcast and binding its
+// stream. Broadcasts published less than the minimum age ago, or with an
+// unknown publish time, are skipped (see WithReconcileMinAge).
+//
+// Reconcile only reports orphans unless WithReconcileDelete is set. Review
+// the report first: a broadcast scheduled by hand looks the same until a
+// stream is bound to it. When deleting, a failed deletion does not stop the
+// others and the errors are joined.
+//
+// Quota cost: 1 unit per page listed, plus 50 units per deletion.
+func (c *StreamController) Reconcile(ctx context.Context, opts ...ReconcileOption) ([]*LiveBroadcast, error) {
+	cfg := &reconcileConfig{minAge: DefaultReconcileMinAge}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if err := c.refreshToken(ctx); err != nil {
+		return nil, fmt.Errorf("refreshing token: %w", err)
+	}
+
+	var orphans []*LiveBroadcast
+	pageToken := ""
+	for {
+		resp, err := GetBroadcasts(ctx, c.client, &GetBroadcastsParams{
+			Mine:            true,
+			BroadcastStatus: "upcoming",
+			Parts:           []string{"snippet", "status", "contentDetails"},
+			MaxResults:      50,
+			PageToken:       pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing broadcasts: %w", err)
+		}
+
+		for _, b := range resp.Items {
+			if isOrphanedBroadcast(b, cfg) {
+				orphans = append(orphans, b)
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if !cfg.delete {
+		return orphans, nil
+	}
+
+	var errs []error
+	for _, b := range orphans {
+		if err := DeleteBroadcast(ctx, c.client, b.ID); err != nil {
+			errs = append(errs, fmt.Errorf("deleting broadcast %s: %w", b.ID, err))
+		}
+	}
+
+	return orphans, errors.Join(errs...)
+}
+
+// isOrphanedBroadcast reports whether a broadcast is in the created state
+// without a bound stream and old enough to reconcile.
+func isOrphanedBroadcast(b *LiveBroadcast, cfg *reconcileConfig) bool {
+	if b == nil || lifeCycleStatus(b) != BroadcastStatusCreated || b.HasBoundStream() {
+		return false
+	}
+	if cfg.minAge <= 0 {
+		return true
+	}
+	return b.Snippet != nil && !b.Snippet.PublishedAt.IsZero() && time.Since(b.Snippet.PublishedAt) >= cfg.minAge
+}
+
 // refreshToken refreshes the access token if a token provider is configured.
 func (c *StreamController) refreshToken(ctx context.Context) error {
 	if c.tokenProvider == nil {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -71,7 +72,12 @@ func TestStreamController_CreateBroadcastWithStream(t *testing.T) {
 
 			switch {
 			case r.URL.Path == "/liveBroadcasts" && r.Method == http.MethodPost:
-				// Insert broadcast
+				// Insert broadcast; no schedule was given, so it starts now
+				var body LiveBroadcast
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				if start := body.Snippet.ScheduledStartTime; start == nil || time.Since(*start) > time.Minute {
+					t.Errorf("scheduledStartTime = %v, want now", start)
+				}
 				resp := LiveBroadcast{
 					ID:      "broadcast123",
 					Snippet: &BroadcastSnippet{Title: "Test Broadcast", LiveChatID: "chat123"},
@@ -580,6 +586,126 @@ func TestStreamController_DeleteStream(t *testing.T) {
 	})
 }
 
+func TestStreamController_Reconcile(t *testing.T) {
+	now := time.Now()
+	pages := map[string]LiveBroadcastListResponse{
+		"": {
+			NextPageToken: "page2",
+			Items: []*LiveBroadcast{
+				{ID: "orphan1", Snippet: &BroadcastSnippet{PublishedAt: now.Add(-48 * time.Hour)}, Status: &BroadcastStatus{LifeCycleStatus: BroadcastStatusCreated}},
+				{ID: "bound", Snippet: &BroadcastSnippet{PublishedAt: now.Add(-time.Hour)}, Status: &BroadcastStatus{LifeCycleStatus: BroadcastStatusReady}, ContentDetails: &BroadcastContentDetails{BoundStreamID: "stream1"}},
+			},
+		},
+		"page2": {
+			Items: []*LiveBroadcast{
+				{ID: "orphan2", Snippet: &BroadcastSnippet{PublishedAt: now.Add(-time.Hour)}, Status: &BroadcastStatus{LifeCycleStatus: BroadcastStatusCreated}},
+				{ID: "unknownAge", Status: &BroadcastStatus{LifeCycleStatus: BroadcastStatusCreated}},
+			},
+		},
+	}
+
+	newServer := func(deleted *[]string, failDelete string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				if r.URL.Query().Get("broadcastStatus") != "upcoming" {
+					t.Errorf("broadcastStatus = %q, want upcoming", r.URL.Query().Get("broadcastStatus"))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(pages[r.URL.Query().Get("pageToken")])
+			case http.MethodDelete:
+				id := r.URL.Query().Get("id")
+				if id == failDelete {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				*deleted = append(*deleted, id)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+	}
+
+	tests := []struct {
+		name        string
+		opts        []ReconcileOption
+		failDelete  string
+		wantOrphans []string
+		wantDeleted []string
+		wantErr     bool
+	}{
+		{
+			name:        "reports only by default",
+			wantOrphans: []string{"orphan1"},
+		},
+		{
+			name:        "deletes orphans across pages",
+			opts:        []ReconcileOption{WithReconcileDelete(), WithReconcileMinAge(30 * time.Minute)},
+			wantOrphans: []string{"orphan1", "orphan2"},
+			wantDeleted: []string{"orphan1", "orphan2"},
+		},
+		{
+			name:        "default min age skips recent broadcasts",
+			opts:        []ReconcileOption{WithReconcileDelete()},
+			wantOrphans: []string{"orphan1"},
+			wantDeleted: []string{"orphan1"},
+		},
+		{
+			name:        "zero min age includes unknown age",
+			opts:        []ReconcileOption{WithReconcileMinAge(0)},
+			wantOrphans: []string{"orphan1", "orphan2", "unknownAge"},
+		},
+		{
+			name:        "delete failure continues",
+			opts:        []ReconcileOption{WithReconcileDelete(), WithReconcileMinAge(30 * time.Minute)},
+			failDelete:  "orphan1",
+			wantOrphans: []string{"orphan1", "orphan2"},
+			wantDeleted: []string{"orphan2"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []string
+			server := newServer(&deleted, tt.failDelete)
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			controller, _ := NewStreamController(client, nil)
+
+			orphans, err := controller.Reconcile(context.Background(), tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var ids []string
+			for _, b := range orphans {
+				ids = append(ids, b.ID)
+			}
+			if !slices.Equal(ids, tt.wantOrphans) {
+				t.Errorf("orphans = %v, want %v", ids, tt.wantOrphans)
+			}
+			if !slices.Equal(deleted, tt.wantDeleted) {
+				t.Errorf("deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+
+	t.Run("list error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		controller, _ := NewStreamController(client, nil)
+
+		if _, err := controller.Reconcile(context.Background()); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
 func TestStreamController_TokenRefresh(t *testing.T) {
 	t.Run("refreshes token on each call", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,205 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// DefaultCuepointDuration is the ad break length YouTube uses when a
+// cuepoint is inserted without DurationSecs.
+const DefaultCuepointDuration = 30 * time.Second
+
+// CuepointIneligibleError indicates that an ad break cannot run on a
+// broadcast. Ads require all of the following:
+//
+//   - the channel is in the YouTube Partner Program with ads enabled,
+//   - the broadcast has monetization enabled,
+//   - the broadcast is currently live.
+//
+// InsertCuepoint returns this error when the API refuses a cuepoint with
+// 403 Forbidden, and WaitForCuepoint returns it when the broadcast is not live.
+type CuepointIneligibleError struct {
+	// BroadcastID is the broadcast the cuepoint targeted.
+	BroadcastID string
+
+	// Reason describes why the broadcast is ineligible.
+	Reason string
+
+	// Err is the underlying API error, if any.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *CuepointIneligibleError) Error() string {
+	msg := fmt.Sprintf("broadcast %s is not eligible for ad breaks: %s", e.BroadcastID, e.Reason)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns the underlying error.
+func (e *CuepointIneligibleError) Unwrap() error {
+	return e.Err
+}
+
+// CuepointStatus is the outcome of an ad break reported by WaitForCuepoint.
+type CuepointStatus string
+
+// Cuepoint outcomes.
+const (
+	// CuepointCompleted means the ad break window elapsed while the
+	// broadcast stayed live.
+	CuepointCompleted CuepointStatus = "completed"
+
+	// CuepointInterrupted means the broadcast stopped being live before the
+	// ad break window ended.
+	CuepointInterrupted CuepointStatus = "interrupted"
+)
+
+// CuepointResult reports the outcome of an ad break.
+type CuepointResult struct {
+	// CuepointID is the cuepoint's ID.
+	CuepointID string
+
+	// Status is the outcome of the ad break.
+	Status CuepointStatus
+
+	// StartsAt is when the ad break was scheduled to begin.
+	StartsAt time.Time
+
+	// EndsAt is when the ad break was scheduled to end.
+	EndsAt time.Time
+}
+
+// CuepointOption configures WaitForCuepoint.
+type CuepointOption func(*cuepointConfig)
+
+// cuepointConfig holds WaitForCuepoint settings.
+type cuepointConfig struct {
+	pollInterval time.Duration
+}
+
+// WithCuepointPollInterval sets how often the broadcast is checked while
+// waiting for an ad break to finish. Default is 5 seconds.
+func WithCuepointPollInterval(d time.Duration) CuepointOption {
+	return func(c *cuepointConfig) {
+		if d > 0 {
+			c.pollInterval = d
+		}
+	}
+}
+
+// insertedCuepoint records when a cuepoint was inserted and how it was timed.
+type insertedCuepoint struct {
+	insertedAt time.Time
+	params     InsertCuepointParams
+}
+
+// recordCuepoint attaches the insertion timing to a cuepoint returned by
+// InsertCuepoint, since the API has no cuepoint lookup.
+func recordCuepoint(cp *Cuepoint, params *InsertCuepointParams) {
+	cp.inserted = &insertedCuepoint{insertedAt: time.Now(), params: *params}
+}
+
+// cuepointError converts a 403 from liveBroadcasts.cuepoint into a
+// *CuepointIneligibleError. Quota errors are returned unchanged.
+func cuepointError(broadcastID string, err error) error {
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) && apiErr.IsForbidden() && !apiErr.IsQuotaExceeded() {
+		return &CuepointIneligibleError{
+			BroadcastID: broadcastID,
+			Reason:      "cuepoint rejected (check monetization settings)",
+			Err:         err,
+		}
+	}
+	return err
+}
+
+// WaitForCuepoint waits until an ad break inserted with InsertCuepoint has
+// run its course and reports whether the broadcast stayed live throughout.
+//
+// The YouTube API does not report whether ads actually played: individual
+// viewers may not see ads (e.g., Premium members or frequency caps).
+// CuepointCompleted therefore means the break window elapsed on a live,
+// ad-eligible broadcast, not that every viewer saw an ad.
+//
+// cp must be the value returned by InsertCuepoint, which carries the
+// insertion time and timing parameters; the API has no way to look a
+// cuepoint up again. Returns a *CuepointIneligibleError if the broadcast is
+// not live when called.
+//
+// Quota cost: 1 unit per status poll.
+func WaitForCuepoint(ctx context.Context, client *core.Client, cp *Cuepoint, opts ...CuepointOption) (*CuepointResult, error) {
+	if cp == nil {
+		return nil, fmt.Errorf("cuepoint cannot be nil")
+	}
+	if cp.inserted == nil {
+		return nil, fmt.Errorf("cuepoint %s was not returned by InsertCuepoint", cp.ID)
+	}
+	inserted := cp.inserted
+	broadcastID := inserted.params.BroadcastID
+
+	cfg := &cuepointConfig{pollInterval: DefaultLifecyclePollInterval}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	broadcast, err := GetBroadcast(ctx, client, broadcastID, "snippet", "status")
+	if err != nil {
+		return nil, err
+	}
+	if !broadcast.IsLive() {
+		return nil, &CuepointIneligibleError{
+			BroadcastID: broadcastID,
+			Reason:      fmt.Sprintf("broadcast is %s, not live", lifeCycleStatus(broadcast)),
+		}
+	}
+
+	result := &CuepointResult{CuepointID: cp.ID}
+	result.StartsAt, result.EndsAt = cuepointWindow(inserted, broadcast)
+
+	ticker := time.NewTicker(cfg.pollInterval)
+	defer ticker.Stop()
+
+	for time.Now().Before(result.EndsAt) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		b, err := GetBroadcast(ctx, client, broadcastID, "status")
+		if err != nil {
+			return nil, err
+		}
+		if !b.IsLive() {
+			result.Status = CuepointInterrupted
+			return result, nil
+		}
+	}
+
+	result.Status = CuepointCompleted
+	return result, nil
+}
+
+// cuepointWindow computes when an inserted cuepoint's ad break starts and ends.
+func cuepointWindow(cp *insertedCuepoint, b *LiveBroadcast) (start, end time.Time) {
+	start = cp.insertedAt
+	switch {
+	case cp.params.WalltimeMs > 0:
+		start = time.UnixMilli(cp.params.WalltimeMs)
+	case cp.params.InsertionOffsetTimeMs > 0 && b.Snippet != nil && b.Snippet.ActualStartTime != nil:
+		start = b.Snippet.ActualStartTime.Add(time.Duration(cp.params.InsertionOffsetTimeMs) * time.Millisecond)
+	}
+
+	duration := DefaultCuepointDuration
+	if cp.params.DurationSecs > 0 {
+		duration = time.Duration(cp.params.DurationSecs) * time.Second
+	}
+	return start, start.Add(duration)
+}
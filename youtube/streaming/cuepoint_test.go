@@ -0,0 +1,161 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// cuepointServer serves liveBroadcasts.cuepoint and a broadcast whose
+// lifecycle status ends after a number of status polls.
+type cuepointServer struct {
+	polls     atomic.Int32
+	liveFor   int32 // polls before the broadcast completes; 0 = stays live
+	notLive   bool
+	forbidden bool
+}
+
+func (s *cuepointServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/liveBroadcasts/cuepoint":
+		if s.forbidden {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"Monetization is not enabled","errors":[{"reason":"forbidden"}]}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(Cuepoint{ID: "cue1", CueType: CueTypeAd, DurationSecs: 1})
+	case "/liveBroadcasts":
+		status := BroadcastStatusLive
+		n := s.polls.Add(1)
+		if s.notLive || (s.liveFor > 0 && n > s.liveFor) {
+			status = BroadcastStatusComplete
+		}
+		_ = json.NewEncoder(w).Encode(LiveBroadcastListResponse{
+			Items: []*LiveBroadcast{{ID: "b1", Status: &BroadcastStatus{LifeCycleStatus: status}}},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestInsertCuepoint_Ineligible(t *testing.T) {
+	server := httptest.NewServer(&cuepointServer{forbidden: true})
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	_, err := InsertImmediateCuepoint(context.Background(), client, "b1")
+
+	var ineligible *CuepointIneligibleError
+	if !errors.As(err, &ineligible) {
+		t.Fatalf("error = %v, want *CuepointIneligibleError", err)
+	}
+	if ineligible.BroadcastID != "b1" {
+		t.Errorf("BroadcastID = %q, want b1", ineligible.BroadcastID)
+	}
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		t.Error("error should wrap *core.APIError")
+	}
+}
+
+func TestWaitForCuepoint(t *testing.T) {
+	tests := []struct {
+		name       string
+		srv        *cuepointServer
+		wantStatus CuepointStatus
+		wantErr    bool
+	}{
+		{name: "completed", srv: &cuepointServer{}, wantStatus: CuepointCompleted},
+		{name: "interrupted", srv: &cuepointServer{liveFor: 2}, wantStatus: CuepointInterrupted},
+		{name: "not live", srv: &cuepointServer{notLive: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.srv)
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			cp, err := InsertCuepoint(context.Background(), client, &InsertCuepointParams{
+				BroadcastID:  "b1",
+				DurationSecs: 1,
+			})
+			if err != nil {
+				t.Fatalf("InsertCuepoint() error = %v", err)
+			}
+
+			// Shorten the recorded window so the test doesn't wait a full second.
+			cp.inserted.insertedAt = time.Now().Add(-950 * time.Millisecond)
+
+			result, err := WaitForCuepoint(context.Background(), client, cp,
+				WithCuepointPollInterval(5*time.Millisecond))
+			if tt.wantErr {
+				var ineligible *CuepointIneligibleError
+				if !errors.As(err, &ineligible) {
+					t.Fatalf("error = %v, want *CuepointIneligibleError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WaitForCuepoint() error = %v", err)
+			}
+			if result.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", result.Status, tt.wantStatus)
+			}
+			if result.EndsAt.Sub(result.StartsAt) != time.Second {
+				t.Errorf("window = %v, want 1s", result.EndsAt.Sub(result.StartsAt))
+			}
+		})
+	}
+
+	t.Run("not inserted", func(t *testing.T) {
+		client := core.NewClient()
+		if _, err := WaitForCuepoint(context.Background(), client, &Cuepoint{ID: "cue1"}); err == nil {
+			t.Error("expected error for cuepoint not returned by InsertCuepoint")
+		}
+	})
+
+	t.Run("nil cuepoint", func(t *testing.T) {
+		client := core.NewClient()
+		if _, err := WaitForCuepoint(context.Background(), client, nil); err == nil {
+			t.Error("expected error for nil cuepoint")
+		}
+	})
+}
+
+func TestCuepointWindow(t *testing.T) {
+	inserted := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	started := time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)
+	b := &LiveBroadcast{Snippet: &BroadcastSnippet{ActualStartTime: &started}}
+
+	tests := []struct {
+		name      string
+		params    InsertCuepointParams
+		wantStart time.Time
+		wantLen   time.Duration
+	}{
+		{"immediate", InsertCuepointParams{InsertionOffsetTimeMs: CuepointInsertImmediate}, inserted, DefaultCuepointDuration},
+		{"offset", InsertCuepointParams{InsertionOffsetTimeMs: 90 * 60 * 1000, DurationSecs: 60}, started.Add(90 * time.Minute), time.Minute},
+		{"walltime", InsertCuepointParams{WalltimeMs: inserted.Add(time.Hour).UnixMilli()}, inserted.Add(time.Hour), DefaultCuepointDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := cuepointWindow(&insertedCuepoint{insertedAt: inserted, params: tt.params}, b)
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", start, tt.wantStart)
+			}
+			if end.Sub(start) != tt.wantLen {
+				t.Errorf("duration = %v, want %v", end.Sub(start), tt.wantLen)
+			}
+		})
+	}
+}
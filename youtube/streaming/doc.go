@@ -32,6 +32,71 @@
 //	}
 //	defer bot.Close()
 //
+// Use Shutdown instead of Close to let pending sends finish first:
+//
+//	_ = bot.Say(ctx, "Stream ending!")
+//	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	err := bot.Shutdown(shutdownCtx) // *ShutdownError reports undelivered messages
+//
+// Keep recent messages in memory, e.g. to redraw an overlay on reconnect:
+//
+//	bot, err := streaming.NewChatBotClient(client, authClient, liveChatID,
+//		streaming.WithHistoryBuffer(20),
+//	)
+//	for _, msg := range bot.RecentMessages() { // oldest first
+//		render(msg)
+//	}
+//
+// The buffer also lets deletion events report what was deleted:
+//
+//	bot.OnMessageDeletedEvent(func(e *streaming.MessageDeletedEvent) {
+//		if e.Author != nil { // nil if the message was not buffered
+//			log.Printf("%s's message %q was deleted", e.Author.DisplayName, e.Message)
+//		}
+//	})
+//
+// Archive chat as JSON lines (messages, Super Chats, and bans). Pass a
+// rotating io.Writer to split logs; Close flushes but leaves w open:
+//
+//	logger := streaming.NewChatLogger(w)
+//	detach := logger.Attach(bot)
+//	defer func() { detach(); _ = logger.Close() }()
+//
+// For operational logs, SetLogger routes chat events (info), bans (warn),
+// and failures such as failed sends, fatal poll errors, and handler panics
+// (error) to a log/slog logger, with the event type and author as
+// attributes:
+//
+//	bot.SetLogger(slog.New(slog.NewJSONHandler(os.Stderr, nil)))
+//
+// Post a periodic status message after connecting. Beats are skipped while
+// the bot has recently sent a message with Say or is rate limited:
+//
+//	err := bot.StartHeartbeat(ctx, 15*time.Minute, "Bot online. Type !help for commands.")
+//
+// Route "!command" messages to handlers with a CommandRouter. Per-user
+// cooldowns stop one viewer from flooding the chat with bot replies:
+//
+//	router := streaming.NewCommandRouter()
+//	router.Handle("hello", func(ctx context.Context, msg *streaming.ChatMessage, args []string) {
+//		_ = bot.Say(ctx, "Hello, "+msg.Author.DisplayName+"!")
+//	}, streaming.WithCooldown(10*time.Second))
+//	unsubscribe := router.Attach(ctx, bot)
+//
+// HandleContext passes a CommandContext with typed argument getters, which
+// return an *ArgumentError for missing or malformed arguments:
+//
+//	router.HandleContext("timeout", func(ctx context.Context, cmd *streaming.CommandContext) {
+//		user, err := cmd.ArgString(0)
+//		if err != nil {
+//			_ = bot.Say(ctx, "Usage: !timeout <user> <duration>")
+//			return
+//		}
+//		d, err := cmd.ArgDuration(1) // "300" or "5m"
+//		reason := cmd.RestFrom(2)
+//	})
+//
 // # LiveChatPoller (Advanced)
 //
 // The low-level poller for custom implementations:
@@ -45,6 +110,71 @@
 //	poller.Start(ctx)
 //	defer poller.Stop()
 //
+// OnRawResponse exposes each poll's full response, e.g. to drive custom
+// adaptive polling from PollingIntervalMillis and PageInfo.
+//
+// For select-based processing, receive events from a channel instead of
+// registering handlers. The channel is closed when the poller stops:
+//
+//	events := poller.Events()
+//	poller.Start(ctx)
+//	for ev := range events {
+//		if ev.Type == streaming.ChatEventMessage {
+//			handle(ev.Message)
+//		}
+//	}
+//
+// To save quota on quiet streams, WithAdaptivePolling doubles the interval
+// after each empty poll (up to the maximum) and halves it after each poll
+// with messages, stepping back down to the normal interval:
+//
+//	poller := streaming.NewLiveChatPoller(client, liveChatID,
+//		streaming.WithAdaptivePolling(true),
+//		streaming.WithMaxPollInterval(20*time.Second),
+//	)
+//
+// A failed poll is reported to OnError as a *PollError. Transient failures
+// (5xx, rate limits, dropped connections) are retried with backoff and the
+// poller keeps running; it stops only on a fatal error, such as the chat
+// ending, a 403, or an exhausted quota. PollError.ConsecutiveErrors counts
+// failures since the last successful poll:
+//
+//	poller.OnError(func(err error) {
+//		var pollErr *streaming.PollError
+//		if errors.As(err, &pollErr) && pollErr.ConsecutiveErrors >= 5 {
+//			log.Printf("chat unreachable: %v", err)
+//		}
+//	})
+//
+// SendMessage returns the first error by default. WithSendRetry retries
+// transient failures with the poller's backoff, so a reply sent during a
+// network blip is not lost; 4xx errors, such as a message that is too long,
+// are never retried:
+//
+//	poller := streaming.NewLiveChatPoller(client, liveChatID, streaming.WithSendRetry(3))
+//	bot, err := streaming.NewChatBotClient(client, tokenProvider, liveChatID,
+//		streaming.WithPoller(poller),
+//	)
+//
+// For at-least-once processing, OnMessageAck handlers return an error
+// instead of nil to refuse a message. The page token is then only committed,
+// and passed to OnCheckpoint for persisting, once the whole batch is
+// acknowledged; otherwise the batch is delivered again on the next poll, so
+// handlers must tolerate duplicates (e.g. a unique key on the message ID):
+//
+//	poller.OnMessageAck(func(msg *streaming.LiveChatMessage) error {
+//		return db.UpsertMessage(ctx, msg)
+//	})
+//	poller.OnCheckpoint(saveCheckpoint)
+//
+// The first poll returns recent chat from before the bot connected. To keep
+// a restarted command bot from running old commands, WithSkipBacklog drops
+// messages published before Start. Resuming from a saved page token is not
+// affected, since the messages after a checkpoint are ones the bot missed:
+//
+//	poller := streaming.NewLiveChatPoller(client, liveChatID, streaming.WithSkipBacklog(true))
+//	bot, err := streaming.NewChatBotClient(client, authClient, liveChatID, streaming.WithPoller(poller))
+//
 // # LiveChatStream (SSE)
 //
 // Server-Sent Events streaming for lower latency than polling:
@@ -68,6 +198,25 @@
 // SSE streaming provides automatic reconnection with token-based resumption.
 // Use PageToken/SetPageToken for manual resumption across sessions.
 //
+// For long-running streams, use WithStreamTokenProvider instead of a static
+// token so a fresh access token is fetched on every reconnect:
+//
+//	stream := streaming.NewLiveChatStream(client, liveChatID,
+//		streaming.WithStreamTokenProvider(authClient.AccessToken),
+//	)
+//
+// On networks that block long-lived connections, WithStreamFallbackToPolling
+// switches to liveChatMessages.list polling after repeated connection
+// failures, resuming from the last page token and honoring
+// pollingIntervalMillis. Note that each poll costs quota:
+//
+//	stream := streaming.NewLiveChatStream(client, liveChatID,
+//		streaming.WithStreamFallbackToPolling(true),
+//	)
+//	stream.OnFallback(func(err error) {
+//		log.Printf("SSE unavailable, polling instead: %v", err)
+//	})
+//
 // # Moderation
 //
 // Both clients support moderation actions:
@@ -76,6 +225,51 @@
 //	bot.Timeout(ctx, channelID, 300) // 5 minute timeout
 //	bot.Delete(ctx, messageID)
 //
+// ChatBotClient also changes chat modes, e.g. for a "!slowmode 5" command:
+//
+//	bot.SetSlowMode(ctx, 5000) // milliseconds between messages
+//	bot.SetMembersOnly(ctx, true)
+//	bot.SetNormalMode(ctx) // clear all restrictions
+//
+// After a raid, PurgeUser bans a user and deletes every message of theirs
+// still in the history buffer (see WithHistoryBuffer). Failed deletions are
+// reported per message in a *PurgeError:
+//
+//	for _, raider := range raiders {
+//		n, err := bot.PurgeUser(ctx, raider)
+//		log.Printf("purged %d messages from %s (err: %v)", n, raider, err)
+//	}
+//
+// Moderator changes made with AddModerator and RemoveModerator fire
+// OnModeratorAdded and OnModeratorRemoved. To also catch changes made
+// elsewhere, poll the moderator list (50 quota units per poll):
+//
+//	bot, err := streaming.NewChatBotClient(client, authClient, liveChatID,
+//		streaming.WithModeratorPollInterval(5*time.Minute),
+//	)
+//	bot.OnModeratorAdded(func(e *streaming.ModeratorEvent) {
+//		ui.AddMod(e.ID, e.Moderator.DisplayName)
+//	})
+//
+// If the ChatBotClient's token provider reports its granted scopes (as
+// *auth.AuthClient does), sending and moderation return
+// *auth.InsufficientScopeError without calling the API when the token lacks a
+// chat scope. Disable the check with WithScopeValidation(false).
+//
+// # Super Chat History
+//
+// GetAllSuperChatEvents fetches the past 30 days of Super Chats and Super
+// Stickers across all pages. SuperChatTotals sums them per currency:
+//
+//	events, err := streaming.GetAllSuperChatEvents(ctx, client, nil)
+//	for currency, micros := range streaming.SuperChatTotals(events) {
+//		fmt.Println(streaming.Amount{Micros: micros, Currency: currency})
+//	}
+//
+// Amount prints with the currency's decimal places, e.g. "USD 5.00" and
+// "JPY 500". SuperChatDetails.Amount and SuperChatEvent.Value return the
+// amount of a live Super Chat.
+//
 // # Handler Pattern
 //
 // Handlers return an unsubscribe function for cleanup:
@@ -96,6 +290,19 @@
 // Both LiveChatPoller and ChatBotClient are safe for concurrent use.
 // Handler registration and unsubscription can be done from any goroutine.
 //
+// # Testing
+//
+// The streamingtest package provides FakeBot, a connected ChatBotClient on a
+// fake live chat. Inject messages and Super Chats, then assert on what the
+// handlers sent:
+//
+//	fake := streamingtest.NewFakeBot(t)
+//	fake.Bot.OnMessage(myHandler)
+//	fake.InjectMessage(&streaming.ChatMessage{Message: "!ban UCspammer"})
+//	if got := fake.Banned(); len(got) != 1 {
+//		t.Errorf("Banned() = %v", got)
+//	}
+//
 // # Raw Message Access
 //
 // All event types include a Raw field containing the underlying
@@ -103,6 +310,28 @@
 // semantic types. Note that the Raw pointer is shared across handlers
 // for efficiency; if you need to modify it, use Clone() first.
 //
+// YouTube generally returns messages in chronological order, but does not
+// guarantee it. When display order matters, use SortedByPublishedAt:
+//
+//	poller.OnRawResponse(func(resp *streaming.LiveChatMessageListResponse) {
+//		for _, msg := range resp.SortedByPublishedAt() {
+//			overlay.Add(msg, msg.Age())
+//		}
+//	})
+//
+// Emojis arrive flattened into the text as ":shortcode:" (custom channel
+// emojis start with an underscore). TextSegments splits a message into text
+// runs and emojis, e.g. to draw emojis as images; the API does not send
+// emoji images, so map Emoji.ID to your channel's emoji artwork:
+//
+//	for _, seg := range msg.Raw.Snippet.TextSegments() {
+//		if seg.Emoji != nil {
+//			drawImage(emojiImages[seg.Emoji.ID])
+//		} else {
+//			drawText(seg.Text)
+//		}
+//	}
+//
 // # Broadcasts
 //
 // Retrieve live broadcast information:
@@ -119,6 +348,20 @@
 //	// Get live chat ID from broadcast
 //	liveChatID, err := streaming.GetBroadcastLiveChatID(ctx, client, "broadcast-id")
 //
+//	// Get viewer count and latency for a dashboard
+//	stats, err := streaming.GetBroadcastStats(ctx, client, "broadcast-id")
+//	fmt.Printf("%d viewers (%s latency)\n", stats.ConcurrentViewers, stats.LatencyPreference)
+//
+// Narrow a fetched list to a time window by scheduled start, e.g. for an
+// events calendar. The filters make no API calls:
+//
+//	resp, err := streaming.GetBroadcasts(ctx, client, &streaming.GetBroadcastsParams{
+//		BroadcastStatus: "upcoming",
+//		Mine:            true,
+//	})
+//	today := streaming.FilterUpcoming(resp.Items, 24*time.Hour)
+//	march := streaming.FilterByScheduledStart(resp.Items, marchStart, aprilStart)
+//
 // # Broadcast Management
 //
 // Create, update, and manage broadcast lifecycle:
@@ -129,19 +372,51 @@
 //			Title:              "My Stream",
 //			ScheduledStartTime: &startTime,
 //		},
-//		Status: &streaming.BroadcastStatus{PrivacyStatus: "unlisted"},
+//		Status: &streaming.BroadcastStatus{PrivacyStatus: streaming.BroadcastPrivacyUnlisted},
 //	}
 //	created, err := streaming.InsertBroadcast(ctx, client, broadcast, "snippet", "status")
 //
+//	// A missing title, schedule, or privacy status is caught before the call
+//	var invalid *core.ValidationError
+//	if errors.As(err, &invalid) {
+//		log.Printf("fix %s: %s", invalid.Field, invalid.Message)
+//	}
+//
 //	// Bind a stream to a broadcast
 //	bound, err := streaming.BindBroadcast(ctx, client, &streaming.BindBroadcastParams{
 //		BroadcastID: broadcastID,
 //		StreamID:    streamID,
 //	})
+//	var alreadyBound *streaming.StreamAlreadyBoundError
+//	var incompatible *streaming.IncompatibleStreamError
+//	switch {
+//	case errors.As(err, &alreadyBound):
+//		// stream is in use by another broadcast
+//	case errors.As(err, &incompatible):
+//		log.Printf("cannot bind: %s", incompatible.Reason)
+//	}
 //
 //	// Transition broadcast state
 //	broadcast, err = streaming.TransitionBroadcast(ctx, client, broadcastID, streaming.TransitionLive)
 //
+//	// Run a whole lifecycle, waiting for each state to settle
+//	broadcast, err = streaming.RunBroadcastLifecycle(ctx, client, broadcastID,
+//		[]string{streaming.TransitionTesting, streaming.TransitionLive},
+//		streaming.WithWaitForState(true),
+//	)
+//
+// # Ad Breaks
+//
+// Insert a mid-roll ad break and wait for it to finish. Ads require a
+// monetized channel, monetization enabled on the broadcast, and a live
+// broadcast; otherwise CuepointIneligibleError is returned:
+//
+//	cp, err := streaming.InsertImmediateCuepoint(ctx, client, broadcastID)
+//	result, err := streaming.WaitForCuepoint(ctx, client, cp)
+//	if result.Status == streaming.CuepointInterrupted {
+//		// broadcast ended during the break
+//	}
+//
 // # Stream Management
 //
 // Create and manage live streams (the video feed):
@@ -158,11 +433,27 @@
 //	streamKey := stream.StreamKey()
 //	rtmpURL := stream.RTMPUrl()
 //
+//	// Redundant ingest: backup endpoints, or a single paste-ready URL
+//	backupURL := stream.BackupRTMPSUrl()
+//	serverURL := stream.FullIngestURL(false, true) // primary RTMPS + key
+//
+//	// Reuse the channel's default stream key across broadcasts
+//	defaultStream, err := streaming.GetDefaultStream(ctx, client) // nil if none
+//
 //	// Check stream health
 //	if stream.IsHealthy() {
 //		fmt.Println("Stream is healthy")
 //	}
 //
+// Live captions are posted to the stream's caption ingestion URL, which is
+// empty unless the broadcast uses HTTP POST captions:
+//
+//	if captionsURL := stream.CaptionsIngestURL(); captionsURL != "" {
+//		captions, err := streaming.NewCaptionSender(captionsURL)
+//		// ...
+//		err = captions.Send(ctx, "Welcome!")
+//	}
+//
 // # StreamController (High-Level)
 //
 // For common streaming workflows, use StreamController:
@@ -184,4 +475,21 @@
 //	controller.StartTesting(ctx, result.Broadcast.ID)
 //	controller.GoLive(ctx, result.Broadcast.ID)
 //	controller.EndBroadcast(ctx, result.Broadcast.ID)
+//
+// After a crash, find broadcasts left in the created state without a bound
+// stream. Reconcile only reports them unless WithReconcileDelete is set:
+//
+//	orphans, err := controller.Reconcile(ctx)
+//	// Review orphans, then:
+//	_, err = controller.Reconcile(ctx, streaming.WithReconcileDelete())
+//
+// With a hot-standby ingest, AutoRebindOnError watches a live broadcast and
+// rebinds it to the backup stream if the bound stream fails, giving up after
+// WithRebindMaxAttempts rebinds:
+//
+//	err := controller.AutoRebindOnError(ctx, broadcastID, backupStreamID,
+//		streaming.WithOnRebind(func(e *streaming.RebindEvent) {
+//			log.Printf("failover %s -> %s: %v", e.FromStreamID, e.ToStreamID, e.Err)
+//		}),
+//	)
 package streaming
@@ -0,0 +1,163 @@
+package streaming
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultEventBuffer is the default capacity of the channel returned by
+// LiveChatPoller.Events.
+const DefaultEventBuffer = 100
+
+// ChatEventType identifies the kind of a ChatEvent.
+type ChatEventType int
+
+// Chat event types.
+const (
+	// ChatEventMessage carries a chat message in ChatEvent.Message.
+	ChatEventMessage ChatEventType = iota + 1
+
+	// ChatEventDelete carries a deleted message ID in ChatEvent.DeletedMessageID.
+	ChatEventDelete
+
+	// ChatEventBan carries ban details in ChatEvent.Ban.
+	ChatEventBan
+
+	// ChatEventPoll marks a completed poll, with ChatEvent.MessageCount and
+	// ChatEvent.NextPoll set.
+	ChatEventPoll
+
+	// ChatEventError carries a polling error in ChatEvent.Err.
+	ChatEventError
+)
+
+// String returns the event type name.
+func (t ChatEventType) String() string {
+	switch t {
+	case ChatEventMessage:
+		return "message"
+	case ChatEventDelete:
+		return "delete"
+	case ChatEventBan:
+		return "ban"
+	case ChatEventPoll:
+		return "poll"
+	case ChatEventError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// ChatEvent is an event from LiveChatPoller.Events. Type determines which
+// fields are set.
+type ChatEvent struct {
+	// Type is the kind of event.
+	Type ChatEventType
+
+	// Message is the chat message (ChatEventMessage).
+	Message *LiveChatMessage
+
+	// DeletedMessageID is the ID of the deleted message (ChatEventDelete).
+	DeletedMessageID string
+
+	// Ban contains the ban details (ChatEventBan).
+	Ban *UserBannedDetails
+
+	// MessageCount is the number of messages in the poll (ChatEventPoll).
+	MessageCount int
+
+	// NextPoll is the delay before the next poll (ChatEventPoll).
+	NextPoll time.Duration
+
+	// Err is the polling error (ChatEventError).
+	Err error
+}
+
+// WithEventBuffer sets the capacity of the channel returned by Events.
+// Default is DefaultEventBuffer.
+func WithEventBuffer(n int) PollerOption {
+	return func(p *LiveChatPoller) {
+		if n >= 0 {
+			p.eventBuffer = n
+		}
+	}
+}
+
+// Events returns a channel of chat events, as an alternative to OnMessage,
+// OnDelete, OnBan, OnPollComplete, and OnError for select-based processing.
+// Registered handlers still run; each event is sent after its handlers.
+//
+//	events := poller.Events()
+//	_ = poller.Start(ctx)
+//	for ev := range events {
+//		switch ev.Type {
+//		case streaming.ChatEventMessage:
+//			log.Println(ev.Message.Snippet.DisplayMessage)
+//		case streaming.ChatEventError:
+//			log.Println(ev.Err)
+//		}
+//	}
+//
+// The channel is buffered (see WithEventBuffer). When the buffer is full the
+// poller waits for the consumer before polling again, so a slow consumer
+// slows polling rather than losing events. Keep receiving until the channel
+// is closed, or call Stop, which never blocks on the channel.
+//
+// The channel is closed when the poller stops, whether by Stop, context
+// cancellation, or the chat ending. Events returns the same channel until
+// then; after a restart, call Events again for a new channel.
+func (p *LiveChatPoller) Events() <-chan ChatEvent {
+	p.eventsMu.Lock()
+	defer p.eventsMu.Unlock()
+
+	if p.events == nil {
+		p.events = make(chan ChatEvent, p.eventBuffer)
+	}
+	return p.events
+}
+
+// emitMessages sends an event for each message, classified the same way as
+// dispatchMessages.
+func (p *LiveChatPoller) emitMessages(ctx context.Context, messages []*LiveChatMessage) {
+	for _, msg := range messages {
+		switch {
+		case msg.Type() == MessageTypeMessageDeleted && msg.Snippet != nil && msg.Snippet.MessageDeletedDetails != nil:
+			p.emitEvent(ctx, ChatEvent{
+				Type:             ChatEventDelete,
+				DeletedMessageID: msg.Snippet.MessageDeletedDetails.DeletedMessageID,
+			})
+		case msg.Type() == MessageTypeUserBanned && msg.Snippet != nil && msg.Snippet.UserBannedDetails != nil:
+			p.emitEvent(ctx, ChatEvent{Type: ChatEventBan, Ban: msg.Snippet.UserBannedDetails})
+		default:
+			p.emitEvent(ctx, ChatEvent{Type: ChatEventMessage, Message: msg})
+		}
+	}
+}
+
+// emitEvent sends ev if Events has been called, waiting for buffer space
+// until ctx is done. Only called from the poll loop.
+func (p *LiveChatPoller) emitEvent(ctx context.Context, ev ChatEvent) {
+	p.eventsMu.Lock()
+	ch := p.events
+	p.eventsMu.Unlock()
+
+	if ch == nil {
+		return
+	}
+	select {
+	case ch <- ev:
+	case <-ctx.Done():
+	}
+}
+
+// closeEvents closes the event channel, if any, when the poll loop exits.
+func (p *LiveChatPoller) closeEvents() {
+	p.eventsMu.Lock()
+	defer p.eventsMu.Unlock()
+
+	if p.events != nil {
+		close(p.events)
+		p.events = nil
+	}
+}
@@ -0,0 +1,202 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestLiveChatPoller_Events(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch polls.Add(1) {
+		case 1:
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+				PollingIntervalMillis: 1,
+				Items: []*LiveChatMessage{
+					{ID: "msg1", Snippet: &MessageSnippet{Type: MessageTypeText}},
+					{ID: "del1", Snippet: &MessageSnippet{
+						Type:                  MessageTypeMessageDeleted,
+						MessageDeletedDetails: &MessageDeletedDetails{DeletedMessageID: "msg0"},
+					}},
+					{ID: "ban1", Snippet: &MessageSnippet{
+						Type:              MessageTypeUserBanned,
+						UserBannedDetails: &UserBannedDetails{BanType: "permanent"},
+					}},
+				},
+			})
+		case 2:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"code":500,"message":"backend error"}}`))
+		default:
+			// Chat ended: the poller stops and closes the channel.
+			now := time.Now()
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{OfflineAt: &now})
+		}
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123",
+		WithMinPollInterval(time.Millisecond),
+		WithBackoff(core.NewBackoffConfig(core.WithBaseDelay(time.Millisecond))),
+	)
+
+	var handled atomic.Int32
+	poller.OnMessage(func(*LiveChatMessage) { handled.Add(1) })
+
+	events := poller.Events()
+	if poller.Events() != events {
+		t.Error("Events() returned a different channel before the poller stopped")
+	}
+	if err := poller.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer poller.Stop()
+
+	var got []ChatEvent
+	timeout := time.After(2 * time.Second)
+	for done := false; !done; {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				done = true
+				break
+			}
+			got = append(got, ev)
+		case <-timeout:
+			t.Fatalf("channel not closed; got %d events", len(got))
+		}
+	}
+
+	wantTypes := []ChatEventType{
+		ChatEventMessage, ChatEventDelete, ChatEventBan, ChatEventPoll,
+		ChatEventError, // server error
+		ChatEventError, // chat ended
+	}
+	if len(got) != len(wantTypes) {
+		t.Fatalf("got %d events %v, want %d", len(got), got, len(wantTypes))
+	}
+	for i, want := range wantTypes {
+		if got[i].Type != want {
+			t.Errorf("event[%d].Type = %v, want %v", i, got[i].Type, want)
+		}
+	}
+
+	if got[0].Message == nil || got[0].Message.ID != "msg1" {
+		t.Errorf("message event = %+v", got[0])
+	}
+	if got[1].DeletedMessageID != "msg0" {
+		t.Errorf("DeletedMessageID = %q, want msg0", got[1].DeletedMessageID)
+	}
+	if got[2].Ban == nil || got[2].Ban.BanType != "permanent" {
+		t.Errorf("ban event = %+v", got[2])
+	}
+	if got[3].MessageCount != 3 {
+		t.Errorf("MessageCount = %d, want 3", got[3].MessageCount)
+	}
+	var chatEnded *core.ChatEndedError
+	if !errors.As(got[5].Err, &chatEnded) {
+		t.Errorf("last event error = %v, want *core.ChatEndedError", got[5].Err)
+	}
+	if handled.Load() != 1 {
+		t.Errorf("OnMessage called %d times, want 1 (handlers still run)", handled.Load())
+	}
+}
+
+func TestLiveChatPoller_Events_StopWithFullBuffer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+			PollingIntervalMillis: 1,
+			Items:                 []*LiveChatMessage{{ID: "msg", Snippet: &MessageSnippet{Type: MessageTypeText}}},
+		})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123",
+		WithMinPollInterval(time.Millisecond),
+		WithEventBuffer(1),
+	)
+
+	events := poller.Events()
+	_ = poller.Start(context.Background())
+
+	// Nobody reads: the poller blocks on the full buffer, but Stop must
+	// still return and close the channel.
+	time.Sleep(20 * time.Millisecond)
+	stopped := make(chan struct{})
+	go func() {
+		poller.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop() blocked on a full event channel")
+	}
+
+	// Drain the buffered event, then expect the channel to be closed.
+	for range events {
+	}
+
+	// A new channel is created after the poller stops.
+	if poller.Events() == events {
+		t.Error("Events() returned the closed channel after Stop()")
+	}
+}
+
+func TestLiveChatPoller_Events_NotRequested(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+			PollingIntervalMillis: 1,
+			Items:                 []*LiveChatMessage{{ID: "msg", Snippet: &MessageSnippet{Type: MessageTypeText}}},
+		})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123", WithMinPollInterval(time.Millisecond))
+
+	var polls atomic.Int32
+	poller.OnPollComplete(func(int, time.Duration) { polls.Add(1) })
+
+	// Without Events, polling never blocks on an unread channel.
+	_ = poller.Start(context.Background())
+	time.Sleep(30 * time.Millisecond)
+	poller.Stop()
+
+	if polls.Load() < 2 {
+		t.Errorf("polls = %d, want polling to continue without an events consumer", polls.Load())
+	}
+}
+
+func TestChatEventType_String(t *testing.T) {
+	tests := []struct {
+		typ  ChatEventType
+		want string
+	}{
+		{ChatEventMessage, "message"},
+		{ChatEventDelete, "delete"},
+		{ChatEventBan, "ban"},
+		{ChatEventPoll, "poll"},
+		{ChatEventError, "error"},
+		{ChatEventType(0), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.typ.String(); got != tt.want {
+			t.Errorf("%d.String() = %q, want %q", tt.typ, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// Default settings for StreamController.AutoRebindOnError.
+const (
+	// DefaultRebindPollInterval is how often the bound stream is checked.
+	DefaultRebindPollInterval = 15 * time.Second
+
+	// DefaultRebindMaxAttempts is how many rebinds are attempted before
+	// giving up.
+	DefaultRebindMaxAttempts = 3
+)
+
+// RebindOption configures StreamController.AutoRebindOnError.
+type RebindOption func(*rebindConfig)
+
+// rebindConfig holds AutoRebindOnError settings.
+type rebindConfig struct {
+	pollInterval time.Duration
+	maxAttempts  int
+	onRebind     []func(*RebindEvent)
+}
+
+// WithRebindPollInterval sets how often the broadcast and its bound stream
+// are checked. Default is 15 seconds.
+func WithRebindPollInterval(d time.Duration) RebindOption {
+	return func(c *rebindConfig) {
+		if d > 0 {
+			c.pollInterval = d
+		}
+	}
+}
+
+// WithRebindMaxAttempts sets how many rebinds are attempted, successful or
+// not, before AutoRebindOnError gives up. Default is 3.
+func WithRebindMaxAttempts(n int) RebindOption {
+	return func(c *rebindConfig) {
+		if n > 0 {
+			c.maxAttempts = n
+		}
+	}
+}
+
+// WithOnRebind registers a function called after each rebind attempt.
+// It runs on the watcher's goroutine, so it should return quickly.
+func WithOnRebind(fn func(*RebindEvent)) RebindOption {
+	return func(c *rebindConfig) {
+		if fn != nil {
+			c.onRebind = append(c.onRebind, fn)
+		}
+	}
+}
+
+// RebindEvent describes a rebind attempt made by AutoRebindOnError.
+type RebindEvent struct {
+	// BroadcastID is the broadcast being watched.
+	BroadcastID string
+
+	// FromStreamID is the failed stream that was bound.
+	FromStreamID string
+
+	// ToStreamID is the stream the broadcast was rebound to.
+	ToStreamID string
+
+	// Attempt is the attempt number, starting at 1.
+	Attempt int
+
+	// Broadcast is the rebound broadcast, or nil if the attempt failed.
+	Broadcast *LiveBroadcast
+
+	// Err is the bind error, or nil if the attempt succeeded. It is a
+	// *core.StreamNotHealthyError if the target stream was not active and
+	// healthy, in which case the broadcast was not rebound.
+	Err error
+}
+
+// RebindError indicates that AutoRebindOnError gave up: it used all its
+// attempts, or the failed stream had no other stream to fail over to.
+type RebindError struct {
+	// BroadcastID is the broadcast being watched.
+	BroadcastID string
+
+	// Attempts is the number of rebinds attempted.
+	Attempts int
+
+	// Err is the last failure.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *RebindError) Error() string {
+	return fmt.Sprintf("broadcast %s: giving up stream failover after %d attempts: %v", e.BroadcastID, e.Attempts, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RebindError) Unwrap() error {
+	return e.Err
+}
+
+// AutoRebindOnError watches a broadcast and fails over to a hot-standby
+// stream when the bound stream enters the "error" state, so the broadcast
+// does not keep running on a dead feed:
+//
+//	err := controller.AutoRebindOnError(ctx, broadcastID, backupStreamID,
+//		streaming.WithOnRebind(func(e *streaming.RebindEvent) {
+//			log.Printf("failover %s -> %s: %v", e.FromStreamID, e.ToStreamID, e.Err)
+//		}),
+//	)
+//
+// The stream bound when watching starts is the primary. If the backup fails
+// in turn, the broadcast is rebound to the primary. Before each rebind the
+// target stream is checked: if it is not active with good or ok health
+// (LiveStream.IsHealthy), the broadcast stays where it is and the attempt
+// fails, so the watcher never switches to a dead stream. Each rebind attempt
+// fires the WithOnRebind callbacks.
+//
+// It blocks until the broadcast is complete or revoked (returning nil), ctx
+// is done, a non-retryable API error occurs, or it gives up with a
+// *RebindError after WithRebindMaxAttempts rebinds. Transient API errors
+// are retried at the next check.
+//
+// Quota cost: 2 units per check, plus 51 units per rebind (1 to check the
+// target stream).
+func (c *StreamController) AutoRebindOnError(ctx context.Context, broadcastID, backupStreamID string, opts ...RebindOption) error {
+	if broadcastID == "" {
+		return fmt.Errorf("broadcast ID cannot be empty")
+	}
+	if backupStreamID == "" {
+		return fmt.Errorf("backup stream ID cannot be empty")
+	}
+
+	cfg := &rebindConfig{
+		pollInterval: DefaultRebindPollInterval,
+		maxAttempts:  DefaultRebindMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	ticker := time.NewTicker(cfg.pollInterval)
+	defer ticker.Stop()
+
+	primaryStreamID := ""
+	attempts := 0
+	for {
+		boundID, failed, done, err := c.checkBoundStream(ctx, broadcastID)
+		switch {
+		case err != nil && !core.IsRetryable(err):
+			return err
+		case done:
+			return nil
+		}
+
+		if primaryStreamID == "" && boundID != backupStreamID {
+			primaryStreamID = boundID
+		}
+
+		if failed {
+			target := backupStreamID
+			if boundID == backupStreamID {
+				target = primaryStreamID
+			}
+			if target == "" {
+				return &RebindError{BroadcastID: broadcastID, Attempts: attempts, Err: fmt.Errorf("stream %s failed and no other stream is available", boundID)}
+			}
+			if attempts >= cfg.maxAttempts {
+				return &RebindError{BroadcastID: broadcastID, Attempts: attempts, Err: fmt.Errorf("stream %s failed", boundID)}
+			}
+
+			attempts++
+			event := &RebindEvent{BroadcastID: broadcastID, FromStreamID: boundID, ToStreamID: target, Attempt: attempts}
+			if event.Err = c.checkRebindTarget(ctx, target); event.Err == nil {
+				event.Broadcast, event.Err = BindBroadcast(ctx, c.client, &BindBroadcastParams{
+					BroadcastID: broadcastID,
+					StreamID:    target,
+					Parts:       []string{"snippet", "status", "contentDetails"},
+				})
+			}
+			for _, fn := range cfg.onRebind {
+				fn(event)
+			}
+			if event.Err != nil && attempts >= cfg.maxAttempts {
+				return &RebindError{BroadcastID: broadcastID, Attempts: attempts, Err: event.Err}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkBoundStream returns the broadcast's bound stream and whether it has
+// failed. done is true once the broadcast is complete or revoked.
+func (c *StreamController) checkBoundStream(ctx context.Context, broadcastID string) (boundID string, failed, done bool, err error) {
+	if err := c.refreshToken(ctx); err != nil {
+		return "", false, false, fmt.Errorf("refreshing token: %w", err)
+	}
+
+	b, err := GetBroadcast(ctx, c.client, broadcastID, "status", "contentDetails")
+	if err != nil {
+		return "", false, false, err
+	}
+	switch lifeCycleStatus(b) {
+	case BroadcastStatusComplete, BroadcastStatusRevoked:
+		return "", false, true, nil
+	}
+
+	boundID = b.BoundStreamID()
+	if boundID == "" {
+		return "", false, false, nil
+	}
+
+	stream, err := GetStream(ctx, c.client, boundID, "status")
+	var notFound *core.NotFoundError
+	if errors.As(err, &notFound) {
+		// A deleted stream is as dead as a failed one.
+		return boundID, true, false, nil
+	}
+	if err != nil {
+		return boundID, false, false, err
+	}
+	return boundID, stream.Status != nil && stream.Status.StreamStatus == StreamStatusError, false, nil
+}
+
+// checkRebindTarget returns a *core.StreamNotHealthyError unless the stream
+// is active and healthy.
+func (c *StreamController) checkRebindTarget(ctx context.Context, streamID string) error {
+	stream, err := GetStream(ctx, c.client, streamID, "status")
+	if err != nil {
+		return fmt.Errorf("checking stream %s: %w", streamID, err)
+	}
+	if stream.IsActive() && stream.IsHealthy() {
+		return nil
+	}
+
+	notHealthy := &core.StreamNotHealthyError{StreamID: streamID}
+	if stream.Status != nil {
+		notHealthy.Status = stream.Status.StreamStatus
+		if stream.Status.HealthStatus != nil {
+			notHealthy.Issues = []string{"health: " + stream.Status.HealthStatus.Status}
+		}
+	}
+	return notHealthy
+}
@@ -0,0 +1,276 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// failoverServer simulates a broadcast with a bound stream for
+// AutoRebindOnError tests.
+type failoverServer struct {
+	mu        sync.Mutex
+	checks    int
+	bound     string
+	lifecycle string
+	streams   map[string]string // stream ID to stream status
+	unhealthy map[string]bool   // active streams reporting bad health
+	bindErr   bool
+	forbidden bool
+
+	// step is called before each broadcast check to change the state.
+	step func(s *failoverServer)
+}
+
+func (s *failoverServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/liveBroadcasts":
+		s.checks++
+		if s.step != nil {
+			s.step(s)
+		}
+		if s.forbidden {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"Forbidden"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(LiveBroadcastListResponse{Items: []*LiveBroadcast{s.broadcast()}})
+	case "/liveStreams":
+		id := r.URL.Query().Get("id")
+		resp := LiveStreamListResponse{}
+		if status, ok := s.streams[id]; ok {
+			health := StreamHealthGood
+			if status != StreamStatusActive || s.unhealthy[id] {
+				health = StreamHealthBad
+			}
+			resp.Items = []*LiveStream{{ID: id, Status: &StreamStatus{
+				StreamStatus: status,
+				HealthStatus: &StreamHealthStatus{Status: health},
+			}}}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	case "/liveBroadcasts/bind":
+		if s.bindErr {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"code":500,"message":"Backend Error"}}`))
+			return
+		}
+		s.bound = r.URL.Query().Get("streamId")
+		_ = json.NewEncoder(w).Encode(s.broadcast())
+	default:
+		http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+	}
+}
+
+func (s *failoverServer) broadcast() *LiveBroadcast {
+	return &LiveBroadcast{
+		ID:             "b1",
+		Status:         &BroadcastStatus{LifeCycleStatus: s.lifecycle},
+		ContentDetails: &BroadcastContentDetails{BoundStreamID: s.bound},
+	}
+}
+
+func TestStreamController_AutoRebindOnError(t *testing.T) {
+	tests := []struct {
+		name        string
+		server      *failoverServer
+		opts        []RebindOption
+		wantEvents  []string // "from->to" or "from->to!" for failed binds
+		wantAttempt int      // RebindError attempts, or -1 for no RebindError
+		wantErr     func(error) bool
+		wantBound   string
+	}{
+		{
+			name: "fails over to backup",
+			server: &failoverServer{bound: "primary", lifecycle: "live", streams: map[string]string{"primary": "active", "backup": "active"},
+				step: func(s *failoverServer) {
+					switch s.checks {
+					case 2:
+						s.streams["primary"] = "error"
+					case 4:
+						s.lifecycle = "complete"
+					}
+				}},
+			wantEvents:  []string{"primary->backup"},
+			wantAttempt: -1,
+			wantBound:   "backup",
+		},
+		{
+			name: "backup failure rebinds to primary",
+			server: &failoverServer{bound: "primary", lifecycle: "live", streams: map[string]string{"primary": "active", "backup": "active"},
+				step: func(s *failoverServer) {
+					switch s.checks {
+					case 2:
+						s.streams["primary"] = "error"
+					case 3:
+						s.streams["primary"] = "active"
+						s.streams["backup"] = "error"
+					case 5:
+						s.lifecycle = "complete"
+					}
+				}},
+			wantEvents:  []string{"primary->backup", "backup->primary"},
+			wantAttempt: -1,
+			wantBound:   "primary",
+		},
+		{
+			name:        "deleted stream counts as failed",
+			server:      &failoverServer{bound: "primary", lifecycle: "live", streams: map[string]string{"backup": "active"}, step: completeAfter(3)},
+			wantEvents:  []string{"primary->backup"},
+			wantAttempt: -1,
+			wantBound:   "backup",
+		},
+		{
+			name:        "gives up after failed binds",
+			server:      &failoverServer{bound: "primary", lifecycle: "live", streams: map[string]string{"primary": "error", "backup": "active"}, bindErr: true},
+			opts:        []RebindOption{WithRebindMaxAttempts(2)},
+			wantEvents:  []string{"primary->backup!", "primary->backup!"},
+			wantAttempt: 2,
+			wantBound:   "primary",
+		},
+		{
+			name:        "does not bind a failed backup",
+			server:      &failoverServer{bound: "primary", lifecycle: "live", streams: map[string]string{"primary": "error", "backup": "error"}},
+			opts:        []RebindOption{WithRebindMaxAttempts(2)},
+			wantEvents:  []string{"primary->backup!", "primary->backup!"},
+			wantAttempt: 2,
+			wantBound:   "primary",
+		},
+		{
+			name: "waits for an unhealthy backup to recover",
+			server: &failoverServer{bound: "primary", lifecycle: "live", streams: map[string]string{"primary": "error", "backup": "active"},
+				unhealthy: map[string]bool{"backup": true},
+				step: func(s *failoverServer) {
+					switch s.checks {
+					case 2:
+						s.unhealthy["backup"] = false
+					case 4:
+						s.lifecycle = "complete"
+					}
+				}},
+			wantEvents:  []string{"primary->backup!", "primary->backup"},
+			wantAttempt: -1,
+			wantBound:   "backup",
+		},
+		{
+			name:        "no stream to fail over to",
+			server:      &failoverServer{bound: "backup", lifecycle: "live", streams: map[string]string{"backup": "error"}},
+			wantAttempt: 0,
+			wantBound:   "backup",
+		},
+		{
+			name:        "non-retryable error",
+			server:      &failoverServer{forbidden: true},
+			wantAttempt: -1,
+			wantErr: func(err error) bool {
+				var apiErr *core.APIError
+				return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.server)
+			defer server.Close()
+
+			controller, _ := NewStreamController(core.NewClient(core.WithBaseURL(server.URL)), nil)
+
+			var events []string
+			opts := append([]RebindOption{
+				WithRebindPollInterval(time.Millisecond),
+				WithOnRebind(func(e *RebindEvent) {
+					event := e.FromStreamID + "->" + e.ToStreamID
+					if e.Err != nil {
+						event += "!"
+					} else if e.Broadcast == nil || e.Broadcast.BoundStreamID() != e.ToStreamID {
+						t.Errorf("event broadcast = %+v, want bound to %s", e.Broadcast, e.ToStreamID)
+					}
+					if e.Attempt != len(events)+1 {
+						t.Errorf("Attempt = %d, want %d", e.Attempt, len(events)+1)
+					}
+					events = append(events, event)
+				}),
+			}, tt.opts...)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			err := controller.AutoRebindOnError(ctx, "b1", "backup", opts...)
+
+			var rebindErr *RebindError
+			switch {
+			case tt.wantErr != nil:
+				if !tt.wantErr(err) {
+					t.Errorf("AutoRebindOnError() error = %v", err)
+				}
+			case tt.wantAttempt >= 0:
+				if !errors.As(err, &rebindErr) || rebindErr.Attempts != tt.wantAttempt {
+					t.Errorf("AutoRebindOnError() error = %v, want *RebindError after %d attempts", err, tt.wantAttempt)
+				}
+			case err != nil:
+				t.Errorf("AutoRebindOnError() error = %v", err)
+			}
+
+			if fmt.Sprint(events) != fmt.Sprint(tt.wantEvents) {
+				t.Errorf("events = %v, want %v", events, tt.wantEvents)
+			}
+			if tt.server.bound != tt.wantBound {
+				t.Errorf("bound stream = %q, want %q", tt.server.bound, tt.wantBound)
+			}
+		})
+	}
+}
+
+// completeAfter returns a failoverServer step that completes the broadcast
+// at the given check.
+func completeAfter(check int) func(s *failoverServer) {
+	return func(s *failoverServer) {
+		if s.checks == check {
+			s.lifecycle = "complete"
+		}
+	}
+}
+
+func TestStreamController_AutoRebindOnError_Context(t *testing.T) {
+	server := httptest.NewServer(&failoverServer{bound: "primary", lifecycle: "live", streams: map[string]string{"primary": "active"}})
+	defer server.Close()
+
+	controller, _ := NewStreamController(core.NewClient(core.WithBaseURL(server.URL)), nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := controller.AutoRebindOnError(ctx, "b1", "backup", WithRebindPollInterval(time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("AutoRebindOnError() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	if err := controller.AutoRebindOnError(ctx, "", "backup"); err == nil {
+		t.Error("expected error for empty broadcast ID")
+	}
+	if err := controller.AutoRebindOnError(ctx, "b1", ""); err == nil {
+		t.Error("expected error for empty backup stream ID")
+	}
+}
+
+func TestRebindError_Error(t *testing.T) {
+	err := &RebindError{BroadcastID: "b1", Attempts: 3, Err: errors.New("stream s1 failed")}
+	want := "broadcast b1: giving up stream failover after 3 attempts: stream s1 failed"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if !errors.Is(err, err.Err) {
+		t.Error("RebindError should unwrap to its cause")
+	}
+}
@@ -0,0 +1,104 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// StartHeartbeat posts message to the chat every interval, e.g. a periodic
+// "bot is online" status. It replaces any running heartbeat and stops when
+// ctx is cancelled, on Close or Shutdown, or when the bot disconnects.
+//
+// A beat is skipped if Say sent a message within the last interval, so the
+// heartbeat only fills silence. After a rate limit error the heartbeat also
+// skips beats until the server's Retry-After has passed. Send errors are
+// reported to the OnError handlers.
+//
+//	if err := bot.StartHeartbeat(ctx, 10*time.Minute, "Bot is online. Type !help for commands."); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// Each heartbeat costs liveChatMessages.insert quota (50 units).
+func (c *ChatBotClient) StartHeartbeat(ctx context.Context, interval time.Duration, message string) error {
+	if interval <= 0 {
+		return fmt.Errorf("heartbeat interval must be positive")
+	}
+	if message == "" {
+		return fmt.Errorf("heartbeat message cannot be empty")
+	}
+	if !c.IsConnected() {
+		return ErrNotRunning
+	}
+
+	c.stopHeartbeat()
+
+	c.heartbeatMu.Lock()
+	defer c.heartbeatMu.Unlock()
+	c.heartbeatStop = make(chan struct{})
+	c.heartbeatDone = make(chan struct{})
+	go c.heartbeatLoop(ctx, interval, message, c.heartbeatStop, c.heartbeatDone)
+	return nil
+}
+
+// StopHeartbeat stops the heartbeat started by StartHeartbeat, if any.
+func (c *ChatBotClient) StopHeartbeat() {
+	c.stopHeartbeat()
+}
+
+// stopHeartbeat stops the heartbeat loop if running.
+func (c *ChatBotClient) stopHeartbeat() {
+	c.heartbeatMu.Lock()
+	stop, done := c.heartbeatStop, c.heartbeatDone
+	c.heartbeatStop = nil
+	c.heartbeatDone = nil
+	c.heartbeatMu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// heartbeatLoop posts the heartbeat message on each tick.
+func (c *ChatBotClient) heartbeatLoop(ctx context.Context, interval time.Duration, message string, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var retryAt time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if now.Before(retryAt) || c.sentWithin(now, interval) {
+				continue
+			}
+
+			err := c.send(ctx, message)
+			if errors.Is(err, ErrNotRunning) || errors.Is(err, ErrShuttingDown) {
+				return
+			}
+			if err != nil {
+				var rateErr *core.RateLimitError
+				if errors.As(err, &rateErr) {
+					retryAt = now.Add(rateErr.RetryAfter)
+				}
+				c.dispatchError(fmt.Errorf("heartbeat: %w", err))
+			}
+		}
+	}
+}
+
+// sentWithin reports whether Say sent a message within d before now.
+func (c *ChatBotClient) sentWithin(now time.Time, d time.Duration) bool {
+	last := c.lastManualSend.Load()
+	return last != 0 && now.Sub(time.Unix(0, last)) < d
+}
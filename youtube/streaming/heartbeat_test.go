@@ -0,0 +1,178 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// newHeartbeatServer returns a server that counts sent messages and answers
+// polls with an empty response. If rateLimited is set, sends fail with a
+// rateLimitExceeded error.
+func newHeartbeatServer(sent *atomic.Int32, rateLimited bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/liveChat/messages" && r.Method == http.MethodPost {
+			sent.Add(1)
+			if rateLimited {
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":{"code":403,"message":"rate limited","errors":[{"reason":"rateLimitExceeded"}]}}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(LiveChatMessage{ID: "sent"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 5000})
+	}))
+}
+
+func connectHeartbeatBot(t *testing.T, serverURL string) *ChatBotClient {
+	t.Helper()
+	client := core.NewClient(core.WithBaseURL(serverURL))
+	bot, _ := NewChatBotClient(client, nil, "chat123")
+	if err := bot.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	return bot
+}
+
+func TestChatBotClient_StartHeartbeat(t *testing.T) {
+	var sent atomic.Int32
+	server := newHeartbeatServer(&sent, false)
+	defer server.Close()
+
+	bot := connectHeartbeatBot(t, server.URL)
+
+	if err := bot.StartHeartbeat(context.Background(), 20*time.Millisecond, "still here"); err != nil {
+		t.Fatalf("StartHeartbeat() error = %v", err)
+	}
+	time.Sleep(110 * time.Millisecond)
+	_ = bot.Close()
+
+	got := sent.Load()
+	if got < 3 {
+		t.Errorf("sent %d heartbeats, want at least 3", got)
+	}
+
+	// Close stops the heartbeat.
+	time.Sleep(60 * time.Millisecond)
+	if after := sent.Load(); after != got {
+		t.Errorf("sent %d heartbeats after Close, want 0", after-got)
+	}
+}
+
+func TestChatBotClient_StartHeartbeat_ContextCancel(t *testing.T) {
+	var sent atomic.Int32
+	server := newHeartbeatServer(&sent, false)
+	defer server.Close()
+
+	bot := connectHeartbeatBot(t, server.URL)
+	defer func() { _ = bot.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := bot.StartHeartbeat(ctx, 20*time.Millisecond, "still here"); err != nil {
+		t.Fatalf("StartHeartbeat() error = %v", err)
+	}
+	cancel()
+	time.Sleep(60 * time.Millisecond)
+
+	if got := sent.Load(); got != 0 {
+		t.Errorf("sent %d heartbeats after cancel, want 0", got)
+	}
+}
+
+func TestChatBotClient_StartHeartbeat_SkipsAfterManualSend(t *testing.T) {
+	var sent atomic.Int32
+	server := newHeartbeatServer(&sent, false)
+	defer server.Close()
+
+	bot := connectHeartbeatBot(t, server.URL)
+	defer func() { _ = bot.Close() }()
+
+	ctx := context.Background()
+	if err := bot.StartHeartbeat(ctx, 50*time.Millisecond, "still here"); err != nil {
+		t.Fatalf("StartHeartbeat() error = %v", err)
+	}
+
+	// Keep chatting more often than the interval; no beat should be needed.
+	for range 6 {
+		if err := bot.Say(ctx, "manual"); err != nil {
+			t.Fatalf("Say() error = %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	bot.StopHeartbeat()
+
+	if got := sent.Load(); got != 6 {
+		t.Errorf("sent %d messages, want 6 (no heartbeats)", got)
+	}
+}
+
+func TestChatBotClient_StartHeartbeat_RateLimited(t *testing.T) {
+	var sent atomic.Int32
+	server := newHeartbeatServer(&sent, true)
+	defer server.Close()
+
+	bot := connectHeartbeatBot(t, server.URL)
+	defer func() { _ = bot.Close() }()
+
+	var heartbeatErr atomic.Value
+	bot.OnError(func(err error) { heartbeatErr.Store(err) })
+
+	if err := bot.StartHeartbeat(context.Background(), 10*time.Millisecond, "still here"); err != nil {
+		t.Fatalf("StartHeartbeat() error = %v", err)
+	}
+	time.Sleep(80 * time.Millisecond)
+	bot.StopHeartbeat()
+
+	// Retry-After is 60s, so only the first beat is attempted.
+	if got := sent.Load(); got != 1 {
+		t.Errorf("attempted %d heartbeats, want 1", got)
+	}
+	err, _ := heartbeatErr.Load().(error)
+	var rateErr *core.RateLimitError
+	if !errors.As(err, &rateErr) || !strings.HasPrefix(err.Error(), "heartbeat: ") {
+		t.Errorf("OnError got %v, want wrapped *core.RateLimitError", err)
+	}
+}
+
+func TestChatBotClient_StartHeartbeat_Validation(t *testing.T) {
+	var sent atomic.Int32
+	server := newHeartbeatServer(&sent, false)
+	defer server.Close()
+
+	notConnected, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+	if err := notConnected.StartHeartbeat(context.Background(), time.Minute, "hi"); err != ErrNotRunning {
+		t.Errorf("StartHeartbeat() not connected error = %v, want ErrNotRunning", err)
+	}
+
+	bot := connectHeartbeatBot(t, server.URL)
+	defer func() { _ = bot.Close() }()
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		message  string
+	}{
+		{"zero interval", 0, "hi"},
+		{"negative interval", -time.Second, "hi"},
+		{"empty message", time.Minute, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := bot.StartHeartbeat(context.Background(), tt.interval, tt.message); err == nil {
+				t.Error("StartHeartbeat() expected error")
+			}
+		})
+	}
+}
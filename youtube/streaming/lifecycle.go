@@ -0,0 +1,149 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// DefaultLifecyclePollInterval is the default interval for polling broadcast
+// status while waiting for a transition to settle.
+const DefaultLifecyclePollInterval = 5 * time.Second
+
+// LifecycleOption configures RunBroadcastLifecycle.
+type LifecycleOption func(*lifecycleConfig)
+
+// lifecycleConfig holds RunBroadcastLifecycle settings.
+type lifecycleConfig struct {
+	waitForState bool
+	pollInterval time.Duration
+}
+
+// WithWaitForState makes RunBroadcastLifecycle poll the broadcast after each
+// transition until its lifecycle status reaches the requested state.
+// Default is false (transitions are issued back-to-back).
+func WithWaitForState(wait bool) LifecycleOption {
+	return func(c *lifecycleConfig) { c.waitForState = wait }
+}
+
+// WithLifecyclePollInterval sets how often the broadcast status is polled
+// while waiting for a state. Default is 5 seconds.
+func WithLifecyclePollInterval(d time.Duration) LifecycleOption {
+	return func(c *lifecycleConfig) {
+		if d > 0 {
+			c.pollInterval = d
+		}
+	}
+}
+
+// LifecycleError identifies the transition that failed in RunBroadcastLifecycle.
+type LifecycleError struct {
+	// BroadcastID is the broadcast being transitioned.
+	BroadcastID string
+
+	// Transition is the requested state that failed.
+	Transition string
+
+	// LastStatus is the last observed lifecycle status, if known.
+	LastStatus string
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *LifecycleError) Error() string {
+	return fmt.Sprintf("broadcast %s: transition to %s failed (last status: %s): %v",
+		e.BroadcastID, e.Transition, e.LastStatus, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *LifecycleError) Unwrap() error {
+	return e.Err
+}
+
+// RunBroadcastLifecycle transitions a broadcast through the given states in
+// order (e.g., testing, live, complete). With WithWaitForState(true), it polls
+// the broadcast after each transition until the state is reached before
+// issuing the next one.
+//
+// On failure it returns a *LifecycleError identifying the failed transition
+// and the last observed status. On success it returns the final broadcast.
+//
+// Requires OAuth authentication with youtube.force-ssl scope.
+// Quota cost: 50 units per transition, plus 1 unit per status poll.
+func RunBroadcastLifecycle(ctx context.Context, client *core.Client, broadcastID string, states []string, opts ...LifecycleOption) (*LiveBroadcast, error) {
+	if broadcastID == "" {
+		return nil, fmt.Errorf("broadcast ID cannot be empty")
+	}
+	if len(states) == 0 {
+		return nil, fmt.Errorf("states cannot be empty")
+	}
+
+	cfg := &lifecycleConfig{pollInterval: DefaultLifecyclePollInterval}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	var broadcast *LiveBroadcast
+	lastStatus := ""
+
+	for _, state := range states {
+		b, err := TransitionBroadcast(ctx, client, broadcastID, state)
+		if err != nil {
+			return broadcast, &LifecycleError{BroadcastID: broadcastID, Transition: state, LastStatus: lastStatus, Err: err}
+		}
+		broadcast = b
+		lastStatus = lifeCycleStatus(b)
+
+		if !cfg.waitForState || lastStatus == state {
+			continue
+		}
+
+		b, err = waitForBroadcastState(ctx, client, broadcastID, state, cfg.pollInterval, &lastStatus)
+		if err != nil {
+			return broadcast, &LifecycleError{BroadcastID: broadcastID, Transition: state, LastStatus: lastStatus, Err: err}
+		}
+		broadcast = b
+	}
+
+	return broadcast, nil
+}
+
+// waitForBroadcastState polls until the broadcast reaches the target state.
+// lastStatus is updated with each observed status.
+func waitForBroadcastState(ctx context.Context, client *core.Client, broadcastID, state string, interval time.Duration, lastStatus *string) (*LiveBroadcast, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		b, err := GetBroadcast(ctx, client, broadcastID, "status")
+		if err != nil {
+			return nil, err
+		}
+		*lastStatus = lifeCycleStatus(b)
+
+		switch *lastStatus {
+		case state:
+			return b, nil
+		case BroadcastStatusRevoked:
+			return nil, fmt.Errorf("broadcast was revoked")
+		}
+	}
+}
+
+// lifeCycleStatus returns the broadcast's lifecycle status, or "" if unknown.
+func lifeCycleStatus(b *LiveBroadcast) string {
+	if b == nil || b.Status == nil {
+		return ""
+	}
+	return b.Status.LifeCycleStatus
+}
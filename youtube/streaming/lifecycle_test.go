@@ -0,0 +1,178 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// lifecycleServer simulates broadcast transitions that settle after one status poll.
+type lifecycleServer struct {
+	mu          sync.Mutex
+	status      string
+	pending     string
+	transitions []string
+	failOn      string
+	settleTo    map[string]string
+}
+
+func (s *lifecycleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/liveBroadcasts/transition":
+		state := r.URL.Query().Get("broadcastStatus")
+		s.transitions = append(s.transitions, state)
+		if state == s.failOn {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":403,"message":"invalidTransition","errors":[{"reason":"invalidTransition"}]}}`))
+			return
+		}
+		switch state {
+		case TransitionTesting:
+			s.status = BroadcastStatusTestStarting
+		case TransitionLive:
+			s.status = BroadcastStatusLiveStarting
+		default:
+			s.status = state
+		}
+		s.pending = state
+		if settled, ok := s.settleTo[state]; ok {
+			s.pending = settled
+		}
+		_ = json.NewEncoder(w).Encode(LiveBroadcast{ID: "b1", Status: &BroadcastStatus{LifeCycleStatus: s.status}})
+	case "/liveBroadcasts":
+		s.status = s.pending
+		_ = json.NewEncoder(w).Encode(LiveBroadcastListResponse{
+			Items: []*LiveBroadcast{{ID: "b1", Status: &BroadcastStatus{LifeCycleStatus: s.status}}},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestRunBroadcastLifecycle(t *testing.T) {
+	states := []string{TransitionTesting, TransitionLive, TransitionComplete}
+
+	t.Run("waits for each state", func(t *testing.T) {
+		srv := &lifecycleServer{}
+		server := httptest.NewServer(srv)
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		b, err := RunBroadcastLifecycle(context.Background(), client, "b1", states,
+			WithWaitForState(true),
+			WithLifecyclePollInterval(time.Millisecond),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !b.IsComplete() {
+			t.Errorf("final status = %q, want complete", b.Status.LifeCycleStatus)
+		}
+		if !slices.Equal(srv.transitions, states) {
+			t.Errorf("transitions = %v, want %v", srv.transitions, states)
+		}
+	})
+
+	t.Run("without waiting", func(t *testing.T) {
+		srv := &lifecycleServer{}
+		server := httptest.NewServer(srv)
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		b, err := RunBroadcastLifecycle(context.Background(), client, "b1", states[:1])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if b.Status.LifeCycleStatus != BroadcastStatusTestStarting {
+			t.Errorf("status = %q, want testStarting", b.Status.LifeCycleStatus)
+		}
+	})
+
+	t.Run("transition failure", func(t *testing.T) {
+		srv := &lifecycleServer{failOn: TransitionLive}
+		server := httptest.NewServer(srv)
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := RunBroadcastLifecycle(context.Background(), client, "b1", states,
+			WithWaitForState(true),
+			WithLifecyclePollInterval(time.Millisecond),
+		)
+
+		var lcErr *LifecycleError
+		if !errors.As(err, &lcErr) {
+			t.Fatalf("error = %v, want *LifecycleError", err)
+		}
+		if lcErr.Transition != TransitionLive {
+			t.Errorf("Transition = %q, want live", lcErr.Transition)
+		}
+		if lcErr.LastStatus != BroadcastStatusTesting {
+			t.Errorf("LastStatus = %q, want testing", lcErr.LastStatus)
+		}
+		var apiErr *core.APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("error should wrap *core.APIError, got %v", err)
+		}
+	})
+
+	t.Run("revoked while waiting", func(t *testing.T) {
+		srv := &lifecycleServer{settleTo: map[string]string{TransitionTesting: BroadcastStatusRevoked}}
+		server := httptest.NewServer(srv)
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := RunBroadcastLifecycle(context.Background(), client, "b1", states,
+			WithWaitForState(true),
+			WithLifecyclePollInterval(time.Millisecond),
+		)
+
+		var lcErr *LifecycleError
+		if !errors.As(err, &lcErr) {
+			t.Fatalf("error = %v, want *LifecycleError", err)
+		}
+		if lcErr.LastStatus != BroadcastStatusRevoked {
+			t.Errorf("LastStatus = %q, want revoked", lcErr.LastStatus)
+		}
+	})
+
+	t.Run("context cancelled while waiting", func(t *testing.T) {
+		srv := &lifecycleServer{settleTo: map[string]string{TransitionTesting: BroadcastStatusTestStarting}}
+		server := httptest.NewServer(srv)
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		_, err := RunBroadcastLifecycle(ctx, client, "b1", states,
+			WithWaitForState(true),
+			WithLifecyclePollInterval(time.Millisecond),
+		)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("error = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client := core.NewClient()
+		if _, err := RunBroadcastLifecycle(context.Background(), client, "", states); err == nil {
+			t.Error("expected error for empty broadcast ID")
+		}
+		if _, err := RunBroadcastLifecycle(context.Background(), client, "b1", nil); err == nil {
+			t.Error("expected error for empty states")
+		}
+	})
+}
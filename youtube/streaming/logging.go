@@ -0,0 +1,117 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// SetLogger routes the bot's chat events and internal diagnostics to
+// logger as structured, leveled records:
+//
+//   - Info: chat messages and other chat events, deleted messages,
+//     connects and disconnects
+//   - Warn: bans and timeouts, and failed polls that will be retried
+//   - Error: failed actions (Say, Ban, ...), fatal poll errors, handler
+//     panics, and other errors reported to OnError
+//
+// Event records carry the event type as the "event" attribute and the
+// author as an "author" group with "channel_id" and "name". Pass nil to
+// stop logging (the default). SetLogger is safe to call while the bot is
+// running.
+func (c *ChatBotClient) SetLogger(logger *slog.Logger) {
+	c.logger.Store(logger)
+}
+
+// log writes a record to the bot's logger, if one is set.
+func (c *ChatBotClient) log(level slog.Level, msg string, attrs ...slog.Attr) {
+	logger := c.logger.Load()
+	if logger == nil {
+		return
+	}
+	logger.LogAttrs(context.Background(), level, msg, attrs...)
+}
+
+// logMessage logs a chat event received from the poller.
+func (c *ChatBotClient) logMessage(msg *LiveChatMessage) {
+	if c.logger.Load() == nil {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("event", msg.Snippet.Type),
+		slog.String("message_id", msg.ID),
+	}
+	if msg.AuthorDetails != nil {
+		attrs = append(attrs, authorAttr("author", msg.AuthorDetails.ChannelID, msg.AuthorDetails.DisplayName))
+	}
+	if text := msg.Message(); text != "" {
+		attrs = append(attrs, slog.String("text", text))
+	}
+	c.log(slog.LevelInfo, "chat event", attrs...)
+}
+
+// logBan logs a user banned event.
+func (c *ChatBotClient) logBan(event *BanEvent) {
+	if c.logger.Load() == nil {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("event", MessageTypeUserBanned),
+		slog.String("ban_type", event.BanType),
+		slog.Bool("by_bot", event.ByBot),
+	}
+	if event.Moderator != nil {
+		attrs = append(attrs, authorAttr("author", event.Moderator.ChannelID, event.Moderator.DisplayName))
+	}
+	if event.BannedUser != nil {
+		attrs = append(attrs, authorAttr("banned_user", event.BannedUser.ChannelID, event.BannedUser.DisplayName))
+	}
+	if event.BanType == BanTypeTemporary {
+		attrs = append(attrs, slog.Duration("duration", event.Duration))
+	}
+	c.log(slog.LevelWarn, "user banned", attrs...)
+}
+
+// logDeletion logs a message deleted event. original is nil if the
+// message is not in the history buffer.
+func (c *ChatBotClient) logDeletion(id string, original *ChatMessage) {
+	if c.logger.Load() == nil {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("event", MessageTypeMessageDeleted),
+		slog.String("message_id", id),
+	}
+	if original != nil && original.Author != nil {
+		attrs = append(attrs, authorAttr("author", original.Author.ChannelID, original.Author.DisplayName))
+	}
+	c.log(slog.LevelInfo, "message deleted", attrs...)
+}
+
+// logError logs an error reported to the OnError handlers. Failed polls
+// that the poller will retry are logged as warnings.
+func (c *ChatBotClient) logError(err error) {
+	var pollErr *PollError
+	if errors.As(err, &pollErr) && !pollErr.Fatal {
+		c.log(slog.LevelWarn, "poll failed, retrying",
+			slog.Any("error", pollErr.Err),
+			slog.Int("consecutive_errors", pollErr.ConsecutiveErrors),
+		)
+		return
+	}
+	c.log(slog.LevelError, "chat bot error", slog.Any("error", err))
+}
+
+// logActionError logs a failed chat action, e.g. "ban". Cancelled actions
+// are not logged.
+func (c *ChatBotClient) logActionError(action string, err error) {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	c.log(slog.LevelError, "chat action failed", slog.String("action", action), slog.Any("error", err))
+}
+
+// authorAttr returns a group attribute describing a chat user.
+func authorAttr(key, channelID, name string) slog.Attr {
+	return slog.Group(key, slog.String("channel_id", channelID), slog.String("name", name))
+}
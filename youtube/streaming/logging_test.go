@@ -0,0 +1,195 @@
+package streaming
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// logRecords decodes the JSON log lines written to buf.
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("decoding log line %q: %v", line, err)
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+func TestChatBotClient_SetLogger(t *testing.T) {
+	tests := []struct {
+		name      string
+		emit      func(bot *ChatBotClient)
+		wantLevel string
+		wantMsg   string
+		wantAttrs map[string]any
+	}{
+		{
+			name: "chat message",
+			emit: func(bot *ChatBotClient) {
+				bot.handleMessage(&LiveChatMessage{
+					ID:            "msg1",
+					Snippet:       &MessageSnippet{Type: MessageTypeText, DisplayMessage: "hello"},
+					AuthorDetails: &AuthorDetails{ChannelID: "UC1", DisplayName: "Alice"},
+				})
+			},
+			wantLevel: "INFO",
+			wantMsg:   "chat event",
+			wantAttrs: map[string]any{
+				"event":      MessageTypeText,
+				"message_id": "msg1",
+				"text":       "hello",
+				"author":     map[string]any{"channel_id": "UC1", "name": "Alice"},
+			},
+		},
+		{
+			name: "ban",
+			emit: func(bot *ChatBotClient) {
+				bot.dispatchUserBanned(&LiveChatMessage{
+					Snippet: &MessageSnippet{
+						Type: MessageTypeUserBanned,
+						UserBannedDetails: &UserBannedDetails{
+							BanType:            BanTypeTemporary,
+							BanDurationSeconds: 300,
+							BannedUserDetails:  &BannedUserDetails{ChannelID: "UC2", DisplayName: "Bob"},
+						},
+					},
+					AuthorDetails: &AuthorDetails{ChannelID: "UCmod", DisplayName: "Mod"},
+				})
+			},
+			wantLevel: "WARN",
+			wantMsg:   "user banned",
+			wantAttrs: map[string]any{
+				"event":       MessageTypeUserBanned,
+				"ban_type":    BanTypeTemporary,
+				"author":      map[string]any{"channel_id": "UCmod", "name": "Mod"},
+				"banned_user": map[string]any{"channel_id": "UC2", "name": "Bob"},
+			},
+		},
+		{
+			name:      "deletion",
+			emit:      func(bot *ChatBotClient) { bot.dispatchMessageDeleted("msg9", nil) },
+			wantLevel: "INFO",
+			wantMsg:   "message deleted",
+			wantAttrs: map[string]any{"event": MessageTypeMessageDeleted, "message_id": "msg9"},
+		},
+		{
+			name: "retried poll error",
+			emit: func(bot *ChatBotClient) {
+				bot.dispatchError(&PollError{Err: errors.New("502"), ConsecutiveErrors: 2})
+			},
+			wantLevel: "WARN",
+			wantMsg:   "poll failed, retrying",
+			wantAttrs: map[string]any{"error": "502", "consecutive_errors": float64(2)},
+		},
+		{
+			name: "fatal poll error",
+			emit: func(bot *ChatBotClient) {
+				bot.dispatchError(&PollError{Err: errors.New("chat ended"), Fatal: true})
+			},
+			wantLevel: "ERROR",
+			wantMsg:   "chat bot error",
+		},
+		{
+			name: "handler panic",
+			emit: func(bot *ChatBotClient) {
+				bot.OnMessage(func(*ChatMessage) { panic("boom") })
+				bot.handleMessage(&LiveChatMessage{Snippet: &MessageSnippet{Type: MessageTypeText}})
+			},
+			wantLevel: "ERROR",
+			wantMsg:   "chat bot error",
+			wantAttrs: map[string]any{"error": "handler panic: boom"},
+		},
+		{
+			name:      "connect",
+			emit:      func(bot *ChatBotClient) { bot.dispatchConnect() },
+			wantLevel: "INFO",
+			wantMsg:   "connected",
+			wantAttrs: map[string]any{"live_chat_id": "chat123"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bot, _ := NewChatBotClient(core.NewClient(), nil, "chat123")
+			var buf bytes.Buffer
+			bot.SetLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+			tt.emit(bot)
+
+			records := logRecords(t, &buf)
+			if len(records) == 0 {
+				t.Fatal("nothing logged")
+			}
+			rec := records[len(records)-1]
+			if rec["level"] != tt.wantLevel || rec["msg"] != tt.wantMsg {
+				t.Errorf("record = %v %q, want %v %q", rec["level"], rec["msg"], tt.wantLevel, tt.wantMsg)
+			}
+			for k, want := range tt.wantAttrs {
+				got, _ := json.Marshal(rec[k])
+				wantJSON, _ := json.Marshal(want)
+				if string(got) != string(wantJSON) {
+					t.Errorf("%s = %s, want %s", k, got, wantJSON)
+				}
+			}
+		})
+	}
+}
+
+func TestChatBotClient_SetLogger_ActionFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 60000})
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error": {"code": 403, "message": "forbidden"}}`))
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	bot, _ := NewChatBotClient(client, nil, "chat123")
+	if err := bot.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer func() { _ = bot.Close() }()
+
+	var buf bytes.Buffer
+	bot.SetLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+	if err := bot.Ban(context.Background(), "UC1"); err == nil {
+		t.Fatal("Ban() error = nil, want 403")
+	}
+
+	var failures []map[string]any
+	for _, rec := range logRecords(t, &buf) {
+		if rec["msg"] == "chat action failed" {
+			failures = append(failures, rec)
+		}
+	}
+	if len(failures) != 1 || failures[0]["level"] != "ERROR" || failures[0]["action"] != "ban" {
+		t.Errorf("failures = %v, want one ban failure", failures)
+	}
+
+	// A nil logger stops logging
+	buf.Reset()
+	bot.SetLogger(nil)
+	_ = bot.Ban(context.Background(), "UC1")
+	if buf.Len() != 0 {
+		t.Errorf("logged %q after SetLogger(nil)", buf.String())
+	}
+}
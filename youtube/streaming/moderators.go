@@ -0,0 +1,260 @@
+package streaming
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ModeratorEvent reports a moderator being added to or removed from the chat.
+type ModeratorEvent struct {
+	// ID is the moderator entry ID, as passed to RemoveModerator.
+	ID string
+
+	// Moderator is the moderator's channel. Nil when a moderator the bot has
+	// not seen is removed by ID.
+	Moderator *Author
+
+	// Raw is the underlying LiveChatModerator, or nil if unknown.
+	Raw *LiveChatModerator
+}
+
+// WithModeratorPollInterval polls the moderator list every d while the bot
+// is connected and reports changes made elsewhere (e.g., in YouTube Studio)
+// to the OnModeratorAdded and OnModeratorRemoved handlers. The first poll
+// after Connect establishes the known list without firing events.
+//
+// Default is 0 (disabled): only changes made through AddModerator and
+// RemoveModerator are reported. Each poll costs liveChatModerators.list
+// quota (50 units) per page of 50 moderators, so keep the interval long.
+func WithModeratorPollInterval(d time.Duration) ChatBotOption {
+	return func(c *ChatBotClient) { c.modPollInterval = d }
+}
+
+// OnModeratorAdded registers a handler called when a moderator is added.
+func (c *ChatBotClient) OnModeratorAdded(fn func(*ModeratorEvent)) func() {
+	return c.addModeratorHandler(&c.moderatorAddedHandlers, fn)
+}
+
+// OnModeratorRemoved registers a handler called when a moderator is removed.
+func (c *ChatBotClient) OnModeratorRemoved(fn func(*ModeratorEvent)) func() {
+	return c.addModeratorHandler(&c.moderatorRemovedHandlers, fn)
+}
+
+// Moderators returns the moderators known from the last moderator poll and
+// changes made through the bot, sorted by display name. Returns nil until
+// the first poll completes (see WithModeratorPollInterval).
+func (c *ChatBotClient) Moderators() []*LiveChatModerator {
+	c.modMu.Lock()
+	defer c.modMu.Unlock()
+
+	if !c.modsKnown {
+		return nil
+	}
+	mods := make([]*LiveChatModerator, 0, len(c.moderators))
+	for _, m := range c.moderators {
+		mods = append(mods, m)
+	}
+	slices.SortFunc(mods, func(a, b *LiveChatModerator) int {
+		return cmp.Or(
+			strings.Compare(strings.ToLower(moderatorName(a)), strings.ToLower(moderatorName(b))),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+	return mods
+}
+
+// startModeratorWatch starts the moderator poll loop, replacing any running one.
+func (c *ChatBotClient) startModeratorWatch(ctx context.Context) {
+	c.stopModeratorWatch()
+
+	c.modMu.Lock()
+	defer c.modMu.Unlock()
+	c.moderators = nil
+	c.modsKnown = false
+	c.modStop = make(chan struct{})
+	c.modDone = make(chan struct{})
+	go c.moderatorLoop(ctx, c.modStop, c.modDone)
+}
+
+// stopModeratorWatch stops the moderator poll loop if running.
+func (c *ChatBotClient) stopModeratorWatch() {
+	c.modMu.Lock()
+	stop, done := c.modStop, c.modDone
+	c.modStop = nil
+	c.modDone = nil
+	c.modMu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// moderatorLoop polls the moderator list immediately and then on each tick.
+func (c *ChatBotClient) moderatorLoop(ctx context.Context, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(c.modPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.pollModerators(ctx); err != nil && ctx.Err() == nil {
+			c.dispatchError(fmt.Errorf("moderator poll: %w", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-ticker.C:
+			if !c.IsConnected() {
+				return
+			}
+		}
+	}
+}
+
+// pollModerators fetches the moderator list and reports differences from
+// the known list.
+func (c *ChatBotClient) pollModerators(ctx context.Context) error {
+	c.modMu.Lock()
+	version := c.modVersion
+	c.modMu.Unlock()
+
+	mods, err := c.poller.ListAllModerators(ctx, nil)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]*LiveChatModerator, len(mods))
+	for _, m := range mods {
+		current[m.ID] = m
+	}
+
+	c.modMu.Lock()
+	if c.modVersion != version {
+		// AddModerator or RemoveModerator ran during the fetch, so the
+		// result may be stale. Diff on the next poll instead.
+		c.modMu.Unlock()
+		return nil
+	}
+	previous, known := c.moderators, c.modsKnown
+	c.moderators = current
+	c.modsKnown = true
+	c.modMu.Unlock()
+
+	if !known {
+		return nil
+	}
+	for id, m := range current {
+		if _, ok := previous[id]; !ok {
+			c.dispatchModerator(&c.moderatorAddedHandlers, newModeratorEvent(id, m))
+		}
+	}
+	for id, m := range previous {
+		if _, ok := current[id]; !ok {
+			c.dispatchModerator(&c.moderatorRemovedHandlers, newModeratorEvent(id, m))
+		}
+	}
+	return nil
+}
+
+// moderatorAdded records a moderator added through the bot and notifies
+// handlers. channelID fills in the details if the response omitted them.
+func (c *ChatBotClient) moderatorAdded(mod *LiveChatModerator, channelID string) {
+	if mod == nil {
+		mod = &LiveChatModerator{}
+	}
+	if mod.Snippet == nil || mod.Snippet.ModeratorDetails == nil {
+		clone := *mod
+		clone.Snippet = &ModeratorSnippet{
+			LiveChatID:       c.liveChatID,
+			ModeratorDetails: &ModeratorDetails{ChannelID: channelID},
+		}
+		mod = &clone
+	}
+
+	c.modMu.Lock()
+	c.modVersion++
+	if c.modsKnown && mod.ID != "" {
+		c.moderators[mod.ID] = mod
+	}
+	c.modMu.Unlock()
+
+	c.dispatchModerator(&c.moderatorAddedHandlers, newModeratorEvent(mod.ID, mod))
+}
+
+// moderatorRemoved records a moderator removed through the bot and notifies
+// handlers.
+func (c *ChatBotClient) moderatorRemoved(id string) {
+	c.modMu.Lock()
+	c.modVersion++
+	mod := c.moderators[id]
+	delete(c.moderators, id)
+	c.modMu.Unlock()
+
+	c.dispatchModerator(&c.moderatorRemovedHandlers, newModeratorEvent(id, mod))
+}
+
+// newModeratorEvent builds a ModeratorEvent; mod may be nil.
+func newModeratorEvent(id string, mod *LiveChatModerator) *ModeratorEvent {
+	event := &ModeratorEvent{ID: id, Raw: mod}
+	if mod != nil && mod.Snippet != nil && mod.Snippet.ModeratorDetails != nil {
+		d := mod.Snippet.ModeratorDetails
+		event.Moderator = &Author{
+			ChannelID:       d.ChannelID,
+			DisplayName:     d.DisplayName,
+			ProfileImageURL: d.ProfileImageURL,
+			IsModerator:     true,
+		}
+	}
+	return event
+}
+
+// moderatorName returns a moderator's display name, or "" if unknown.
+func moderatorName(m *LiveChatModerator) string {
+	if m.Snippet == nil || m.Snippet.ModeratorDetails == nil {
+		return ""
+	}
+	return m.Snippet.ModeratorDetails.DisplayName
+}
+
+// addModeratorHandler appends a moderator handler to list and returns its
+// unsubscribe function.
+func (c *ChatBotClient) addModeratorHandler(list *[]*moderatorHandler, fn func(*ModeratorEvent)) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h := &moderatorHandler{fn: fn}
+	*list = append(*list, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			for i, handler := range *list {
+				if handler == h {
+					*list = slices.Delete(*list, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
+// dispatchModerator calls the handlers in list with event.
+func (c *ChatBotClient) dispatchModerator(list *[]*moderatorHandler, event *ModeratorEvent) {
+	c.mu.RLock()
+	handlers := slices.Clone(*list)
+	c.mu.RUnlock()
+
+	for _, h := range handlers {
+		c.safeCall(func() { h.fn(event) })
+	}
+}
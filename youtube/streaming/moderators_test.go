@@ -0,0 +1,217 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func testModerator(id, channelID, name string) *LiveChatModerator {
+	return &LiveChatModerator{
+		ID: id,
+		Snippet: &ModeratorSnippet{
+			LiveChatID: "chat123",
+			ModeratorDetails: &ModeratorDetails{
+				ChannelID:   channelID,
+				DisplayName: name,
+			},
+		},
+	}
+}
+
+// waitModeratorEvent waits for an event on ch.
+func waitModeratorEvent(t *testing.T, ch <-chan *ModeratorEvent) *ModeratorEvent {
+	t.Helper()
+	select {
+	case event := <-ch:
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for moderator event")
+		return nil
+	}
+}
+
+func TestChatBotClient_ModeratorPolling(t *testing.T) {
+	alice := testModerator("mod-a", "UCalice", "alice")
+	bob := testModerator("mod-b", "UCbob", "Bob")
+	carol := testModerator("mod-c", "UCcarol", "carol")
+
+	var mu sync.Mutex
+	pages := [][]*LiveChatModerator{{alice, bob}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/liveChat/moderators" {
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 5000})
+			return
+		}
+
+		mu.Lock()
+		current := pages
+		mu.Unlock()
+
+		// Serve one page per moderator to exercise pagination.
+		page := 0
+		if token := r.URL.Query().Get("pageToken"); token != "" {
+			page = int(token[0] - '0')
+		}
+		resp := LiveChatModeratorListResponse{}
+		for i, mods := range current {
+			if i == page {
+				resp.Items = mods
+			}
+		}
+		if page+1 < len(current) {
+			resp.NextPageToken = string(rune('0' + page + 1))
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	bot, _ := NewChatBotClient(client, nil, "chat123",
+		WithModeratorPollInterval(10*time.Millisecond),
+	)
+
+	added := make(chan *ModeratorEvent, 10)
+	removed := make(chan *ModeratorEvent, 10)
+	bot.OnModeratorAdded(func(e *ModeratorEvent) { added <- e })
+	bot.OnModeratorRemoved(func(e *ModeratorEvent) { removed <- e })
+
+	if err := bot.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer func() { _ = bot.Close() }()
+
+	// Wait for the baseline poll.
+	deadline := time.Now().Add(2 * time.Second)
+	for bot.Moderators() == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("baseline moderator poll did not complete")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	mods := bot.Moderators()
+	if len(mods) != 2 || mods[0].ID != "mod-a" || mods[1].ID != "mod-b" {
+		t.Errorf("Moderators() = %v, want [mod-a mod-b] sorted by name", mods)
+	}
+	select {
+	case e := <-added:
+		t.Fatalf("baseline poll fired added event for %s", e.ID)
+	default:
+	}
+
+	// Bob is removed and Carol added elsewhere, across two pages.
+	mu.Lock()
+	pages = [][]*LiveChatModerator{{alice}, {carol}}
+	mu.Unlock()
+
+	e := waitModeratorEvent(t, added)
+	if e.ID != "mod-c" || e.Moderator == nil || e.Moderator.ChannelID != "UCcarol" || !e.Moderator.IsModerator {
+		t.Errorf("added event = %+v, want carol", e)
+	}
+	e = waitModeratorEvent(t, removed)
+	if e.ID != "mod-b" || e.Moderator == nil || e.Moderator.DisplayName != "Bob" {
+		t.Errorf("removed event = %+v, want Bob", e)
+	}
+
+	// No further events while the list is unchanged.
+	time.Sleep(50 * time.Millisecond)
+	if len(added) != 0 || len(removed) != 0 {
+		t.Errorf("unexpected events: %d added, %d removed", len(added), len(removed))
+	}
+}
+
+func TestChatBotClient_ModeratorEvents_FromActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/liveChat/moderators" && r.Method == http.MethodPost:
+			var req InsertModeratorRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			_ = json.NewEncoder(w).Encode(testModerator("mod-new",
+				req.Snippet.ModeratorDetails.ChannelID, "New Mod"))
+		case r.URL.Path == "/liveChat/moderators" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 5000})
+		}
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	bot, _ := NewChatBotClient(client, nil, "chat123")
+
+	added := make(chan *ModeratorEvent, 1)
+	removed := make(chan *ModeratorEvent, 1)
+	bot.OnModeratorAdded(func(e *ModeratorEvent) { added <- e })
+	unsub := bot.OnModeratorRemoved(func(e *ModeratorEvent) { removed <- e })
+
+	ctx := context.Background()
+	_ = bot.Connect(ctx)
+	defer func() { _ = bot.Close() }()
+
+	if err := bot.AddModerator(ctx, "UCnew"); err != nil {
+		t.Fatalf("AddModerator() error = %v", err)
+	}
+	e := waitModeratorEvent(t, added)
+	if e.ID != "mod-new" || e.Moderator == nil || e.Moderator.ChannelID != "UCnew" {
+		t.Errorf("added event = %+v", e)
+	}
+
+	if err := bot.RemoveModerator(ctx, "mod-other"); err != nil {
+		t.Fatalf("RemoveModerator() error = %v", err)
+	}
+	e = waitModeratorEvent(t, removed)
+	if e.ID != "mod-other" || e.Moderator != nil || e.Raw != nil {
+		t.Errorf("removed event for unknown moderator = %+v, want ID only", e)
+	}
+
+	// Polling is disabled, so the moderator list is unknown.
+	if mods := bot.Moderators(); mods != nil {
+		t.Errorf("Moderators() = %v, want nil without polling", mods)
+	}
+
+	unsub()
+	_ = bot.RemoveModerator(ctx, "mod-new")
+	select {
+	case e := <-removed:
+		t.Errorf("unsubscribed handler called with %+v", e)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestChatBotClient_ModeratorEvents_NotFiredOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/liveChat/moderators" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 5000})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	bot, _ := NewChatBotClient(client, nil, "chat123")
+
+	called := false
+	bot.OnModeratorAdded(func(*ModeratorEvent) { called = true })
+
+	ctx := context.Background()
+	_ = bot.Connect(ctx)
+	defer func() { _ = bot.Close() }()
+
+	if err := bot.AddModerator(ctx, "UCnew"); err == nil {
+		t.Fatal("AddModerator() expected error")
+	}
+	if called {
+		t.Error("OnModeratorAdded called after failed AddModerator")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"slices"
 	"sync"
@@ -37,13 +38,85 @@ var (
 type (
 	messageHandler      struct{ fn func(*LiveChatMessage) }
 	deleteHandler       struct{ fn func(string) }
-	banHandler          struct{ fn func(*UserBannedDetails) }
 	errorHandler        struct{ fn func(error) }
 	connectHandler      struct{ fn func() }
 	disconnectHandler   struct{ fn func() }
 	pollCompleteHandler struct{ fn func(int, time.Duration) }
+	rawResponseHandler  struct {
+		fn func(*LiveChatMessageListResponse)
+	}
+	ackHandler        struct{ fn func(*LiveChatMessage) error }
+	checkpointHandler struct{ fn func(string) }
 )
 
+// banHandler holds an OnBan handler, or an onBanMessage handler in msgFn.
+type banHandler struct {
+	fn    func(*UserBannedDetails)
+	msgFn func(*LiveChatMessage)
+}
+
+// PollError is passed to OnError handlers, and sent as a ChatEventError,
+// when a poll fails. Transient errors such as a 500 or a dropped connection
+// are retried with backoff, so handlers can use ConsecutiveErrors to decide
+// when an outage is worth reporting:
+//
+//	poller.OnError(func(err error) {
+//		var pollErr *streaming.PollError
+//		if errors.As(err, &pollErr) && (pollErr.Fatal || pollErr.ConsecutiveErrors >= 5) {
+//			alert(err)
+//		}
+//	})
+//
+// Handler panics are reported as plain errors, not as a PollError.
+type PollError struct {
+	// Err is the underlying error, e.g. a *core.APIError or *core.ChatEndedError.
+	Err error
+
+	// ConsecutiveErrors is the number of polls in a row that have failed,
+	// including this one. It starts again from 1 after a successful poll.
+	ConsecutiveErrors int
+
+	// Fatal reports whether the poller is stopping because of Err. The poller
+	// stops when the chat has ended or is disabled, when access is forbidden,
+	// when the quota is exhausted, and on other API errors that
+	// core.IsRetryable reports as permanent. A 401 is retried, since the
+	// client's access token may be refreshed in the meantime.
+	Fatal bool
+}
+
+// Error implements the error interface.
+func (e *PollError) Error() string {
+	if e.Fatal {
+		return fmt.Sprintf("live chat poll failed, stopping: %v", e.Err)
+	}
+	return fmt.Sprintf("live chat poll failed (%d in a row), retrying: %v", e.ConsecutiveErrors, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PollError) Unwrap() error {
+	return e.Err
+}
+
+// isFatalPollError reports whether err means polling cannot succeed, so the
+// poller should stop rather than retry. Errors that are not API errors, such
+// as a failed connection or a malformed response, are retried.
+func isFatalPollError(err error) bool {
+	var (
+		chatEnded *core.ChatEndedError
+		quotaErr  *core.QuotaError
+		authErr   *core.AuthError
+		apiErr    *core.APIError
+	)
+	switch {
+	case errors.As(err, &chatEnded), errors.As(err, &quotaErr), errors.As(err, &authErr):
+		return true
+	case errors.As(err, &apiErr):
+		return apiErr.StatusCode != http.StatusUnauthorized && !core.IsRetryable(err)
+	default:
+		return false
+	}
+}
+
 // LiveChatPoller provides low-level HTTP polling for YouTube Live Chat.
 type LiveChatPoller struct {
 	client     *core.Client
@@ -55,6 +128,13 @@ type LiveChatPoller struct {
 	pollInterval    time.Duration
 	minPollInterval time.Duration
 	maxPollInterval time.Duration
+	adaptive        bool // See WithAdaptivePolling
+	idlePolls       int  // Consecutive polls without messages
+
+	// Page token of the last poll, held back until its batch is
+	// acknowledged (see ack.go)
+	pendingPageToken string
+	hasPendingToken  bool
 
 	// Composable handlers (wrapper pointers for identity-based unsubscribe)
 	handlerMu            sync.RWMutex
@@ -65,14 +145,30 @@ type LiveChatPoller struct {
 	connectHandlers      []*connectHandler
 	disconnectHandlers   []*disconnectHandler
 	pollCompleteHandlers []*pollCompleteHandler
+	rawResponseHandlers  []*rawResponseHandler
+	ackHandlers          []*ackHandler
+	checkpointHandlers   []*checkpointHandler
 
 	// Lifecycle (context-based cancellation)
 	lifecycleMu sync.Mutex // Protects Start/Stop atomicity
 	state       atomic.Int32
 	cancel      context.CancelFunc
 	wg          sync.WaitGroup
+	done        chan struct{} // closed when the current run's pollLoop exits
+	runErr      error         // fatal error that ended the last run
 	backoff     *core.BackoffConfig
 
+	// Retries for transient SendMessage failures (see WithSendRetry)
+	sendRetries int
+
+	// Drop messages published before Start (see WithSkipBacklog)
+	skipBacklog bool
+
+	// Event channel (nil until Events is called; see events.go)
+	eventsMu    sync.Mutex
+	events      chan ChatEvent
+	eventBuffer int
+
 	// Options
 	profileImageSize string // Default, medium, high
 }
@@ -88,6 +184,7 @@ func NewLiveChatPoller(client *core.Client, liveChatID string, opts ...PollerOpt
 		minPollInterval:  DefaultMinPollInterval,
 		maxPollInterval:  DefaultMaxPollInterval,
 		backoff:          core.NewBackoffConfig(),
+		eventBuffer:      DefaultEventBuffer,
 		profileImageSize: "default",
 	}
 
@@ -108,6 +205,17 @@ func WithMaxPollInterval(d time.Duration) PollerOption {
 	return func(p *LiveChatPoller) { p.maxPollInterval = d }
 }
 
+// WithAdaptivePolling lengthens the poll interval while the chat is quiet.
+// Each consecutive poll that returns no messages doubles the interval, up to
+// the maximum poll interval; each poll with messages then halves it again,
+// stepping back down to the normal interval. The API's pollingIntervalMillis
+// is always respected as a floor. Saves quota on low-traffic streams at the
+// cost of added latency for the first messages after a lull. Default is
+// false.
+func WithAdaptivePolling(enabled bool) PollerOption {
+	return func(p *LiveChatPoller) { p.adaptive = enabled }
+}
+
 // WithBackoff sets a custom backoff configuration for retries.
 // If cfg is nil, the default backoff configuration is retained.
 func WithBackoff(cfg *core.BackoffConfig) PollerOption {
@@ -118,6 +226,33 @@ func WithBackoff(cfg *core.BackoffConfig) PollerOption {
 	}
 }
 
+// WithSendRetry retries SendMessage up to n times when sending fails with a
+// transient error: a 5xx response, a rate limit, or a dropped connection.
+// Errors a retry cannot fix, such as a 4xx for a message that is too long,
+// are returned at once. Retries wait with the poller's backoff (see
+// WithBackoff), and at least as long as a rate limit asks. Default is 0 (no
+// retries); leave it there if the client's RetryMiddleware already retries.
+//
+// A 5xx response does not guarantee the message was not posted, so a retry
+// can occasionally send a message twice.
+func WithSendRetry(n int) PollerOption {
+	return func(p *LiveChatPoller) { p.sendRetries = max(n, 0) }
+}
+
+// WithSkipBacklog discards the chat backlog that the first poll returns:
+// messages published before Start, so a restarted command bot does not
+// re-run old commands. Messages are compared by PublishedAt, and filtering
+// stops at the first poll that returns a message published after Start.
+// OnRawResponse still sees the full responses. Default is false.
+//
+// When polling resumes from a page token (SetPageToken, e.g. a checkpoint
+// saved with OnCheckpoint), the messages since that token are not backlog
+// and are delivered as usual: the option only applies to a Start without
+// a page token. Call ResetPageToken first to skip the backlog anyway.
+func WithSkipBacklog(enabled bool) PollerOption {
+	return func(p *LiveChatPoller) { p.skipBacklog = enabled }
+}
+
 // Profile image size constants.
 const (
 	ProfileImageDefault = "default" // 88px
@@ -168,8 +303,14 @@ func (p *LiveChatPoller) Start(ctx context.Context) error {
 	pollCtx, cancel := context.WithCancel(ctx)
 	p.cancel = cancel
 
+	done := make(chan struct{})
+	p.mu.Lock()
+	p.done = done
+	p.runErr = nil
+	p.mu.Unlock()
+
 	p.wg.Add(1)
-	go p.pollLoop(pollCtx)
+	go p.pollLoop(pollCtx, done)
 
 	// Transition to running (under lock, so Stop can't race)
 	p.state.Store(stateRunning)
@@ -206,16 +347,45 @@ func (p *LiveChatPoller) Stop() {
 	p.state.Store(stateStopped)
 }
 
+// Done returns a channel that is closed when polling started by the last
+// Start ends: after Stop, cancellation of the Start context, or a fatal
+// error. Returns nil if the poller has never been started. Together with
+// Err, this makes the poller a core.Finisher, so a core.RunGroup shuts down
+// when the chat ends.
+func (p *LiveChatPoller) Done() <-chan struct{} {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.done
+}
+
+// Err returns the fatal *PollError that ended the last run, or nil if the
+// poller is running or was stopped or cancelled.
+func (p *LiveChatPoller) Err() error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.runErr
+}
+
 // pollLoop is the main polling goroutine.
-func (p *LiveChatPoller) pollLoop(ctx context.Context) {
+func (p *LiveChatPoller) pollLoop(ctx context.Context, done chan struct{}) {
+	defer close(done)
 	defer p.wg.Done()
 	defer p.state.Store(stateStopped) // Ensure state is stopped on exit
+	defer p.closeEvents()
 
 	// Notify connect handlers
 	p.dispatchConnect()
 
 	var attempt int
 
+	// Drop the backlog published before now, unless resuming (see WithSkipBacklog)
+	var backlogBefore time.Time
+	p.mu.RLock()
+	if p.skipBacklog && p.pageToken == "" {
+		backlogBefore = time.Now()
+	}
+	p.mu.RUnlock()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -224,28 +394,37 @@ func (p *LiveChatPoller) pollLoop(ctx context.Context) {
 		default:
 		}
 
-		// Perform poll
-		messages, nextPoll, err := p.poll(ctx)
+		// Perform poll, holding the page token back if the batch needs acks
+		acks := p.snapshotAckHandlers()
+		messages, nextPoll, err := p.poll(ctx, len(acks) > 0)
 
 		if err != nil {
-			// Check for chat ended
-			var chatEnded *core.ChatEndedError
-			if errors.As(err, &chatEnded) {
-				p.dispatchError(err)
+			// Check for context cancellation
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				p.dispatchDisconnect()
 				return
 			}
 
-			// Check for context cancellation
-			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			attempt++
+			pollErr := &PollError{Err: err, ConsecutiveErrors: attempt, Fatal: isFatalPollError(err)}
+			p.dispatchError(pollErr)
+			p.emitEvent(ctx, ChatEvent{Type: ChatEventError, Err: pollErr})
+
+			// Stop on errors a retry cannot fix, such as the chat ending
+			if pollErr.Fatal {
+				p.mu.Lock()
+				p.runErr = pollErr
+				p.mu.Unlock()
 				p.dispatchDisconnect()
 				return
 			}
 
-			// Dispatch error and apply backoff
-			p.dispatchError(err)
-			backoffDelay := p.backoff.Delay(attempt)
-			attempt++
+			// Apply backoff, waiting at least as long as a rate limit asks
+			backoffDelay := p.backoff.Delay(attempt - 1)
+			var rateErr *core.RateLimitError
+			if errors.As(err, &rateErr) {
+				backoffDelay = max(backoffDelay, rateErr.RetryAfter)
+			}
 
 			select {
 			case <-ctx.Done():
@@ -259,11 +438,29 @@ func (p *LiveChatPoller) pollLoop(ctx context.Context) {
 		// Reset attempt counter on success
 		attempt = 0
 
+		if !backlogBefore.IsZero() {
+			var caughtUp bool
+			messages, caughtUp = dropBacklog(messages, backlogBefore)
+			if caughtUp {
+				backlogBefore = time.Time{}
+			}
+		}
+
 		// Dispatch messages
 		p.dispatchMessages(messages)
+		p.emitMessages(ctx, messages)
+
+		// Advance the checkpoint once the batch is acknowledged
+		if ackErr := dispatchAcks(acks, messages); ackErr != nil {
+			p.dispatchError(ackErr)
+			p.emitEvent(ctx, ChatEvent{Type: ChatEventError, Err: ackErr})
+		} else {
+			p.commitCheckpoint()
+		}
 
 		// Notify poll complete
 		p.dispatchPollComplete(len(messages), nextPoll)
+		p.emitEvent(ctx, ChatEvent{Type: ChatEventPoll, MessageCount: len(messages), NextPoll: nextPoll})
 
 		// Wait for next poll interval
 		select {
@@ -275,8 +472,26 @@ func (p *LiveChatPoller) pollLoop(ctx context.Context) {
 	}
 }
 
-// poll performs a single poll request.
-func (p *LiveChatPoller) poll(ctx context.Context) ([]*LiveChatMessage, time.Duration, error) {
+// dropBacklog removes the messages published before t. caughtUp reports
+// whether any message was published at or after t, so later polls hold no
+// more backlog. Messages without a publish time are kept.
+func dropBacklog(messages []*LiveChatMessage, t time.Time) (kept []*LiveChatMessage, caughtUp bool) {
+	kept = messages[:0:0]
+	for _, msg := range messages {
+		if msg.Snippet != nil && !msg.Snippet.PublishedAt.IsZero() && msg.Snippet.PublishedAt.Before(t) {
+			continue
+		}
+		if msg.Snippet != nil && !msg.Snippet.PublishedAt.IsZero() {
+			caughtUp = true
+		}
+		kept = append(kept, msg)
+	}
+	return kept, caughtUp
+}
+
+// poll performs a single poll request. If holdToken is set, the next page
+// token is held back until the batch is acknowledged (see commitCheckpoint).
+func (p *LiveChatPoller) poll(ctx context.Context, holdToken bool) ([]*LiveChatMessage, time.Duration, error) {
 	p.mu.RLock()
 	pageToken := p.pageToken
 	p.mu.RUnlock()
@@ -296,14 +511,22 @@ func (p *LiveChatPoller) poll(ctx context.Context) ([]*LiveChatMessage, time.Dur
 		return nil, p.minPollInterval, err
 	}
 
+	p.dispatchRawResponse(&resp)
+
 	// Check if chat has ended
 	if resp.IsChatEnded() {
 		return nil, 0, &core.ChatEndedError{LiveChatID: p.liveChatID}
 	}
 
-	// Update page token
+	// Update page token, or hold it back until the batch is acknowledged
 	p.mu.Lock()
-	p.pageToken = resp.NextPageToken
+	if holdToken {
+		p.pendingPageToken = resp.NextPageToken
+		p.hasPendingToken = true
+	} else {
+		p.pageToken = resp.NextPageToken
+		p.hasPendingToken = false
+	}
 	p.mu.Unlock()
 
 	// Calculate poll interval
@@ -312,12 +535,39 @@ func (p *LiveChatPoller) poll(ctx context.Context) ([]*LiveChatMessage, time.Dur
 	pollInterval = min(pollInterval, p.maxPollInterval)
 
 	p.mu.Lock()
+	if p.adaptive {
+		pollInterval = p.adaptInterval(pollInterval, resp.PollingInterval(), len(resp.Items))
+	}
 	p.pollInterval = pollInterval
 	p.mu.Unlock()
 
 	return resp.Items, pollInterval, nil
 }
 
+// adaptInterval applies adaptive polling to the normal interval, given the
+// API-suggested floor and the number of messages in the last poll.
+// Must be called with mu held.
+func (p *LiveChatPoller) adaptInterval(interval, floor time.Duration, count int) time.Duration {
+	if interval <= 0 {
+		p.idlePolls = 0
+		return interval
+	}
+
+	if count > 0 {
+		p.idlePolls = max(p.idlePolls-1, 0) // Step back down one doubling at a time
+	} else {
+		p.idlePolls++
+	}
+
+	doublings := 0
+	for doublings < p.idlePolls && interval < p.maxPollInterval {
+		interval *= 2
+		doublings++
+	}
+	p.idlePolls = doublings // Hold at the maximum rather than counting forever
+	return max(min(interval, p.maxPollInterval), floor)
+}
+
 // OnMessage registers a handler for chat messages.
 // Returns an unsubscribe function that is safe to call multiple times.
 func (p *LiveChatPoller) OnMessage(fn func(*LiveChatMessage)) func() {
@@ -369,10 +619,20 @@ func (p *LiveChatPoller) OnDelete(fn func(string)) func() {
 // OnBan registers a handler for user bans.
 // Returns an unsubscribe function.
 func (p *LiveChatPoller) OnBan(fn func(*UserBannedDetails)) func() {
+	return p.addBanHandler(&banHandler{fn: fn})
+}
+
+// onBanMessage registers a handler receiving the whole ban message, whose
+// author is the moderator who issued the ban.
+func (p *LiveChatPoller) onBanMessage(fn func(*LiveChatMessage)) func() {
+	return p.addBanHandler(&banHandler{msgFn: fn})
+}
+
+// addBanHandler registers h and returns an unsubscribe function.
+func (p *LiveChatPoller) addBanHandler(h *banHandler) func() {
 	p.handlerMu.Lock()
 	defer p.handlerMu.Unlock()
 
-	h := &banHandler{fn: fn}
 	p.banHandlers = append(p.banHandlers, h)
 
 	var once sync.Once
@@ -390,7 +650,9 @@ func (p *LiveChatPoller) OnBan(fn func(*UserBannedDetails)) func() {
 	}
 }
 
-// OnError registers a handler for polling errors.
+// OnError registers a handler for polling errors. Failed polls are reported
+// as a *PollError; transient failures are retried with backoff, and the
+// poller stops only after a fatal one.
 // Returns an unsubscribe function.
 func (p *LiveChatPoller) OnError(fn func(error)) func() {
 	p.handlerMu.Lock()
@@ -487,6 +749,32 @@ func (p *LiveChatPoller) OnPollComplete(fn func(int, time.Duration)) func() {
 	}
 }
 
+// OnRawResponse registers a handler for each full poll response, before its
+// messages are dispatched. Use it to read metadata such as NextPageToken,
+// PollingIntervalMillis, PageInfo, and OfflineAt.
+// Returns an unsubscribe function that is safe to call multiple times.
+func (p *LiveChatPoller) OnRawResponse(fn func(*LiveChatMessageListResponse)) func() {
+	p.handlerMu.Lock()
+	defer p.handlerMu.Unlock()
+
+	h := &rawResponseHandler{fn: fn}
+	p.rawResponseHandlers = append(p.rawResponseHandlers, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.handlerMu.Lock()
+			defer p.handlerMu.Unlock()
+			for i, handler := range p.rawResponseHandlers {
+				if handler == h {
+					p.rawResponseHandlers = slices.Delete(p.rawResponseHandlers, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
 // dispatchMessages sends messages to all handlers.
 func (p *LiveChatPoller) dispatchMessages(messages []*LiveChatMessage) {
 	// Snapshot handlers under read lock
@@ -512,7 +800,11 @@ func (p *LiveChatPoller) dispatchMessages(messages []*LiveChatMessage) {
 		// Handle user ban events
 		if msg.Type() == MessageTypeUserBanned && msg.Snippet != nil && msg.Snippet.UserBannedDetails != nil {
 			for _, h := range banHandlers {
-				p.safeCall(func() { h.fn(msg.Snippet.UserBannedDetails) })
+				if h.msgFn != nil {
+					p.safeCall(func() { h.msgFn(msg) })
+				} else {
+					p.safeCall(func() { h.fn(msg.Snippet.UserBannedDetails) })
+				}
 			}
 			continue
 		}
@@ -572,6 +864,18 @@ func (p *LiveChatPoller) dispatchPollComplete(count int, interval time.Duration)
 	}
 }
 
+// dispatchRawResponse sends the full poll response to raw response handlers.
+func (p *LiveChatPoller) dispatchRawResponse(resp *LiveChatMessageListResponse) {
+	p.handlerMu.RLock()
+	handlers := make([]*rawResponseHandler, len(p.rawResponseHandlers))
+	copy(handlers, p.rawResponseHandlers)
+	p.handlerMu.RUnlock()
+
+	for _, h := range handlers {
+		p.safeCall(func() { h.fn(resp) })
+	}
+}
+
 // safeCall executes a handler function with panic recovery.
 func (p *LiveChatPoller) safeCall(fn func()) {
 	defer func() {
@@ -594,7 +898,8 @@ func (p *LiveChatPoller) safeCall(fn func()) {
 	fn()
 }
 
-// SendMessage sends a text message to the live chat.
+// SendMessage sends a text message to the live chat. Transient failures are
+// retried if the poller was created with WithSendRetry.
 func (p *LiveChatPoller) SendMessage(ctx context.Context, text string) (*LiveChatMessage, error) {
 	if text == "" {
 		return nil, errors.New("text cannot be empty")
@@ -612,13 +917,29 @@ func (p *LiveChatPoller) SendMessage(ctx context.Context, text string) (*LiveCha
 
 	query := url.Values{"part": {"snippet"}}
 
-	var resp LiveChatMessage
-	err := p.client.Post(ctx, "liveChat/messages", query, req, "liveChatMessages.insert", &resp)
-	if err != nil {
-		return nil, fmt.Errorf("sending message: %w", err)
-	}
+	for attempt := 0; ; attempt++ {
+		var resp LiveChatMessage
+		err := p.client.Post(ctx, "liveChat/messages", query, req, "liveChatMessages.insert", &resp)
+		if err == nil {
+			return &resp, nil
+		}
+		if attempt >= p.sendRetries || !core.IsRetryable(err) {
+			return nil, fmt.Errorf("sending message: %w", err)
+		}
 
-	return &resp, nil
+		// Wait with backoff, at least as long as a rate limit asks
+		delay := p.backoff.Delay(attempt)
+		var rateErr *core.RateLimitError
+		if errors.As(err, &rateErr) {
+			delay = max(delay, rateErr.RetryAfter)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("sending message: %w", err)
+		case <-time.After(delay):
+		}
+	}
 }
 
 // DeleteMessage deletes a message from the live chat.
@@ -744,6 +1065,7 @@ func (p *LiveChatPoller) RemoveModerator(ctx context.Context, moderatorID string
 // ListModeratorsParams contains parameters for listing moderators.
 type ListModeratorsParams struct {
 	// MaxResults is the maximum number of items to return (1-50, default 5).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken for pagination.
@@ -759,9 +1081,7 @@ func (p *LiveChatPoller) ListModerators(ctx context.Context, params *ListModerat
 	}
 
 	if params != nil {
-		if params.MaxResults > 0 {
-			query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-		}
+		core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 		if params.PageToken != "" {
 			query.Set("pageToken", params.PageToken)
 		}
@@ -776,6 +1096,53 @@ func (p *LiveChatPoller) ListModerators(ctx context.Context, params *ListModerat
 	return &resp, nil
 }
 
+// ListAllModerators retrieves every moderator for the live chat, following
+// page tokens until the last page. Moderators are deduplicated by ID, since
+// the list can shift between page requests, and keep the API's order.
+//
+// params is optional: MaxResults sets the page size (default 50, the API
+// maximum) and PageToken starts from a later page. params is not modified.
+// Cancelling ctx stops between pages.
+// Costs 50 quota units per page.
+func (p *LiveChatPoller) ListAllModerators(ctx context.Context, params *ListModeratorsParams) ([]*LiveChatModerator, error) {
+	page := ListModeratorsParams{MaxResults: 50}
+	if params != nil {
+		page.PageToken = params.PageToken
+		if params.MaxResults > 0 {
+			page.MaxResults = params.MaxResults
+		}
+	}
+
+	start := page.PageToken
+	pages := core.Paginate(ctx, func(token string) ([]*LiveChatModerator, string, error) {
+		if token != "" {
+			page.PageToken = token
+		}
+		resp, err := p.ListModerators(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.NextPageToken == start {
+			return resp.Items, "", nil // Paginate only sees tokens after the first page
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+
+	var moderators []*LiveChatModerator
+	seen := make(map[string]bool)
+	for m, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		if m == nil || seen[m.ID] {
+			continue
+		}
+		seen[m.ID] = true
+		moderators = append(moderators, m)
+	}
+	return moderators, nil
+}
+
 // TransitionChatMode changes the chat mode for the live chat.
 // Valid modes: ChatModeSubscribersOnly, ChatModeMembersOnly, ChatModeSlowMode, ChatModeNormal.
 // For slow mode, use TransitionChatModeWithDelay to specify the delay.
@@ -832,6 +1199,7 @@ func (p *LiveChatPoller) SetPageToken(token string) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	p.pageToken = token
+	p.hasPendingToken = false
 }
 
 // ResetPageToken clears the page token.
@@ -840,6 +1208,7 @@ func (p *LiveChatPoller) ResetPageToken() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	p.pageToken = ""
+	p.hasPendingToken = false
 }
 
 // Reset clears all polling state, preparing the poller for reuse.
@@ -852,7 +1221,9 @@ func (p *LiveChatPoller) Reset() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	p.pageToken = ""
+	p.hasPendingToken = false
 	p.pollInterval = 0
+	p.idlePolls = 0
 	return nil
 }
 
@@ -863,6 +1234,7 @@ type ListSuperChatEventsParams struct {
 	HL string
 
 	// MaxResults is the maximum number of items to return (1-50, default 5).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken for pagination.
@@ -887,9 +1259,7 @@ func ListSuperChatEvents(ctx context.Context, client *core.Client, params *ListS
 		if params.HL != "" {
 			query.Set("hl", params.HL)
 		}
-		if params.MaxResults > 0 {
-			query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-		}
+		core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 		if params.PageToken != "" {
 			query.Set("pageToken", params.PageToken)
 		}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -112,6 +114,33 @@ func TestLiveChatPoller_StartStop(t *testing.T) {
 	poller.Stop()
 }
 
+func TestLiveChatPoller_RunGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 1000})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123", WithMinPollInterval(10*time.Millisecond))
+	stream := NewLiveChatStream(client, "chat123", WithStreamBaseURL(server.URL))
+
+	group := core.NewRunGroup(context.Background())
+	if err := group.Add(poller, stream); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if !poller.IsRunning() || !stream.IsRunning() {
+		t.Error("group members not running after Add()")
+	}
+
+	if err := group.Stop(); err != nil {
+		t.Errorf("Stop() error = %v", err)
+	}
+	if poller.IsRunning() || stream.IsRunning() {
+		t.Error("group members still running after Stop()")
+	}
+}
+
 func TestLiveChatPoller_OnMessage(t *testing.T) {
 	var receivedMessages []*LiveChatMessage
 	var mu sync.Mutex
@@ -251,6 +280,288 @@ func TestLiveChatPoller_OnError(t *testing.T) {
 	poller.Stop()
 }
 
+func TestLiveChatPoller_SkipBacklog(t *testing.T) {
+	message := func(id string, published time.Time) *LiveChatMessage {
+		return &LiveChatMessage{ID: id, Snippet: &MessageSnippet{Type: MessageTypeText, PublishedAt: published}}
+	}
+
+	tests := []struct {
+		name      string
+		skip      bool
+		pageToken string
+		want      []string
+	}{
+		{name: "skips backlog", skip: true, want: []string{"new1", "late", "new2"}},
+		{name: "disabled", skip: false, want: []string{"old1", "old2", "new1", "late", "new2"}},
+		{name: "resuming from page token", skip: true, pageToken: "checkpoint", want: []string{"old1", "old2", "new1", "late", "new2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var resp LiveChatMessageListResponse
+				switch requests.Add(1) {
+				case 1:
+					// Quiet chat: nothing yet, so the backlog window stays open
+				case 2:
+					resp.Items = []*LiveChatMessage{
+						message("old1", start.Add(-time.Hour)),
+						message("old2", start.Add(-time.Second)),
+						message("new1", time.Now().Add(time.Second)),
+					}
+				case 3:
+					// Caught up: an old message arriving late is delivered
+					resp.Items = []*LiveChatMessage{
+						message("late", start.Add(-time.Minute)),
+						message("new2", time.Now().Add(time.Second)),
+					}
+				}
+				resp.NextPageToken = "next"
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			poller := NewLiveChatPoller(client, "chat123",
+				WithMinPollInterval(time.Millisecond),
+				WithSkipBacklog(tt.skip),
+			)
+			poller.SetPageToken(tt.pageToken)
+
+			var (
+				mu  sync.Mutex
+				got []string
+			)
+			poller.OnMessage(func(msg *LiveChatMessage) {
+				mu.Lock()
+				got = append(got, msg.ID)
+				mu.Unlock()
+			})
+
+			if err := poller.Start(context.Background()); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			deadline := time.Now().Add(2 * time.Second)
+			for requests.Load() < 4 && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+			poller.Stop()
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("received %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiveChatPoller_TransientErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch n := requests.Add(1); {
+		case n <= 3, n == 5:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"code":500,"message":"backend error"}}`))
+		case n == 4:
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+				Items: []*LiveChatMessage{{ID: "msg1", Snippet: &MessageSnippet{Type: MessageTypeText}}},
+			})
+		default:
+			_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{})
+		}
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123",
+		WithMinPollInterval(time.Millisecond),
+		WithBackoff(core.NewBackoffConfig(core.WithBaseDelay(time.Millisecond), core.WithJitter(0))),
+	)
+
+	var (
+		mu     sync.Mutex
+		counts []int
+	)
+	poller.OnError(func(err error) {
+		var pollErr *PollError
+		if !errors.As(err, &pollErr) {
+			t.Errorf("error = %v, want *PollError", err)
+			return
+		}
+		if pollErr.Fatal {
+			t.Errorf("500 reported as fatal: %v", err)
+		}
+		var apiErr *core.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("underlying error = %v, want APIError 500", pollErr.Err)
+		}
+		mu.Lock()
+		counts = append(counts, pollErr.ConsecutiveErrors)
+		mu.Unlock()
+	})
+	var received atomic.Int32
+	poller.OnMessage(func(*LiveChatMessage) { received.Add(1) })
+
+	if err := poller.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer poller.Stop()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for requests.Load() < 7 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if !poller.IsRunning() {
+		t.Error("poller stopped after transient errors")
+	}
+	if received.Load() != 1 {
+		t.Errorf("received %d messages, want 1", received.Load())
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if fmt.Sprint(counts) != "[1 2 3 1]" {
+		t.Errorf("ConsecutiveErrors = %v, want [1 2 3 1]", counts)
+	}
+}
+
+func TestLiveChatPoller_FatalErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		reason    string
+		wantFatal bool
+	}{
+		{"forbidden", http.StatusForbidden, "forbidden", true},
+		{"chat ended", http.StatusForbidden, "liveChatEnded", true},
+		{"chat disabled", http.StatusForbidden, "liveChatDisabled", true},
+		{"not found", http.StatusNotFound, "liveChatNotFound", true},
+		{"quota exceeded", http.StatusForbidden, "quotaExceeded", true},
+		{"unauthorized", http.StatusUnauthorized, "authError", false},
+		{"rate limited", http.StatusTooManyRequests, "rateLimitExceeded", false},
+		{"unavailable", http.StatusServiceUnavailable, "backendError", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.status)
+				_, _ = fmt.Fprintf(w, `{"error":{"code":%d,"message":"failed","errors":[{"reason":%q}]}}`, tt.status, tt.reason)
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			poller := NewLiveChatPoller(client, "chat123",
+				WithMinPollInterval(time.Millisecond),
+				WithBackoff(core.NewBackoffConfig(core.WithBaseDelay(time.Millisecond), core.WithMaxDelay(time.Millisecond))),
+			)
+
+			var first atomic.Pointer[PollError]
+			poller.OnError(func(err error) {
+				var pollErr *PollError
+				if errors.As(err, &pollErr) {
+					first.CompareAndSwap(nil, pollErr)
+				}
+			})
+			var disconnected atomic.Bool
+			poller.OnDisconnect(func() { disconnected.Store(true) })
+
+			if err := poller.Start(context.Background()); err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			defer poller.Stop()
+
+			deadline := time.Now().Add(2 * time.Second)
+			for time.Now().Before(deadline) {
+				if tt.wantFatal && !poller.IsRunning() || !tt.wantFatal && requests.Load() >= 3 {
+					break
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+
+			pollErr := first.Load()
+			if pollErr == nil {
+				t.Fatal("OnError not called with a *PollError")
+			}
+			if pollErr.Fatal != tt.wantFatal {
+				t.Errorf("Fatal = %v, want %v (err: %v)", pollErr.Fatal, tt.wantFatal, pollErr)
+			}
+			if tt.wantFatal {
+				if poller.IsRunning() || !disconnected.Load() {
+					t.Error("poller did not stop after a fatal error")
+				}
+				if requests.Load() != 1 {
+					t.Errorf("requests = %d, want 1", requests.Load())
+				}
+				select {
+				case <-poller.Done():
+				case <-time.After(time.Second):
+					t.Fatal("Done() not closed after a fatal error")
+				}
+				if err := poller.Err(); err != pollErr {
+					t.Errorf("Err() = %v, want %v", err, pollErr)
+				}
+			} else if !poller.IsRunning() {
+				t.Error("poller stopped after a transient error")
+			}
+		})
+	}
+}
+
+func TestLiveChatPoller_DoneAfterStop(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"pollingIntervalMillis":1000}`))
+	}))
+	defer server.Close()
+
+	poller := NewLiveChatPoller(core.NewClient(core.WithBaseURL(server.URL)), "chat123")
+	if poller.Done() != nil {
+		t.Error("Done() before Start should be nil")
+	}
+	if err := poller.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	done := poller.Done()
+	select {
+	case <-done:
+		t.Fatal("Done() closed while running")
+	default:
+	}
+
+	poller.Stop()
+	select {
+	case <-done:
+	default:
+		t.Error("Done() not closed after Stop")
+	}
+	if err := poller.Err(); err != nil {
+		t.Errorf("Err() after Stop = %v, want nil", err)
+	}
+}
+
+func TestPollError_Error(t *testing.T) {
+	apiErr := &core.APIError{StatusCode: 500, Message: "backend error"}
+
+	transient := &PollError{Err: apiErr, ConsecutiveErrors: 3}
+	if got, want := transient.Error(), "live chat poll failed (3 in a row), retrying: "+apiErr.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	fatal := &PollError{Err: apiErr, ConsecutiveErrors: 1, Fatal: true}
+	if got, want := fatal.Error(), "live chat poll failed, stopping: "+apiErr.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(fatal, apiErr) {
+		t.Error("PollError does not unwrap to the underlying error")
+	}
+}
+
 func TestLiveChatPoller_OnPollComplete(t *testing.T) {
 	var pollCount atomic.Int32
 	var lastMessageCount atomic.Int32
@@ -294,6 +605,162 @@ func TestLiveChatPoller_OnPollComplete(t *testing.T) {
 	poller.Stop()
 }
 
+func TestLiveChatPoller_AdaptInterval(t *testing.T) {
+	poller := NewLiveChatPoller(core.NewClient(), "chat123",
+		WithMinPollInterval(2*time.Second),
+		WithMaxPollInterval(30*time.Second),
+		WithAdaptivePolling(true),
+	)
+
+	steps := []struct {
+		name     string
+		interval time.Duration
+		floor    time.Duration
+		count    int
+		want     time.Duration
+	}{
+		{"busy", 2 * time.Second, time.Second, 5, 2 * time.Second},
+		{"idle 1", 2 * time.Second, time.Second, 0, 4 * time.Second},
+		{"idle 2", 2 * time.Second, time.Second, 0, 8 * time.Second},
+		{"idle 3", 2 * time.Second, time.Second, 0, 16 * time.Second},
+		{"idle 4 capped", 2 * time.Second, time.Second, 0, 30 * time.Second},
+		{"idle 5 held", 2 * time.Second, time.Second, 0, 30 * time.Second},
+		{"busy steps down", 2 * time.Second, time.Second, 1, 16 * time.Second},
+		{"busy 2", 2 * time.Second, time.Second, 3, 8 * time.Second},
+		{"busy 3", 2 * time.Second, time.Second, 1, 4 * time.Second},
+		{"busy 4 normal", 2 * time.Second, time.Second, 1, 2 * time.Second},
+		{"busy 5 held", 2 * time.Second, time.Second, 1, 2 * time.Second},
+		{"idle after busy", 2 * time.Second, time.Second, 0, 4 * time.Second},
+		{"api floor", 30 * time.Second, 45 * time.Second, 0, 45 * time.Second},
+	}
+
+	for _, step := range steps {
+		got := poller.adaptInterval(step.interval, step.floor, step.count)
+		if got != step.want {
+			t.Errorf("%s: adaptInterval() = %v, want %v", step.name, got, step.want)
+		}
+	}
+}
+
+func TestLiveChatPoller_AdaptivePolling(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := LiveChatMessageListResponse{PollingIntervalMillis: 1}
+		// The third poll has a message; the rest are empty.
+		if polls.Add(1) == 3 {
+			resp.Items = []*LiveChatMessage{{ID: "msg1", Snippet: &MessageSnippet{Type: MessageTypeText}}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123",
+		WithMinPollInterval(5*time.Millisecond),
+		WithMaxPollInterval(40*time.Millisecond),
+		WithAdaptivePolling(true),
+	)
+
+	var mu sync.Mutex
+	var intervals []time.Duration
+	poller.OnPollComplete(func(count int, interval time.Duration) {
+		mu.Lock()
+		intervals = append(intervals, interval)
+		mu.Unlock()
+	})
+
+	_ = poller.Start(context.Background())
+	deadline := time.Now().Add(2 * time.Second)
+	for polls.Load() < 6 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	poller.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []time.Duration{
+		10 * time.Millisecond, // idle
+		20 * time.Millisecond, // idle
+		10 * time.Millisecond, // message: one step down
+		20 * time.Millisecond, // idle
+		40 * time.Millisecond, // idle, capped
+	}
+	if len(intervals) < len(want) {
+		t.Fatalf("got %d polls, want at least %d", len(intervals), len(want))
+	}
+	for i, w := range want {
+		if intervals[i] != w {
+			t.Errorf("interval[%d] = %v, want %v (all: %v)", i, intervals[i], w, intervals)
+		}
+	}
+}
+
+func TestLiveChatPoller_OnRawResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+			NextPageToken:         "page-" + r.URL.Query().Get("pageToken"),
+			PollingIntervalMillis: 1000,
+			PageInfo:              &PageInfo{TotalResults: 1, ResultsPerPage: 1},
+			Items: []*LiveChatMessage{
+				{ID: "msg1", Snippet: &MessageSnippet{Type: MessageTypeText}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123")
+
+	var responses []*LiveChatMessageListResponse
+	poller.OnRawResponse(func(resp *LiveChatMessageListResponse) {
+		responses = append(responses, resp)
+	})
+	unsub := poller.OnRawResponse(func(resp *LiveChatMessageListResponse) {
+		panic("test panic")
+	})
+	var errs []error
+	poller.OnError(func(err error) { errs = append(errs, err) })
+
+	if _, _, err := poller.poll(context.Background(), false); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+
+	if len(responses) != 1 {
+		t.Fatalf("got %d responses, want 1", len(responses))
+	}
+	resp := responses[0]
+	if resp.NextPageToken != "page-" {
+		t.Errorf("NextPageToken = %q, want 'page-'", resp.NextPageToken)
+	}
+	if resp.PollingIntervalMillis != 1000 {
+		t.Errorf("PollingIntervalMillis = %d, want 1000", resp.PollingIntervalMillis)
+	}
+	if resp.PageInfo == nil || resp.PageInfo.TotalResults != 1 {
+		t.Errorf("PageInfo = %+v, want TotalResults 1", resp.PageInfo)
+	}
+	if len(errs) != 1 {
+		t.Errorf("got %d errors from panicking handler, want 1", len(errs))
+	}
+
+	// Unsubscribed handlers are not called; unsubscribe is idempotent
+	unsub()
+	unsub()
+	if _, _, err := poller.poll(context.Background(), false); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	if len(responses) != 2 {
+		t.Fatalf("got %d responses, want 2", len(responses))
+	}
+	if responses[1].NextPageToken != "page-page-" {
+		t.Errorf("NextPageToken = %q, want 'page-page-'", responses[1].NextPageToken)
+	}
+	if len(errs) != 1 {
+		t.Errorf("got %d errors after unsubscribe, want 1", len(errs))
+	}
+}
+
 func TestLiveChatPoller_OnDelete(t *testing.T) {
 	var deletedID string
 	var mu sync.Mutex
@@ -974,6 +1441,95 @@ func TestLiveChatPoller_AllHandlerTypes(t *testing.T) {
 	poller.handlerMu.RUnlock()
 }
 
+func TestLiveChatPoller_ListAllModerators(t *testing.T) {
+	pages := map[string]LiveChatModeratorListResponse{
+		"": {
+			Items:         []*LiveChatModerator{{ID: "mod1"}, {ID: "mod2"}},
+			NextPageToken: "p2",
+		},
+		"p2": {
+			// mod2 shifted onto this page between requests.
+			Items:         []*LiveChatModerator{{ID: "mod2"}, {ID: "mod3"}},
+			NextPageToken: "p3",
+		},
+		"p3": {Items: []*LiveChatModerator{{ID: "mod4"}}},
+	}
+
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		requests = append(requests, q)
+		if q.Get("pageToken") == "fail" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(pages[q.Get("pageToken")])
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123")
+
+	t.Run("all pages", func(t *testing.T) {
+		requests = nil
+		mods, err := poller.ListAllModerators(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("ListAllModerators() error = %v", err)
+		}
+		var ids []string
+		for _, m := range mods {
+			ids = append(ids, m.ID)
+		}
+		if want := []string{"mod1", "mod2", "mod3", "mod4"}; !slices.Equal(ids, want) {
+			t.Errorf("IDs = %v, want %v", ids, want)
+		}
+		if len(requests) != 3 {
+			t.Errorf("requests = %d, want 3", len(requests))
+		}
+		if requests[0].Get("maxResults") != "50" {
+			t.Errorf("maxResults = %q, want default 50", requests[0].Get("maxResults"))
+		}
+	})
+
+	t.Run("params", func(t *testing.T) {
+		requests = nil
+		params := &ListModeratorsParams{MaxResults: 10, PageToken: "p3"}
+		mods, err := poller.ListAllModerators(context.Background(), params)
+		if err != nil {
+			t.Fatalf("ListAllModerators() error = %v", err)
+		}
+		if len(mods) != 1 || mods[0].ID != "mod4" {
+			t.Errorf("mods = %v, want [mod4]", mods)
+		}
+		if requests[0].Get("maxResults") != "10" {
+			t.Errorf("maxResults = %q, want 10", requests[0].Get("maxResults"))
+		}
+		if params.PageToken != "p3" {
+			t.Errorf("params.PageToken modified to %q", params.PageToken)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := poller.ListAllModerators(context.Background(), &ListModeratorsParams{PageToken: "fail"})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		requests = nil
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := poller.ListAllModerators(ctx, nil); !errors.Is(err, context.Canceled) {
+			t.Errorf("error = %v, want context.Canceled", err)
+		}
+		if len(requests) != 0 {
+			t.Errorf("requests = %d, want 0 after cancellation", len(requests))
+		}
+	})
+}
+
 func TestLiveChatPoller_ListModerators(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1309,6 +1865,84 @@ func TestLiveChatPoller_SendMessage_Error(t *testing.T) {
 	})
 }
 
+func TestLiveChatPoller_SendMessage_Retry(t *testing.T) {
+	tests := []struct {
+		name      string
+		retries   int
+		failures  int
+		status    int
+		wantCalls int32
+		wantErr   bool
+	}{
+		{name: "no retry by default", retries: 0, failures: 1, status: http.StatusInternalServerError, wantCalls: 1, wantErr: true},
+		{name: "recovers from 500", retries: 2, failures: 2, status: http.StatusInternalServerError, wantCalls: 3},
+		{name: "recovers from 503", retries: 1, failures: 1, status: http.StatusServiceUnavailable, wantCalls: 2},
+		{name: "gives up after retries", retries: 2, failures: 5, status: http.StatusBadGateway, wantCalls: 3, wantErr: true},
+		{name: "no retry on 400", retries: 3, failures: 1, status: http.StatusBadRequest, wantCalls: 1, wantErr: true},
+		{name: "no retry on 403", retries: 3, failures: 1, status: http.StatusForbidden, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) <= int32(tt.failures) {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(`{"error": {"code": 0, "message": "failed"}}`))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(LiveChatMessage{ID: "sent123"})
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			poller := NewLiveChatPoller(client, "chat123",
+				WithSendRetry(tt.retries),
+				WithBackoff(core.NewBackoffConfig(core.WithBaseDelay(time.Millisecond), core.WithJitter(0))),
+			)
+
+			msg, err := poller.SendMessage(context.Background(), "test")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SendMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && msg.ID != "sent123" {
+				t.Errorf("msg.ID = %q, want sent123", msg.ID)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("requests = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestLiveChatPoller_SendMessage_RetryCanceled(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	poller := NewLiveChatPoller(client, "chat123",
+		WithSendRetry(5),
+		WithBackoff(core.NewBackoffConfig(core.WithBaseDelay(time.Hour))),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := poller.SendMessage(ctx, "test")
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("SendMessage() error = %v, want the 500 APIError", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
 func TestLiveChatPoller_DeleteMessage_Error(t *testing.T) {
 	t.Run("empty message ID", func(t *testing.T) {
 		client := core.NewClient()
@@ -0,0 +1,116 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// ErrHistoryDisabled is returned by PurgeUser when the bot was created
+// without WithHistoryBuffer, so there are no message IDs to delete.
+var ErrHistoryDisabled = errors.New("message history is disabled (see WithHistoryBuffer)")
+
+// PurgeError is returned by PurgeUser when the ban or some deletions
+// failed. The other actions were still attempted.
+type PurgeError struct {
+	// ChannelID is the purged user's channel ID.
+	ChannelID string
+
+	// BanErr is the error from banning the user, or nil if the ban
+	// succeeded.
+	BanErr error
+
+	// Failed maps the IDs of messages that could not be deleted to their
+	// errors.
+	Failed map[string]error
+}
+
+// Error implements the error interface.
+func (e *PurgeError) Error() string {
+	msg := fmt.Sprintf("purging %s:", e.ChannelID)
+	if e.BanErr != nil {
+		msg += fmt.Sprintf(" ban failed: %v;", e.BanErr)
+	}
+	if len(e.Failed) > 0 {
+		ids := make([]string, 0, len(e.Failed))
+		for id := range e.Failed {
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+		msg += fmt.Sprintf(" %d deletion(s) failed, first %s: %v", len(ids), ids[0], e.Failed[ids[0]])
+	}
+	return msg
+}
+
+// Unwrap returns the ban error and the deletion errors.
+func (e *PurgeError) Unwrap() []error {
+	var errs []error
+	if e.BanErr != nil {
+		errs = append(errs, e.BanErr)
+	}
+	for _, err := range e.Failed {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// PurgeUser bans a user and deletes all of their messages in the history
+// buffer, e.g. to clean up after a raid. It returns the number of messages
+// deleted. Messages already deleted by someone else count as deleted.
+//
+// The user is banned first so they cannot post while their messages are
+// deleted. A failed ban or deletion does not stop the others; PurgeUser
+// then returns a *PurgeError describing each failure along with the count.
+// Only messages still in the buffer are found, so size WithHistoryBuffer to
+// cover the messages a raid may post; without it PurgeUser returns
+// ErrHistoryDisabled without taking any action.
+//
+// Each deletion costs 50 quota units and the ban 50 more.
+func (c *ChatBotClient) PurgeUser(ctx context.Context, channelID string) (int, error) {
+	if channelID == "" {
+		return 0, errors.New("channelID cannot be empty")
+	}
+	if c.history == nil {
+		return 0, ErrHistoryDisabled
+	}
+
+	var ids []string
+	for _, msg := range c.RecentMessages() {
+		if msg.Author != nil && msg.Author.ChannelID == channelID {
+			ids = append(ids, msg.ID)
+		}
+	}
+
+	purgeErr := &PurgeError{ChannelID: channelID}
+	if err := c.Ban(ctx, channelID); err != nil {
+		if ctx.Err() != nil || errors.Is(err, ErrNotRunning) || errors.Is(err, ErrShuttingDown) {
+			return 0, err
+		}
+		purgeErr.BanErr = err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		err := c.Delete(ctx, id)
+		var apiErr *core.APIError
+		switch {
+		case err == nil, errors.As(err, &apiErr) && apiErr.IsNotFound():
+			purged++
+		case ctx.Err() != nil:
+			return purged, err
+		default:
+			if purgeErr.Failed == nil {
+				purgeErr.Failed = make(map[string]error)
+			}
+			purgeErr.Failed[id] = err
+		}
+	}
+
+	if purgeErr.BanErr != nil || len(purgeErr.Failed) > 0 {
+		return purged, purgeErr
+	}
+	return purged, nil
+}
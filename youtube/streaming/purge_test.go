@@ -0,0 +1,172 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func TestChatBotClient_PurgeUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		banStatus   int
+		deleteCodes map[string]int // status by message ID; default 204
+		wantPurged  int
+		wantDeletes []string
+		wantBanErr  bool
+		wantFailed  []string
+	}{
+		{
+			name:        "all deleted",
+			wantPurged:  3,
+			wantDeletes: []string{"m1", "m3", "m5"},
+		},
+		{
+			name:        "already deleted counts",
+			deleteCodes: map[string]int{"m3": http.StatusNotFound},
+			wantPurged:  3,
+			wantDeletes: []string{"m1", "m3", "m5"},
+		},
+		{
+			name:        "per-message failure",
+			deleteCodes: map[string]int{"m3": http.StatusInternalServerError},
+			wantPurged:  2,
+			wantDeletes: []string{"m1", "m3", "m5"},
+			wantFailed:  []string{"m3"},
+		},
+		{
+			name:        "ban failure still deletes",
+			banStatus:   http.StatusForbidden,
+			wantPurged:  3,
+			wantDeletes: []string{"m1", "m3", "m5"},
+			wantBanErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				deletes []string
+				bans    []string
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet:
+					_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{PollingIntervalMillis: 60000})
+				case r.Method == http.MethodPost && r.URL.Path == "/liveChat/bans":
+					var req InsertBanRequest
+					_ = json.NewDecoder(r.Body).Decode(&req)
+					mu.Lock()
+					bans = append(bans, req.Snippet.BannedUserDetails.ChannelID)
+					mu.Unlock()
+					if tt.banStatus != 0 {
+						w.WriteHeader(tt.banStatus)
+						_, _ = w.Write([]byte(`{"error": {"code": 403, "message": "forbidden", "errors": [{"reason": "forbidden"}]}}`))
+						return
+					}
+					_ = json.NewEncoder(w).Encode(LiveChatBan{ID: "ban1"})
+				case r.Method == http.MethodDelete && r.URL.Path == "/liveChat/messages":
+					id := r.URL.Query().Get("id")
+					mu.Lock()
+					deletes = append(deletes, id)
+					mu.Unlock()
+					if code, ok := tt.deleteCodes[id]; ok {
+						w.WriteHeader(code)
+						_, _ = w.Write([]byte(`{"error": {"code": 0, "message": "failed"}}`))
+						return
+					}
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			bot, _ := NewChatBotClient(client, nil, "chat123", WithHistoryBuffer(10))
+			if err := bot.Connect(context.Background()); err != nil {
+				t.Fatalf("Connect() error = %v", err)
+			}
+			defer func() { _ = bot.Close() }()
+
+			for i, author := range []string{"raider", "user", "raider", "user", "raider"} {
+				bot.handleMessage(&LiveChatMessage{
+					ID:            "m" + strconv.Itoa(i+1),
+					Snippet:       &MessageSnippet{Type: MessageTypeText, DisplayMessage: "msg"},
+					AuthorDetails: &AuthorDetails{ChannelID: author},
+				})
+			}
+
+			purged, err := bot.PurgeUser(context.Background(), "raider")
+			if purged != tt.wantPurged {
+				t.Errorf("purged = %d, want %d", purged, tt.wantPurged)
+			}
+			if !slices.Equal(bans, []string{"raider"}) {
+				t.Errorf("bans = %v, want [raider]", bans)
+			}
+			if !slices.Equal(deletes, tt.wantDeletes) {
+				t.Errorf("deletes = %v, want %v", deletes, tt.wantDeletes)
+			}
+
+			if !tt.wantBanErr && tt.wantFailed == nil {
+				if err != nil {
+					t.Fatalf("PurgeUser() error = %v", err)
+				}
+				return
+			}
+			var purgeErr *PurgeError
+			if !errors.As(err, &purgeErr) {
+				t.Fatalf("PurgeUser() error = %v, want *PurgeError", err)
+			}
+			if (purgeErr.BanErr != nil) != tt.wantBanErr {
+				t.Errorf("BanErr = %v, want error %v", purgeErr.BanErr, tt.wantBanErr)
+			}
+			var failed []string
+			for id := range purgeErr.Failed {
+				failed = append(failed, id)
+			}
+			if !slices.Equal(failed, tt.wantFailed) {
+				t.Errorf("Failed = %v, want %v", failed, tt.wantFailed)
+			}
+			var apiErr *core.APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("errors.As(*core.APIError) failed for %v", err)
+			}
+		})
+	}
+}
+
+func TestChatBotClient_PurgeUser_Errors(t *testing.T) {
+	client := core.NewClient()
+
+	t.Run("history disabled", func(t *testing.T) {
+		bot, _ := NewChatBotClient(client, nil, "chat123")
+		if _, err := bot.PurgeUser(context.Background(), "raider"); !errors.Is(err, ErrHistoryDisabled) {
+			t.Errorf("PurgeUser() error = %v, want ErrHistoryDisabled", err)
+		}
+	})
+
+	t.Run("empty channel ID", func(t *testing.T) {
+		bot, _ := NewChatBotClient(client, nil, "chat123", WithHistoryBuffer(10))
+		if _, err := bot.PurgeUser(context.Background(), ""); err == nil {
+			t.Error("PurgeUser() error = nil, want error")
+		}
+	})
+
+	t.Run("not connected", func(t *testing.T) {
+		bot, _ := NewChatBotClient(client, nil, "chat123", WithHistoryBuffer(10))
+		if _, err := bot.PurgeUser(context.Background(), "raider"); !errors.Is(err, ErrNotRunning) {
+			t.Errorf("PurgeUser() error = %v, want ErrNotRunning", err)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package streaming
+
+import "strings"
+
+// TextSegment is a run of plain text or a single emoji in a chat message.
+// Exactly one of Text and Emoji is set.
+type TextSegment struct {
+	// Text is the plain text of the segment.
+	Text string
+
+	// Emoji is the emoji of the segment.
+	Emoji *Emoji
+}
+
+// Emoji is an emoji shortcode in a chat message, such as a channel's custom
+// emoji (":_wave:") or a YouTube emoji (":face-blue-smiling:").
+type Emoji struct {
+	// ID is the shortcode including colons, e.g. ":_wave:".
+	ID string
+
+	// Label is the shortcode without colons, e.g. "_wave".
+	Label string
+
+	// ImageURL is the emoji image. The Data API does not include emoji
+	// images in chat messages, so it is empty unless set by the caller,
+	// e.g. from a map of the channel's emojis keyed by ID.
+	ImageURL string
+}
+
+// IsCustom reports whether the emoji is a channel custom emoji, whose
+// shortcodes start with an underscore.
+func (e *Emoji) IsCustom() bool {
+	return strings.HasPrefix(e.Label, "_")
+}
+
+// TextSegments splits the message text into plain text runs and emojis,
+// e.g. for an overlay that draws emojis as images:
+//
+//	for _, seg := range msg.Snippet.TextSegments() {
+//		if seg.Emoji != nil {
+//			drawImage(emojiImages[seg.Emoji.ID])
+//		} else {
+//			drawText(seg.Text)
+//		}
+//	}
+//
+// The API flattens emojis into their ":shortcode:" form in the message
+// text, so emojis are recognized by that form: letters, digits, "_", and
+// "-" between colons, with at least one letter (so "10:30:45" stays text).
+// A message without emojis is a single text segment. Uses
+// TextMessageDetails.MessageText when present, otherwise DisplayMessage;
+// returns nil for a message with no text.
+func (s *MessageSnippet) TextSegments() []TextSegment {
+	text := s.DisplayMessage
+	if s.TextMessageDetails != nil && s.TextMessageDetails.MessageText != "" {
+		text = s.TextMessageDetails.MessageText
+	}
+	if text == "" {
+		return nil
+	}
+
+	var segments []TextSegment
+	plainStart := 0
+	for i := 0; i < len(text); i++ {
+		if text[i] != ':' {
+			continue
+		}
+		end := strings.IndexByte(text[i+1:], ':')
+		if end < 0 {
+			break
+		}
+		label := text[i+1 : i+1+end]
+		if !isEmojiShortcode(label) {
+			continue
+		}
+
+		if plainStart < i {
+			segments = append(segments, TextSegment{Text: text[plainStart:i]})
+		}
+		segments = append(segments, TextSegment{Emoji: &Emoji{
+			ID:    ":" + label + ":",
+			Label: label,
+		}})
+		i += end + 1
+		plainStart = i + 1
+	}
+	if plainStart < len(text) {
+		segments = append(segments, TextSegment{Text: text[plainStart:]})
+	}
+	return segments
+}
+
+// isEmojiShortcode reports whether label, the text between two colons,
+// looks like an emoji shortcode.
+func isEmojiShortcode(label string) bool {
+	hasLetter := false
+	for _, r := range label {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			hasLetter = true
+		case r >= '0' && r <= '9', r == '_', r == '-':
+		default:
+			return false
+		}
+	}
+	return hasLetter
+}
@@ -0,0 +1,72 @@
+package streaming
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// describeSegments renders segments as "text" and [emoji] for comparison.
+func describeSegments(segments []TextSegment) string {
+	var parts []string
+	for _, seg := range segments {
+		if seg.Emoji != nil {
+			parts = append(parts, "["+seg.Emoji.ID+"]")
+		} else {
+			parts = append(parts, fmt.Sprintf("%q", seg.Text))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestMessageSnippet_TextSegments(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet *MessageSnippet
+		want    string
+	}{
+		{"plain text", &MessageSnippet{DisplayMessage: "hello chat"}, `"hello chat"`},
+		{"empty", &MessageSnippet{}, ""},
+		{"custom emoji", &MessageSnippet{DisplayMessage: "hi :_wave: all"}, `"hi " [:_wave:] " all"`},
+		{"only emojis", &MessageSnippet{DisplayMessage: ":_wave::face-blue-smiling:"}, `[:_wave:] [:face-blue-smiling:]`},
+		{"emoji at edges", &MessageSnippet{DisplayMessage: ":hype: go :hype:"}, `[:hype:] " go " [:hype:]`},
+		{"time is text", &MessageSnippet{DisplayMessage: "starts 10:30:45"}, `"starts 10:30:45"`},
+		{"spaces between colons", &MessageSnippet{DisplayMessage: "note: this is: fine"}, `"note: this is: fine"`},
+		{"unclosed", &MessageSnippet{DisplayMessage: "ratio 3:2 :_wave"}, `"ratio 3:2 :_wave"`},
+		{"adjacent colon", &MessageSnippet{DisplayMessage: "a: :_wave:"}, `"a: " [:_wave:]`},
+		{
+			"prefers message text",
+			&MessageSnippet{
+				DisplayMessage:     "flattened",
+				TextMessageDetails: &TextMessageDetails{MessageText: "gg :_clap:"},
+			},
+			`"gg " [:_clap:]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeSegments(tt.snippet.TextSegments()); got != tt.want {
+				t.Errorf("TextSegments() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmoji_Fields(t *testing.T) {
+	segments := (&MessageSnippet{DisplayMessage: ":_wave::smile:"}).TextSegments()
+	if len(segments) != 2 {
+		t.Fatalf("got %d segments, want 2", len(segments))
+	}
+
+	custom, standard := segments[0].Emoji, segments[1].Emoji
+	if custom.ID != ":_wave:" || custom.Label != "_wave" || !custom.IsCustom() {
+		t.Errorf("custom emoji = %+v, IsCustom %v", custom, custom.IsCustom())
+	}
+	if standard.Label != "smile" || standard.IsCustom() {
+		t.Errorf("standard emoji = %+v, IsCustom %v", standard, standard.IsCustom())
+	}
+	if custom.ImageURL != "" {
+		t.Errorf("ImageURL = %q, want empty", custom.ImageURL)
+	}
+}
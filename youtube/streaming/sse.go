@@ -28,6 +28,10 @@ const (
 
 	// DefaultSSEMaxReconnectDelay is the maximum backoff delay for reconnection attempts.
 	DefaultSSEMaxReconnectDelay = 30 * time.Second
+
+	// DefaultSSEFallbackThreshold is the number of consecutive connection
+	// failures after which a stream with polling fallback switches to polling.
+	DefaultSSEFallbackThreshold = 3
 )
 
 // sseMessageHandler handles incoming messages from SSE stream.
@@ -38,6 +42,7 @@ type sseErrorHandler struct{ fn func(error) }
 type sseConnectHandler struct{ fn func() }
 type sseDisconnectHandler struct{ fn func() }
 type sseResponseHandler struct{ fn func(*LiveChatMessageListResponse) }
+type sseFallbackHandler struct{ fn func(error) }
 
 // LiveChatStream provides Server-Sent Events (SSE) streaming for YouTube Live Chat.
 // It establishes a long-lived HTTP connection to receive chat messages in real-time
@@ -67,6 +72,7 @@ type LiveChatStream struct {
 	connectHandlers     []*sseConnectHandler
 	disconnectHandlers  []*sseDisconnectHandler
 	responseHandlers    []*sseResponseHandler
+	fallbackHandlers    []*sseFallbackHandler
 
 	// Lifecycle
 	lifecycleMu sync.Mutex
@@ -75,8 +81,15 @@ type LiveChatStream struct {
 	wg          sync.WaitGroup
 	backoff     *core.BackoffConfig
 
+	// Polling fallback
+	fallbackToPolling bool
+	fallbackThreshold int
+	pollTimeout       time.Duration
+	polling           atomic.Bool
+
 	// Authentication
-	accessToken string
+	accessToken   string
+	tokenProvider func(context.Context) (string, error)
 }
 
 // StreamOption configures a LiveChatStream.
@@ -95,6 +108,8 @@ func NewLiveChatStream(client *core.Client, liveChatID string, opts ...StreamOpt
 		reconnectDelay:    DefaultSSEReconnectDelay,
 		maxReconnectDelay: DefaultSSEMaxReconnectDelay,
 		backoff:           core.NewBackoffConfig(),
+		fallbackThreshold: DefaultSSEFallbackThreshold,
+		pollTimeout:       core.DefaultTimeout,
 	}
 
 	for _, opt := range opts {
@@ -170,6 +185,37 @@ func WithStreamAccessToken(token string) StreamOption {
 	return func(s *LiveChatStream) { s.accessToken = token }
 }
 
+// WithStreamTokenProvider sets a function to retrieve access tokens dynamically.
+// A fresh token is fetched before each connection attempt, including reconnects,
+// so long-running streams survive token expiry. Takes precedence over
+// WithStreamAccessToken.
+func WithStreamTokenProvider(provider func(context.Context) (string, error)) StreamOption {
+	return func(s *LiveChatStream) { s.tokenProvider = provider }
+}
+
+// WithStreamFallbackToPolling enables switching to HTTP polling
+// (liveChatMessages.list) when SSE connections keep failing, e.g. on networks
+// that block long-lived streams. After the fallback threshold is reached the
+// stream resumes from the last page token, honors pollingIntervalMillis
+// between requests, and keeps polling until stopped. Each poll times out
+// after core.DefaultTimeout and is retried. Poll errors are reported to
+// OnError as *PollError; as with LiveChatPoller, polling stops and the
+// stream disconnects when the chat ends, access is forbidden, or the quota
+// is exhausted. Handlers are unaffected; OnFallback reports the switch.
+func WithStreamFallbackToPolling(enabled bool) StreamOption {
+	return func(s *LiveChatStream) { s.fallbackToPolling = enabled }
+}
+
+// WithStreamFallbackThreshold sets how many consecutive connection failures
+// trigger the polling fallback (default DefaultSSEFallbackThreshold).
+func WithStreamFallbackThreshold(n int) StreamOption {
+	return func(s *LiveChatStream) {
+		if n > 0 {
+			s.fallbackThreshold = n
+		}
+	}
+}
+
 // WithStreamBaseURL sets a custom base URL (useful for testing).
 func WithStreamBaseURL(url string) StreamOption {
 	return func(s *LiveChatStream) {
@@ -184,6 +230,11 @@ func (s *LiveChatStream) LiveChatID() string {
 	return s.liveChatID
 }
 
+// IsPolling returns true if the stream has fallen back to HTTP polling.
+func (s *LiveChatStream) IsPolling() bool {
+	return s.polling.Load()
+}
+
 // IsRunning returns true if the stream is currently connected.
 func (s *LiveChatStream) IsRunning() bool {
 	return s.state.Load() == stateRunning
@@ -240,6 +291,7 @@ func (s *LiveChatStream) streamLoop(ctx context.Context) {
 	defer s.wg.Done()
 	defer s.state.Store(stateStopped)
 
+	s.polling.Store(false)
 	s.dispatchConnect()
 
 	var attempt int
@@ -274,6 +326,13 @@ func (s *LiveChatStream) streamLoop(ctx context.Context) {
 			backoffDelay := min(s.backoff.Delay(attempt), s.maxReconnectDelay)
 			attempt++
 
+			if s.fallbackToPolling && attempt >= s.fallbackThreshold {
+				s.polling.Store(true)
+				s.dispatchFallback(err)
+				s.pollLoop(ctx)
+				return
+			}
+
 			select {
 			case <-ctx.Done():
 				s.dispatchDisconnect()
@@ -288,8 +347,112 @@ func (s *LiveChatStream) streamLoop(ctx context.Context) {
 	}
 }
 
+// pollLoop polls for messages after falling back from SSE, until the
+// context is cancelled or a poll fails with an error that isFatalPollError
+// reports as permanent. Poll errors are dispatched as *PollError.
+func (s *LiveChatStream) pollLoop(ctx context.Context) {
+	var attempt int
+
+	for {
+		interval, err := s.poll(ctx)
+
+		if err != nil {
+			// A timed-out poll is retried; only the stream's own context stops it
+			if ctx.Err() != nil {
+				s.dispatchDisconnect()
+				return
+			}
+
+			attempt++
+			pollErr := &PollError{Err: err, ConsecutiveErrors: attempt, Fatal: isFatalPollError(err)}
+			s.dispatchError(pollErr)
+
+			// Stop on errors a retry cannot fix, such as the chat ending or
+			// access being revoked, as LiveChatPoller does
+			if pollErr.Fatal {
+				s.dispatchDisconnect()
+				return
+			}
+
+			interval = min(s.backoff.Delay(attempt-1), s.maxReconnectDelay)
+		} else {
+			attempt = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			s.dispatchDisconnect()
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// poll performs a single liveChatMessages.list request from the current page
+// token and returns the server's requested interval before the next poll.
+// Unlike the SSE connection, each poll is bounded by pollTimeout, since the
+// stream's HTTP client has no timeout of its own.
+func (s *LiveChatStream) poll(ctx context.Context) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.pollTimeout)
+	defer cancel()
+
+	if err := s.refreshAccessToken(ctx); err != nil {
+		return 0, err
+	}
+
+	s.mu.RLock()
+	pageToken := s.pageToken
+	s.mu.RUnlock()
+
+	u, err := url.Parse(s.baseURL + "/liveChat/messages")
+	if err != nil {
+		return 0, fmt.Errorf("building request: %w", err)
+	}
+	u.RawQuery = s.query(pageToken).Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("building request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "Yougopher/1.0")
+	if token := s.getAccessToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("polling: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	s.client.RecordQuota("liveChatMessages.list")
+
+	if resp.StatusCode >= 400 {
+		return 0, s.handleErrorResponse(resp)
+	}
+
+	var listResp LiveChatMessageListResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, core.MaxResponseBodySize)).Decode(&listResp); err != nil {
+		return 0, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := s.handleResponse(&listResp); err != nil {
+		return 0, err
+	}
+
+	interval := listResp.PollingInterval()
+	if interval <= 0 {
+		interval = DefaultMinPollInterval
+	}
+	return interval, nil
+}
+
 // connect establishes the SSE connection and processes events.
 func (s *LiveChatStream) connect(ctx context.Context) error {
+	if err := s.refreshAccessToken(ctx); err != nil {
+		return err
+	}
+
 	s.mu.RLock()
 	pageToken := s.pageToken
 	s.mu.RUnlock()
@@ -306,9 +469,7 @@ func (s *LiveChatStream) connect(ctx context.Context) error {
 	defer func() { _ = resp.Body.Close() }()
 
 	// Track quota usage
-	if qt := s.client.QuotaTracker(); qt != nil {
-		qt.Add("liveChatMessages.streamList", 1)
-	}
+	s.client.RecordQuota("liveChatMessages.streamList")
 
 	// Handle error responses
 	if resp.StatusCode >= 400 {
@@ -319,14 +480,22 @@ func (s *LiveChatStream) connect(ctx context.Context) error {
 	return s.processEvents(ctx, resp.Body)
 }
 
-// buildRequest creates the HTTP request for the SSE endpoint.
-func (s *LiveChatStream) buildRequest(ctx context.Context, pageToken string) (*http.Request, error) {
-	u, err := url.Parse(s.baseURL + "/liveChat/messages/stream")
+// refreshAccessToken fetches a fresh token from the token provider, if set.
+func (s *LiveChatStream) refreshAccessToken(ctx context.Context) error {
+	if s.tokenProvider == nil {
+		return nil
+	}
+	token, err := s.tokenProvider(ctx)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("getting access token: %w", err)
 	}
+	s.SetAccessToken(token)
+	return nil
+}
 
-	query := u.Query()
+// query returns the query parameters shared by streaming and polling requests.
+func (s *LiveChatStream) query(pageToken string) url.Values {
+	query := url.Values{}
 	query.Set("liveChatId", s.liveChatID)
 	query.Set("part", strings.Join(s.parts, ","))
 	query.Set("maxResults", fmt.Sprintf("%d", s.maxResults))
@@ -338,8 +507,16 @@ func (s *LiveChatStream) buildRequest(ctx context.Context, pageToken string) (*h
 	if pageToken != "" {
 		query.Set("pageToken", pageToken)
 	}
+	return query
+}
 
-	u.RawQuery = query.Encode()
+// buildRequest creates the HTTP request for the SSE endpoint.
+func (s *LiveChatStream) buildRequest(ctx context.Context, pageToken string) (*http.Request, error) {
+	u, err := url.Parse(s.baseURL + "/liveChat/messages/stream")
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = s.query(pageToken).Encode()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
@@ -446,7 +623,12 @@ func (s *LiveChatStream) processEvent(data string) error {
 	if err := json.Unmarshal([]byte(data), &resp); err != nil {
 		return fmt.Errorf("parsing response: %w", err)
 	}
+	return s.handleResponse(&resp)
+}
 
+// handleResponse records the page token and dispatches a response's
+// messages. It is shared by SSE events and fallback polling.
+func (s *LiveChatStream) handleResponse(resp *LiveChatMessageListResponse) error {
 	// Update page token for reconnection
 	if resp.NextPageToken != "" {
 		s.mu.Lock()
@@ -460,7 +642,7 @@ func (s *LiveChatStream) processEvent(data string) error {
 	}
 
 	// Dispatch the full response to response handlers
-	s.dispatchResponse(&resp)
+	s.dispatchResponse(resp)
 
 	// Dispatch individual messages
 	s.dispatchMessages(resp.Items)
@@ -690,6 +872,31 @@ func (s *LiveChatStream) OnResponse(fn func(*LiveChatMessageListResponse)) func(
 	}
 }
 
+// OnFallback registers a handler called when the stream switches from SSE
+// to polling (see WithStreamFallbackToPolling). The handler receives the
+// last SSE connection error.
+func (s *LiveChatStream) OnFallback(fn func(error)) func() {
+	s.handlerMu.Lock()
+	defer s.handlerMu.Unlock()
+
+	h := &sseFallbackHandler{fn: fn}
+	s.fallbackHandlers = append(s.fallbackHandlers, h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.handlerMu.Lock()
+			defer s.handlerMu.Unlock()
+			for i, handler := range s.fallbackHandlers {
+				if handler == h {
+					s.fallbackHandlers = slices.Delete(s.fallbackHandlers, i, i+1)
+					return
+				}
+			}
+		})
+	}
+}
+
 // dispatchMessages sends messages to all handlers.
 func (s *LiveChatStream) dispatchMessages(messages []*LiveChatMessage) {
 	s.handlerMu.RLock()
@@ -738,6 +945,18 @@ func (s *LiveChatStream) dispatchResponse(resp *LiveChatMessageListResponse) {
 	}
 }
 
+// dispatchFallback notifies all fallback handlers.
+func (s *LiveChatStream) dispatchFallback(err error) {
+	s.handlerMu.RLock()
+	handlers := make([]*sseFallbackHandler, len(s.fallbackHandlers))
+	copy(handlers, s.fallbackHandlers)
+	s.handlerMu.RUnlock()
+
+	for _, h := range handlers {
+		s.safeCall(func() { h.fn(err) })
+	}
+}
+
 // dispatchError sends an error to all error handlers.
 func (s *LiveChatStream) dispatchError(err error) {
 	s.handlerMu.RLock()
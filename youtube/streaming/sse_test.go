@@ -3,6 +3,7 @@ package streaming
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -652,6 +653,97 @@ func TestLiveChatStream_AccessToken(t *testing.T) {
 	}
 }
 
+func TestLiveChatStream_TokenProvider(t *testing.T) {
+	t.Run("fresh token on each reconnect", func(t *testing.T) {
+		var mu sync.Mutex
+		var authHeaders []string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+			mu.Unlock()
+			// Close immediately to force a reconnect
+			w.Header().Set("Content-Type", "text/event-stream")
+		}))
+		defer server.Close()
+
+		var calls atomic.Int32
+		provider := func(ctx context.Context) (string, error) {
+			return fmt.Sprintf("token-%d", calls.Add(1)), nil
+		}
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		stream := NewLiveChatStream(client, "chat123",
+			WithStreamAccessToken("static-token"),
+			WithStreamTokenProvider(provider),
+			WithStreamBaseURL(server.URL),
+		)
+
+		_ = stream.Start(context.Background())
+		time.Sleep(50 * time.Millisecond)
+		stream.Stop()
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(authHeaders) < 2 {
+			t.Fatalf("connections = %d, want at least 2", len(authHeaders))
+		}
+		for i, h := range authHeaders[:2] {
+			want := fmt.Sprintf("Bearer token-%d", i+1)
+			if h != want {
+				t.Errorf("connection %d Authorization = %q, want %q", i, h, want)
+			}
+		}
+	})
+
+	t.Run("provider error is dispatched and retried", func(t *testing.T) {
+		var connected atomic.Bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			connected.Store(true)
+			w.Header().Set("Content-Type", "text/event-stream")
+			<-r.Context().Done()
+		}))
+		defer server.Close()
+
+		var calls atomic.Int32
+		provider := func(ctx context.Context) (string, error) {
+			if calls.Add(1) == 1 {
+				return "", fmt.Errorf("token expired")
+			}
+			return "fresh-token", nil
+		}
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		stream := NewLiveChatStream(client, "chat123",
+			WithStreamTokenProvider(provider),
+			WithStreamBaseURL(server.URL),
+			WithStreamBackoff(&core.BackoffConfig{
+				BaseDelay:  time.Millisecond,
+				MaxDelay:   time.Millisecond,
+				Multiplier: 1,
+				RandFloat:  func() float64 { return 0.5 },
+			}),
+		)
+
+		var gotErr atomic.Bool
+		stream.OnError(func(err error) { gotErr.Store(true) })
+
+		_ = stream.Start(context.Background())
+		time.Sleep(50 * time.Millisecond)
+		stream.Stop()
+
+		if !gotErr.Load() {
+			t.Error("expected token provider error to be dispatched")
+		}
+		if !connected.Load() {
+			t.Error("expected reconnect after token provider error")
+		}
+		if got := stream.getAccessToken(); got != "fresh-token" {
+			t.Errorf("getAccessToken() = %q, want 'fresh-token'", got)
+		}
+	})
+}
+
 func TestLiveChatStream_RetryDirective(t *testing.T) {
 	var mu sync.Mutex
 	retryUpdated := false
@@ -786,6 +878,31 @@ func TestLiveChatStream_QuotaMethods(t *testing.T) {
 			t.Errorf("quotaLimit() = %d, want %d", stream.quotaLimit(), core.DefaultDailyQuota)
 		}
 	})
+
+	t.Run("with quota observer", func(t *testing.T) {
+		server := newSSEServer(nil)
+		defer server.Close()
+
+		var streamCost atomic.Int32
+		client := core.NewClient(core.WithQuotaObserver(core.QuotaObserverFunc(func(operation string, cost int) {
+			if operation == "liveChatMessages.streamList" {
+				streamCost.Add(int32(cost))
+			}
+		})))
+		stream := NewLiveChatStream(client, "chat123", WithStreamBaseURL(server.URL))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = stream.Start(ctx)
+		for streamCost.Load() == 0 && ctx.Err() == nil {
+			time.Sleep(5 * time.Millisecond)
+		}
+		stream.Stop()
+
+		if got := streamCost.Load(); got < 5 {
+			t.Errorf("observed streamList cost = %d, want at least 5", got)
+		}
+	})
 }
 
 func TestLiveChatStream_HandlerUnsubscribe(t *testing.T) {
@@ -940,3 +1057,230 @@ func TestLiveChatStream_ResetClearsState(t *testing.T) {
 		t.Errorf("PageToken() after reset = %q, want empty", stream.PageToken())
 	}
 }
+
+func TestLiveChatStream_FallbackToPolling(t *testing.T) {
+	var mu sync.Mutex
+	var pageTokens []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/liveChat/messages/stream" {
+			http.Error(w, "stream blocked", http.StatusBadGateway)
+			return
+		}
+
+		mu.Lock()
+		pageTokens = append(pageTokens, r.URL.Query().Get("pageToken"))
+		n := len(pageTokens)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+			NextPageToken:         fmt.Sprintf("page%d", n),
+			PollingIntervalMillis: 10,
+			Items: []*LiveChatMessage{
+				{ID: fmt.Sprintf("msg%d", n), Snippet: &MessageSnippet{Type: MessageTypeText}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	stream := NewLiveChatStream(client, "chat123",
+		WithStreamAccessToken("test-token"),
+		WithStreamBaseURL(server.URL),
+		WithStreamMaxReconnectDelay(5*time.Millisecond),
+		WithStreamFallbackToPolling(true),
+		WithStreamFallbackThreshold(2),
+	)
+
+	var fallbackErr atomic.Value
+	var fallbacks, messages, errs atomic.Int32
+	stream.OnFallback(func(err error) {
+		fallbacks.Add(1)
+		fallbackErr.Store(err)
+	})
+	stream.OnMessage(func(*LiveChatMessage) { messages.Add(1) })
+	stream.OnError(func(error) { errs.Add(1) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := stream.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer stream.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for messages.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if got := fallbacks.Load(); got != 1 {
+		t.Errorf("fallback handler called %d times, want 1", got)
+	}
+	if err, _ := fallbackErr.Load().(error); err == nil {
+		t.Error("fallback handler should receive the last SSE error")
+	}
+	if got := errs.Load(); got != 2 {
+		t.Errorf("error handler called %d times, want 2", got)
+	}
+	if !stream.IsPolling() {
+		t.Error("IsPolling() = false after fallback")
+	}
+	if got := messages.Load(); got < 3 {
+		t.Fatalf("received %d messages via polling, want at least 3", got)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if pageTokens[0] != "" {
+		t.Errorf("first poll pageToken = %q, want empty", pageTokens[0])
+	}
+	for i := 1; i < len(pageTokens); i++ {
+		if want := fmt.Sprintf("page%d", i); pageTokens[i] != want {
+			t.Errorf("poll %d pageToken = %q, want %q", i, pageTokens[i], want)
+		}
+	}
+}
+
+func TestLiveChatStream_PollFatalError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"forbidden", http.StatusForbidden, `{"error":{"code":403,"message":"Forbidden","errors":[{"reason":"forbidden"}]}}`},
+		{"quota exceeded", http.StatusForbidden, `{"error":{"code":403,"message":"Quota","errors":[{"reason":"quotaExceeded"}]}}`},
+		{"chat disabled", http.StatusForbidden, `{"error":{"code":403,"message":"Disabled","errors":[{"reason":"liveChatDisabled"}]}}`},
+		{"chat not found", http.StatusNotFound, `{"error":{"code":404,"message":"Not found","errors":[{"reason":"liveChatNotFound"}]}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				polls.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			stream := NewLiveChatStream(client, "chat123",
+				WithStreamBaseURL(server.URL),
+				WithStreamMaxReconnectDelay(time.Millisecond),
+			)
+
+			var errs []error
+			var disconnects int
+			stream.OnError(func(err error) { errs = append(errs, err) })
+			stream.OnDisconnect(func() { disconnects++ })
+
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			stream.pollLoop(ctx)
+
+			if ctx.Err() != nil {
+				t.Fatal("pollLoop kept polling after a fatal error")
+			}
+			if got := polls.Load(); got != 1 {
+				t.Errorf("polled %d times, want 1", got)
+			}
+			if disconnects != 1 {
+				t.Errorf("disconnect handler called %d times, want 1", disconnects)
+			}
+			var pollErr *PollError
+			if len(errs) != 1 || !errors.As(errs[0], &pollErr) || !pollErr.Fatal {
+				t.Errorf("errors = %v, want one fatal *PollError", errs)
+			}
+		})
+	}
+}
+
+func TestLiveChatStream_PollTimeout(t *testing.T) {
+	var polls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first poll hangs until the client gives up on it.
+		if polls.Add(1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LiveChatMessageListResponse{
+			PollingIntervalMillis: 10,
+			Items: []*LiveChatMessage{
+				{ID: "msg1", Snippet: &MessageSnippet{Type: MessageTypeText}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	stream := NewLiveChatStream(client, "chat123",
+		WithStreamBaseURL(server.URL),
+		WithStreamMaxReconnectDelay(5*time.Millisecond),
+	)
+	stream.pollTimeout = 20 * time.Millisecond
+
+	var messages, errs atomic.Int32
+	stream.OnMessage(func(*LiveChatMessage) { messages.Add(1) })
+	stream.OnError(func(error) { errs.Add(1) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stream.pollLoop(ctx)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for messages.Load() < 1 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if got := errs.Load(); got < 1 {
+		t.Errorf("error handler called %d times, want the timed-out poll reported", got)
+	}
+	if got := messages.Load(); got < 1 {
+		t.Error("polling should continue after a timed-out poll")
+	}
+}
+
+func TestLiveChatStream_FallbackDisabled(t *testing.T) {
+	var polled atomic.Bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/liveChat/messages/stream" {
+			polled.Store(true)
+		}
+		http.Error(w, "stream blocked", http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+	stream := NewLiveChatStream(client, "chat123",
+		WithStreamBaseURL(server.URL),
+		WithStreamMaxReconnectDelay(5*time.Millisecond),
+		WithStreamFallbackThreshold(1),
+	)
+
+	var fallbacks atomic.Int32
+	stream.OnFallback(func(error) { fallbacks.Add(1) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_ = stream.Start(ctx)
+	time.Sleep(50 * time.Millisecond)
+	stream.Stop()
+
+	if fallbacks.Load() != 0 || polled.Load() || stream.IsPolling() {
+		t.Error("stream should not fall back to polling unless enabled")
+	}
+}
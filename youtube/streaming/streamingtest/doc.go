@@ -0,0 +1,40 @@
+// Package streamingtest provides a fake live chat for unit-testing chat bot
+// handlers without HTTP mocks.
+//
+// # FakeBot
+//
+// NewFakeBot returns a connected streaming.ChatBotClient backed by a fake
+// chat. Register handlers on it as usual, inject chat events, and assert on
+// the messages and moderation actions the handlers sent:
+//
+//	func TestSpamFilter(t *testing.T) {
+//		fake := streamingtest.NewFakeBot(t)
+//		registerHandlers(fake.Bot) // the code under test
+//
+//		fake.InjectMessage(&streaming.ChatMessage{
+//			Message: "BUY FOLLOWERS cheap!!!",
+//			Author:  &streaming.Author{ChannelID: "UCspammer", DisplayName: "spammer"},
+//		})
+//
+//		if got := fake.TimedOut(); len(got) != 1 || got[0].ChannelID != "UCspammer" {
+//			t.Errorf("timeouts = %v", got)
+//		}
+//		if got := fake.Said(); len(got) != 1 {
+//			t.Errorf("replies = %q", got)
+//		}
+//	}
+//
+// Inject methods return once the bot's handlers have run, so assertions can
+// follow immediately. Each injected message is dispatched exactly as if it
+// had come from the API, including history, membership tracking, and
+// CommandRouter dispatch.
+//
+// # Failures
+//
+// The fake chat runs on a coretest.Transport. Replace a handler on
+// FakeBot.Transport to make an action fail:
+//
+//	fake.Transport.Handle("POST", "liveChat/bans", func(*coretest.Request) *coretest.Response {
+//		return coretest.Error(http.StatusForbidden, "forbidden", "The caller is not a moderator.")
+//	})
+package streamingtest
@@ -0,0 +1,381 @@
+package streamingtest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+	"github.com/Its-donkey/yougopher/youtube/core/coretest"
+	"github.com/Its-donkey/yougopher/youtube/streaming"
+)
+
+// LiveChatID is the live chat ID of the fake chat.
+const LiveChatID = "fake-live-chat"
+
+// injectTimeout is how long an Inject call waits for the bot's handlers.
+const injectTimeout = 5 * time.Second
+
+// Timeout is a temporary ban recorded by FakeBot.
+type Timeout struct {
+	// ChannelID is the timed-out user's channel ID.
+	ChannelID string
+
+	// Seconds is the timeout duration.
+	Seconds int64
+}
+
+// FakeBot is a ChatBotClient connected to a fake live chat. Use
+// NewFakeBot to create one.
+type FakeBot struct {
+	// Bot is the connected chat bot. Register the handlers under test on it.
+	Bot *streaming.ChatBotClient
+
+	// Client is the API client the bot uses.
+	Client *core.Client
+
+	// Transport answers the bot's API requests and records them.
+	Transport *coretest.Transport
+
+	t testing.TB
+
+	mu       sync.Mutex
+	pending  []*batch
+	inFlight []*batch
+	wake     chan struct{}
+	nextID   int
+}
+
+// batch is a group of injected messages delivered by one poll.
+type batch struct {
+	messages []*streaming.LiveChatMessage
+	done     chan struct{}
+}
+
+// NewFakeBot creates a FakeBot and connects its bot. opts configure the
+// ChatBotClient. The bot is closed when the test ends.
+func NewFakeBot(t testing.TB, opts ...streaming.ChatBotOption) *FakeBot {
+	t.Helper()
+
+	f := &FakeBot{
+		Transport: coretest.NewTransport(),
+		t:         t,
+		wake:      make(chan struct{}, 1),
+	}
+	f.Client = f.Transport.Client(core.WithAccessToken("fake-token"))
+	f.handleAPI()
+
+	poller := streaming.NewLiveChatPoller(f.Client, LiveChatID, streaming.WithMinPollInterval(time.Millisecond))
+	poller.OnPollComplete(func(int, time.Duration) { f.finishPoll() })
+
+	opts = append([]streaming.ChatBotOption{streaming.WithPoller(poller)}, opts...)
+	bot, err := streaming.NewChatBotClient(f.Client, nil, LiveChatID, opts...)
+	if err != nil {
+		t.Fatalf("streamingtest: creating bot: %v", err)
+	}
+	if err := bot.Connect(context.Background()); err != nil {
+		t.Fatalf("streamingtest: connecting bot: %v", err)
+	}
+	t.Cleanup(func() { _ = bot.Close() })
+
+	f.Bot = bot
+	return f
+}
+
+// InjectMessage delivers a text message to the bot and waits for its
+// handlers to run. An empty ID, a nil Author, and a zero PublishedAt are
+// filled in.
+func (f *FakeBot) InjectMessage(msg *streaming.ChatMessage) {
+	f.t.Helper()
+	f.Inject(&streaming.LiveChatMessage{
+		ID: msg.ID,
+		Snippet: &streaming.MessageSnippet{
+			Type:               streaming.MessageTypeText,
+			PublishedAt:        msg.PublishedAt,
+			HasDisplayContent:  true,
+			DisplayMessage:     msg.Message,
+			TextMessageDetails: &streaming.TextMessageDetails{MessageText: msg.Message},
+		},
+		AuthorDetails: authorDetails(msg.Author),
+	})
+}
+
+// InjectSuperChat delivers a Super Chat to the bot and waits for its
+// handlers to run. An empty ID, a nil Author, and a zero Tier are filled in.
+func (f *FakeBot) InjectSuperChat(event *streaming.SuperChatEvent) {
+	f.t.Helper()
+	tier := event.Tier
+	if tier == 0 {
+		tier = 1
+	}
+	f.Inject(&streaming.LiveChatMessage{
+		ID: event.ID,
+		Snippet: &streaming.MessageSnippet{
+			Type:              streaming.MessageTypeSuperChat,
+			HasDisplayContent: true,
+			DisplayMessage:    event.Message,
+			SuperChatDetails: &streaming.SuperChatDetails{
+				AmountMicros:        event.AmountMicros,
+				Currency:            event.Currency,
+				AmountDisplayString: event.Amount,
+				UserComment:         event.Message,
+				Tier:                tier,
+			},
+		},
+		AuthorDetails: authorDetails(event.Author),
+	})
+}
+
+// Inject delivers raw API messages to the bot in one poll, e.g. membership
+// or ban events, and waits for its handlers to run. Empty IDs, live chat
+// IDs, publish times, display messages, and authors are filled in.
+func (f *FakeBot) Inject(msgs ...*streaming.LiveChatMessage) {
+	f.t.Helper()
+	if len(msgs) == 0 {
+		return
+	}
+
+	b := &batch{messages: make([]*streaming.LiveChatMessage, len(msgs)), done: make(chan struct{})}
+	f.mu.Lock()
+	for i, msg := range msgs {
+		b.messages[i] = f.fillMessage(msg)
+	}
+	f.pending = append(f.pending, b)
+	f.mu.Unlock()
+
+	select {
+	case f.wake <- struct{}{}:
+	default:
+	}
+
+	select {
+	case <-b.done:
+	case <-time.After(injectTimeout):
+		f.t.Fatalf("streamingtest: bot did not receive injected messages within %v; is it connected?", injectTimeout)
+	}
+}
+
+// Said returns the messages the bot sent with Say, oldest first.
+func (f *FakeBot) Said() []string {
+	var said []string
+	for _, req := range f.Transport.RequestsTo(http.MethodPost, "liveChat/messages") {
+		var body streaming.InsertMessageRequest
+		if req.DecodeBody(&body) == nil && body.Snippet != nil && body.Snippet.TextMessageDetails != nil {
+			said = append(said, body.Snippet.TextMessageDetails.MessageText)
+		}
+	}
+	return said
+}
+
+// Banned returns the channel IDs the bot banned permanently, oldest first.
+func (f *FakeBot) Banned() []string {
+	var banned []string
+	for _, ban := range f.bans() {
+		if ban.Type == streaming.BanTypePermanent {
+			banned = append(banned, ban.BannedUserDetails.ChannelID)
+		}
+	}
+	return banned
+}
+
+// TimedOut returns the bot's temporary bans, oldest first.
+func (f *FakeBot) TimedOut() []Timeout {
+	var timeouts []Timeout
+	for _, ban := range f.bans() {
+		if ban.Type == streaming.BanTypeTemporary {
+			timeouts = append(timeouts, Timeout{ChannelID: ban.BannedUserDetails.ChannelID, Seconds: ban.BanDurationSeconds})
+		}
+	}
+	return timeouts
+}
+
+// Unbanned returns the ban IDs the bot removed with Unban, oldest first.
+func (f *FakeBot) Unbanned() []string {
+	return f.ids(http.MethodDelete, "liveChat/bans")
+}
+
+// Deleted returns the message IDs the bot deleted, oldest first.
+func (f *FakeBot) Deleted() []string {
+	return f.ids(http.MethodDelete, "liveChat/messages")
+}
+
+// Reset forgets the recorded actions, e.g. between test steps.
+func (f *FakeBot) Reset() {
+	f.Transport.Reset()
+}
+
+// bans returns the snippets of the bot's ban requests.
+func (f *FakeBot) bans() []*streaming.InsertBanSnippet {
+	var bans []*streaming.InsertBanSnippet
+	for _, req := range f.Transport.RequestsTo(http.MethodPost, "liveChat/bans") {
+		var body streaming.InsertBanRequest
+		if req.DecodeBody(&body) == nil && body.Snippet != nil && body.Snippet.BannedUserDetails != nil {
+			bans = append(bans, body.Snippet)
+		}
+	}
+	return bans
+}
+
+// ids returns the id query parameters of requests to an endpoint.
+func (f *FakeBot) ids(method, path string) []string {
+	var ids []string
+	for _, req := range f.Transport.RequestsTo(method, path) {
+		ids = append(ids, req.Query.Get("id"))
+	}
+	return ids
+}
+
+// handleAPI registers the fake chat's API handlers.
+func (f *FakeBot) handleAPI() {
+	f.Transport.Handle(http.MethodGet, "liveChat/messages", f.listMessages)
+
+	f.Transport.Handle(http.MethodPost, "liveChat/messages", func(req *coretest.Request) *coretest.Response {
+		var body streaming.InsertMessageRequest
+		if err := req.DecodeBody(&body); err != nil || body.Snippet == nil || body.Snippet.TextMessageDetails == nil {
+			return coretest.Error(http.StatusBadRequest, "invalidValue", "invalid message")
+		}
+		text := body.Snippet.TextMessageDetails.MessageText
+		return coretest.JSON(http.StatusOK, &streaming.LiveChatMessage{
+			ID: f.newID("sent"),
+			Snippet: &streaming.MessageSnippet{
+				Type:               streaming.MessageTypeText,
+				LiveChatID:         LiveChatID,
+				PublishedAt:        time.Now(),
+				DisplayMessage:     text,
+				TextMessageDetails: body.Snippet.TextMessageDetails,
+			},
+		})
+	})
+
+	f.Transport.Handle(http.MethodPost, "liveChat/bans", func(req *coretest.Request) *coretest.Response {
+		var body streaming.InsertBanRequest
+		if err := req.DecodeBody(&body); err != nil || body.Snippet == nil {
+			return coretest.Error(http.StatusBadRequest, "invalidValue", "invalid ban")
+		}
+		return coretest.JSON(http.StatusOK, &streaming.LiveChatBan{
+			ID: f.newID("ban"),
+			Snippet: &streaming.BanSnippet{
+				LiveChatID:         LiveChatID,
+				BanType:            body.Snippet.Type,
+				BanDurationSeconds: body.Snippet.BanDurationSeconds,
+				BannedUserDetails:  body.Snippet.BannedUserDetails,
+			},
+		})
+	})
+
+	f.Transport.Handle(http.MethodPost, "liveChat/moderators", func(req *coretest.Request) *coretest.Response {
+		return coretest.JSON(http.StatusOK, &streaming.LiveChatModerator{ID: f.newID("moderator")})
+	})
+
+	noContent := func(*coretest.Request) *coretest.Response {
+		return &coretest.Response{StatusCode: http.StatusNoContent}
+	}
+	f.Transport.Handle(http.MethodDelete, "liveChat/messages", noContent)
+	f.Transport.Handle(http.MethodDelete, "liveChat/bans", noContent)
+	f.Transport.Handle(http.MethodDelete, "liveChat/moderators", noContent)
+	f.Transport.Handle(http.MethodPost, "liveChat/messages/transition", noContent)
+}
+
+// listMessages serves liveChatMessages.list, waiting until messages are
+// injected or the poll is cancelled.
+func (f *FakeBot) listMessages(req *coretest.Request) *coretest.Response {
+	for {
+		f.mu.Lock()
+		if len(f.pending) > 0 {
+			batches := f.pending
+			f.pending = nil
+			f.inFlight = append(f.inFlight, batches...)
+
+			var items []*streaming.LiveChatMessage
+			for _, b := range batches {
+				items = append(items, b.messages...)
+			}
+			f.mu.Unlock()
+
+			return coretest.JSON(http.StatusOK, &streaming.LiveChatMessageListResponse{
+				NextPageToken:         f.newID("page"),
+				PollingIntervalMillis: 1,
+				Items:                 items,
+			})
+		}
+		f.mu.Unlock()
+
+		select {
+		case <-f.wake:
+		case <-req.Context().Done():
+			return coretest.JSON(http.StatusOK, &streaming.LiveChatMessageListResponse{})
+		}
+	}
+}
+
+// finishPoll releases the Inject calls whose messages were delivered by
+// the poll that just completed.
+func (f *FakeBot) finishPoll() {
+	f.mu.Lock()
+	done := f.inFlight
+	f.inFlight = nil
+	f.mu.Unlock()
+
+	for _, b := range done {
+		close(b.done)
+	}
+}
+
+// fillMessage returns a copy of msg with empty fields filled in. The
+// caller must hold f.mu.
+func (f *FakeBot) fillMessage(msg *streaming.LiveChatMessage) *streaming.LiveChatMessage {
+	m := *msg
+	if m.ID == "" {
+		f.nextID++
+		m.ID = fmt.Sprintf("msg-%d", f.nextID)
+	}
+	if m.Snippet != nil {
+		snippet := *m.Snippet
+		if snippet.LiveChatID == "" {
+			snippet.LiveChatID = LiveChatID
+		}
+		if snippet.PublishedAt.IsZero() {
+			snippet.PublishedAt = time.Now()
+		}
+		if snippet.DisplayMessage == "" && snippet.TextMessageDetails != nil {
+			snippet.DisplayMessage = snippet.TextMessageDetails.MessageText
+			snippet.HasDisplayContent = true
+		}
+		m.Snippet = &snippet
+	}
+	if m.AuthorDetails == nil {
+		m.AuthorDetails = authorDetails(nil)
+	}
+	if m.Snippet != nil && m.Snippet.AuthorChannelID == "" {
+		m.Snippet.AuthorChannelID = m.AuthorDetails.ChannelID
+	}
+	return &m
+}
+
+// newID returns a unique ID with the given prefix.
+func (f *FakeBot) newID(prefix string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	return fmt.Sprintf("%s-%d", prefix, f.nextID)
+}
+
+// authorDetails converts an Author to API author details, using a default
+// viewer if a is nil.
+func authorDetails(a *streaming.Author) *streaming.AuthorDetails {
+	if a == nil {
+		return &streaming.AuthorDetails{ChannelID: "UCviewer", DisplayName: "viewer"}
+	}
+	return &streaming.AuthorDetails{
+		ChannelID:       a.ChannelID,
+		DisplayName:     a.DisplayName,
+		ProfileImageURL: a.ProfileImageURL,
+		IsVerified:      a.IsVerified,
+		IsChatOwner:     a.IsOwner,
+		IsChatSponsor:   a.IsMember,
+		IsChatModerator: a.IsModerator,
+	}
+}
@@ -0,0 +1,130 @@
+package streamingtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+	"github.com/Its-donkey/yougopher/youtube/core/coretest"
+	"github.com/Its-donkey/yougopher/youtube/streaming"
+)
+
+func TestFakeBot_Moderation(t *testing.T) {
+	fake := NewFakeBot(t)
+	fake.Bot.OnMessage(func(msg *streaming.ChatMessage) {
+		ctx := context.Background()
+		switch {
+		case strings.Contains(msg.Message, "spam"):
+			_ = fake.Bot.Timeout(ctx, msg.Author.ChannelID, 300)
+			_ = fake.Bot.Delete(ctx, msg.ID)
+		case strings.Contains(msg.Message, "scam"):
+			_ = fake.Bot.Ban(ctx, msg.Author.ChannelID)
+		default:
+			_ = fake.Bot.Say(ctx, "hello "+msg.Author.DisplayName)
+		}
+	})
+
+	fake.InjectMessage(&streaming.ChatMessage{Message: "hi"})
+	fake.InjectMessage(&streaming.ChatMessage{
+		ID:      "m2",
+		Message: "buy spam",
+		Author:  &streaming.Author{ChannelID: "UCspammer", DisplayName: "spammer"},
+	})
+	fake.InjectMessage(&streaming.ChatMessage{
+		Message: "free scam",
+		Author:  &streaming.Author{ChannelID: "UCscammer", DisplayName: "scammer"},
+	})
+
+	if got := fake.Said(); len(got) != 1 || got[0] != "hello viewer" {
+		t.Errorf("Said() = %q, want [hello viewer]", got)
+	}
+	if got := fake.TimedOut(); len(got) != 1 || got[0] != (Timeout{ChannelID: "UCspammer", Seconds: 300}) {
+		t.Errorf("TimedOut() = %+v", got)
+	}
+	if got := fake.Deleted(); len(got) != 1 || got[0] != "m2" {
+		t.Errorf("Deleted() = %q, want [m2]", got)
+	}
+	if got := fake.Banned(); len(got) != 1 || got[0] != "UCscammer" {
+		t.Errorf("Banned() = %q, want [UCscammer]", got)
+	}
+
+	fake.Reset()
+	if got := fake.Said(); len(got) != 0 {
+		t.Errorf("Said() after Reset() = %q", got)
+	}
+}
+
+func TestFakeBot_SuperChat(t *testing.T) {
+	fake := NewFakeBot(t)
+
+	var got *streaming.SuperChatEvent
+	fake.Bot.OnSuperChat(func(event *streaming.SuperChatEvent) { got = event })
+
+	fake.InjectSuperChat(&streaming.SuperChatEvent{
+		AmountMicros: 5000000,
+		Currency:     "USD",
+		Amount:       "$5.00",
+		Message:      "great stream",
+		Author:       &streaming.Author{ChannelID: "UCfan", DisplayName: "fan"},
+	})
+
+	if got == nil {
+		t.Fatal("OnSuperChat handler not called")
+	}
+	if got.AmountMicros != 5000000 || got.Currency != "USD" || got.Amount != "$5.00" {
+		t.Errorf("amount = %d %s %q", got.AmountMicros, got.Currency, got.Amount)
+	}
+	if got.Message != "great stream" || got.Tier != 1 {
+		t.Errorf("message = %q, tier = %d", got.Message, got.Tier)
+	}
+	if got.Author == nil || got.Author.ChannelID != "UCfan" {
+		t.Errorf("author = %+v", got.Author)
+	}
+}
+
+func TestFakeBot_Inject(t *testing.T) {
+	fake := NewFakeBot(t, streaming.WithHistoryBuffer(10))
+
+	var texts []string
+	fake.Bot.OnMessage(func(msg *streaming.ChatMessage) { texts = append(texts, msg.Message) })
+
+	fake.Inject(
+		&streaming.LiveChatMessage{Snippet: &streaming.MessageSnippet{
+			Type:               streaming.MessageTypeText,
+			TextMessageDetails: &streaming.TextMessageDetails{MessageText: "one"},
+		}},
+		&streaming.LiveChatMessage{Snippet: &streaming.MessageSnippet{
+			Type:               streaming.MessageTypeText,
+			TextMessageDetails: &streaming.TextMessageDetails{MessageText: "two"},
+		}},
+	)
+
+	if strings.Join(texts, ",") != "one,two" {
+		t.Errorf("handled %q, want [one two]", texts)
+	}
+	recent := fake.Bot.RecentMessages()
+	if len(recent) != 2 || recent[0].ID == "" || recent[0].ID == recent[1].ID {
+		t.Errorf("RecentMessages() = %+v, want two messages with unique IDs", recent)
+	}
+}
+
+func TestFakeBot_FailingAction(t *testing.T) {
+	fake := NewFakeBot(t)
+	fake.Transport.Handle(http.MethodPost, "liveChat/bans", func(*coretest.Request) *coretest.Response {
+		return coretest.Error(http.StatusForbidden, "forbidden", "The caller is not a moderator.")
+	})
+
+	var banErr error
+	fake.Bot.OnMessage(func(msg *streaming.ChatMessage) {
+		banErr = fake.Bot.Ban(context.Background(), msg.Author.ChannelID)
+	})
+	fake.InjectMessage(&streaming.ChatMessage{Message: "hi"})
+
+	var apiErr *core.APIError
+	if !errors.As(banErr, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("Ban() error = %v, want 403 APIError", banErr)
+	}
+}
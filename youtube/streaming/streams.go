@@ -197,6 +197,7 @@ type GetStreamsParams struct {
 	Parts []string
 
 	// MaxResults is the maximum number of items to return (1-50).
+	// Larger values are clamped to 50.
 	MaxResults int
 
 	// PageToken is the token for pagination.
@@ -233,9 +234,7 @@ func GetStreams(ctx context.Context, client *core.Client, params *GetStreamsPara
 	if params.Mine {
 		query.Set("mine", "true")
 	}
-	if params.MaxResults > 0 {
-		query.Set("maxResults", fmt.Sprintf("%d", params.MaxResults))
-	}
+	core.SetMaxResults(query, params.MaxResults, core.MaxPageSize)
 	if params.PageToken != "" {
 		query.Set("pageToken", params.PageToken)
 	}
@@ -293,6 +292,38 @@ func GetMyStreams(ctx context.Context, client *core.Client, parts ...string) (*L
 	})
 }
 
+// defaultStreamSearchParts are the parts requested by GetDefaultStream.
+var defaultStreamSearchParts = []string{"snippet", "cdn", "status", "contentDetails"}
+
+// GetDefaultStream returns the authenticated channel's default stream, the
+// reusable stream whose key persists across broadcasts. Returns nil, nil if
+// the channel has no default stream. All pages of the channel's streams are
+// searched.
+// Requires OAuth authentication.
+// Quota cost: 5 units per page of 50 streams.
+func GetDefaultStream(ctx context.Context, client *core.Client) (*LiveStream, error) {
+	params := &GetStreamsParams{
+		Mine:       true,
+		Parts:      defaultStreamSearchParts,
+		MaxResults: 50,
+	}
+	for {
+		resp, err := GetStreams(ctx, client, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, stream := range resp.Items {
+			if stream.IsDefault() {
+				return stream, nil
+			}
+		}
+		if resp.NextPageToken == "" {
+			return nil, nil
+		}
+		params.PageToken = resp.NextPageToken
+	}
+}
+
 // InsertStream creates a new live stream.
 // Requires OAuth authentication with youtube.force-ssl scope.
 // Quota cost: 50 units.
@@ -417,6 +448,59 @@ func (s *LiveStream) RTMPSUrl() string {
 	return s.CDN.IngestionInfo.RtmpsIngestionAddress
 }
 
+// BackupRTMPUrl returns the backup RTMP ingest URL for redundant ingestion.
+// Returns empty string if not available.
+func (s *LiveStream) BackupRTMPUrl() string {
+	if s.CDN == nil || s.CDN.IngestionInfo == nil {
+		return ""
+	}
+	return s.CDN.IngestionInfo.BackupIngestionAddress
+}
+
+// BackupRTMPSUrl returns the backup RTMPS (secure) ingest URL.
+// Returns empty string if not available.
+func (s *LiveStream) BackupRTMPSUrl() string {
+	if s.CDN == nil || s.CDN.IngestionInfo == nil {
+		return ""
+	}
+	return s.CDN.IngestionInfo.RtmpsBackupIngestionAddress
+}
+
+// FullIngestURL returns the ingest address joined with the stream key, ready
+// to paste into streaming software as a single server URL. backup selects the
+// backup address and secure selects RTMPS.
+// Returns empty string if the address or stream key is not available.
+func (s *LiveStream) FullIngestURL(backup, secure bool) string {
+	var addr string
+	switch {
+	case backup && secure:
+		addr = s.BackupRTMPSUrl()
+	case backup:
+		addr = s.BackupRTMPUrl()
+	case secure:
+		addr = s.RTMPSUrl()
+	default:
+		addr = s.RTMPUrl()
+	}
+	key := s.StreamKey()
+	if addr == "" || key == "" {
+		return ""
+	}
+	return strings.TrimSuffix(addr, "/") + "/" + key
+}
+
+// IsReusable returns true if the stream can be bound to more than one
+// broadcast, keeping the same stream key. Requires the contentDetails part.
+func (s *LiveStream) IsReusable() bool {
+	return s.ContentDetails != nil && s.ContentDetails.IsReusable
+}
+
+// IsDefault returns true if this is the channel's default stream.
+// Requires the snippet part.
+func (s *LiveStream) IsDefault() bool {
+	return s.Snippet != nil && s.Snippet.IsDefaultStream
+}
+
 // HasConfigurationIssues returns true if there are any configuration issues.
 func (s *LiveStream) HasConfigurationIssues() bool {
 	if s.Status == nil || s.Status.HealthStatus == nil {
@@ -516,7 +516,122 @@ func TestDeleteStream(t *testing.T) {
 	})
 }
 
+func TestGetDefaultStream(t *testing.T) {
+	tests := []struct {
+		name      string
+		pages     [][]*LiveStream
+		wantID    string
+		wantPages int
+	}{
+		{
+			name: "default on second page",
+			pages: [][]*LiveStream{
+				{{ID: "s1", Snippet: &StreamSnippet{}}},
+				{
+					{ID: "s2", Snippet: &StreamSnippet{}},
+					{
+						ID:             "default",
+						Snippet:        &StreamSnippet{IsDefaultStream: true},
+						ContentDetails: &StreamContentDetails{IsReusable: true},
+					},
+				},
+			},
+			wantID:    "default",
+			wantPages: 2,
+		},
+		{
+			name:      "no default stream",
+			pages:     [][]*LiveStream{{{ID: "s1", Snippet: &StreamSnippet{}}}, {}},
+			wantPages: 2,
+		},
+		{
+			name:      "no streams",
+			pages:     [][]*LiveStream{{}},
+			wantPages: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				q := r.URL.Query()
+				if q.Get("mine") != "true" || q.Get("part") != "snippet,cdn,status,contentDetails" {
+					t.Errorf("unexpected query: %s", r.URL.RawQuery)
+				}
+				page := 0
+				if token := q.Get("pageToken"); token != "" {
+					page = int(token[0] - '0')
+				}
+				resp := LiveStreamListResponse{Items: tt.pages[page]}
+				if page+1 < len(tt.pages) {
+					resp.NextPageToken = string(rune('0' + page + 1))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+
+			client := core.NewClient(core.WithBaseURL(server.URL))
+			stream, err := GetDefaultStream(context.Background(), client)
+			if err != nil {
+				t.Fatalf("GetDefaultStream() error = %v", err)
+			}
+			if tt.wantID == "" {
+				if stream != nil {
+					t.Errorf("GetDefaultStream() = %s, want nil", stream.ID)
+				}
+			} else if stream == nil || stream.ID != tt.wantID {
+				t.Errorf("GetDefaultStream() = %v, want %s", stream, tt.wantID)
+			}
+			if requests != tt.wantPages {
+				t.Errorf("requests = %d, want %d", requests, tt.wantPages)
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		client := core.NewClient(core.WithBaseURL(server.URL))
+		if _, err := GetDefaultStream(context.Background(), client); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
 func TestLiveStream_Methods(t *testing.T) {
+	t.Run("IsReusable and IsDefault", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			stream       *LiveStream
+			wantReusable bool
+			wantDefault  bool
+		}{
+			{"no parts", &LiveStream{}, false, false},
+			{"reusable", &LiveStream{ContentDetails: &StreamContentDetails{IsReusable: true}}, true, false},
+			{"default", &LiveStream{
+				Snippet:        &StreamSnippet{IsDefaultStream: true},
+				ContentDetails: &StreamContentDetails{IsReusable: true},
+			}, true, true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := tt.stream.IsReusable(); got != tt.wantReusable {
+					t.Errorf("IsReusable() = %v, want %v", got, tt.wantReusable)
+				}
+				if got := tt.stream.IsDefault(); got != tt.wantDefault {
+					t.Errorf("IsDefault() = %v, want %v", got, tt.wantDefault)
+				}
+			})
+		}
+	})
+
 	t.Run("IsActive", func(t *testing.T) {
 		tests := []struct {
 			name   string
@@ -640,6 +755,82 @@ func TestLiveStream_Methods(t *testing.T) {
 		}
 	})
 
+	t.Run("BackupRTMPUrl", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			stream *LiveStream
+			want   string
+		}{
+			{"nil CDN", &LiveStream{}, ""},
+			{"nil ingestion", &LiveStream{CDN: &StreamCDN{}}, ""},
+			{"has URL", &LiveStream{CDN: &StreamCDN{IngestionInfo: &IngestionInfo{BackupIngestionAddress: "rtmp://backup"}}}, "rtmp://backup"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := tt.stream.BackupRTMPUrl(); got != tt.want {
+					t.Errorf("BackupRTMPUrl() = %q, want %q", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("BackupRTMPSUrl", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			stream *LiveStream
+			want   string
+		}{
+			{"nil CDN", &LiveStream{}, ""},
+			{"nil ingestion", &LiveStream{CDN: &StreamCDN{}}, ""},
+			{"has URL", &LiveStream{CDN: &StreamCDN{IngestionInfo: &IngestionInfo{RtmpsBackupIngestionAddress: "rtmps://backup"}}}, "rtmps://backup"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := tt.stream.BackupRTMPSUrl(); got != tt.want {
+					t.Errorf("BackupRTMPSUrl() = %q, want %q", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("FullIngestURL", func(t *testing.T) {
+		full := &LiveStream{CDN: &StreamCDN{IngestionInfo: &IngestionInfo{
+			StreamName:                  "key-123",
+			IngestionAddress:            "rtmp://a.rtmp.youtube.com/live2",
+			BackupIngestionAddress:      "rtmp://b.rtmp.youtube.com/live2",
+			RtmpsIngestionAddress:       "rtmps://a.rtmps.youtube.com/live2/",
+			RtmpsBackupIngestionAddress: "rtmps://b.rtmps.youtube.com/live2",
+		}}}
+		noKey := &LiveStream{CDN: &StreamCDN{IngestionInfo: &IngestionInfo{IngestionAddress: "rtmp://a"}}}
+		noBackup := &LiveStream{CDN: &StreamCDN{IngestionInfo: &IngestionInfo{StreamName: "key-123", IngestionAddress: "rtmp://a"}}}
+
+		tests := []struct {
+			name   string
+			stream *LiveStream
+			backup bool
+			secure bool
+			want   string
+		}{
+			{"primary RTMP", full, false, false, "rtmp://a.rtmp.youtube.com/live2/key-123"},
+			{"primary RTMPS trailing slash", full, false, true, "rtmps://a.rtmps.youtube.com/live2/key-123"},
+			{"backup RTMP", full, true, false, "rtmp://b.rtmp.youtube.com/live2/key-123"},
+			{"backup RTMPS", full, true, true, "rtmps://b.rtmps.youtube.com/live2/key-123"},
+			{"nil CDN", &LiveStream{}, false, false, ""},
+			{"no stream key", noKey, false, false, ""},
+			{"no backup address", noBackup, true, false, ""},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := tt.stream.FullIngestURL(tt.backup, tt.secure); got != tt.want {
+					t.Errorf("FullIngestURL(%v, %v) = %q, want %q", tt.backup, tt.secure, got, tt.want)
+				}
+			})
+		}
+	})
+
 	t.Run("HasConfigurationIssues", func(t *testing.T) {
 		tests := []struct {
 			name   string
@@ -0,0 +1,79 @@
+package streaming
+
+import (
+	"context"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+// GetAllSuperChatEvents retrieves every Super Chat and Super Sticker event
+// for the authenticated user's channel, following page tokens until the last
+// page. The API returns events from the past 30 days. Events are
+// deduplicated by ID and keep the API's order (newest first).
+//
+// params is optional: MaxResults sets the page size (default 50, the API
+// maximum), PageToken starts from a later page, and HL is sent with every
+// page. params is not modified. Cancelling ctx stops between pages.
+//
+// Requires OAuth authentication with youtube.readonly or youtube.force-ssl scope.
+// Quota cost: 5 units per page.
+func GetAllSuperChatEvents(ctx context.Context, client *core.Client, params *ListSuperChatEventsParams) ([]*SuperChatEventResource, error) {
+	page := ListSuperChatEventsParams{MaxResults: 50}
+	if params != nil {
+		page.HL = params.HL
+		page.PageToken = params.PageToken
+		if params.MaxResults > 0 {
+			page.MaxResults = params.MaxResults
+		}
+	}
+
+	start := page.PageToken
+	pages := core.Paginate(ctx, func(token string) ([]*SuperChatEventResource, string, error) {
+		if token != "" {
+			page.PageToken = token
+		}
+		resp, err := ListSuperChatEvents(ctx, client, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.NextPageToken == start {
+			return resp.Items, "", nil // Paginate only sees tokens after the first page
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+
+	var events []*SuperChatEventResource
+	seen := make(map[string]bool)
+	for e, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		if e == nil || seen[e.ID] {
+			continue
+		}
+		seen[e.ID] = true
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// SuperChatTotals sums event amounts in micros by ISO 4217 currency code,
+// including Super Stickers. Amounts in different currencies cannot be added
+// together, so each currency is totaled separately:
+//
+//	events, err := streaming.GetAllSuperChatEvents(ctx, client, nil)
+//	for currency, micros := range streaming.SuperChatTotals(events) {
+//		fmt.Println(streaming.Amount{Micros: micros, Currency: currency})
+//	}
+//
+// Events without a currency are skipped.
+func SuperChatTotals(events []*SuperChatEventResource) map[string]int64 {
+	totals := make(map[string]int64)
+	for _, e := range events {
+		if e == nil || e.Snippet == nil || e.Snippet.Currency == "" {
+			continue
+		}
+		totals[e.Snippet.Currency] += e.Snippet.AmountMicros
+	}
+	return totals
+}
@@ -0,0 +1,115 @@
+package streaming
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Its-donkey/yougopher/youtube/core"
+)
+
+func superChatResource(id, currency string, micros int64) *SuperChatEventResource {
+	return &SuperChatEventResource{
+		ID: id,
+		Snippet: &SuperChatEventResourceSnippet{
+			AmountMicros: micros,
+			Currency:     currency,
+		},
+	}
+}
+
+func TestGetAllSuperChatEvents(t *testing.T) {
+	pages := map[string]SuperChatEventResourceListResponse{
+		"": {
+			Items:         []*SuperChatEventResource{superChatResource("sc1", "USD", 5000000), superChatResource("sc2", "EUR", 2000000)},
+			NextPageToken: "p2",
+		},
+		"p2": {
+			// sc2 shifted onto this page between requests.
+			Items: []*SuperChatEventResource{superChatResource("sc2", "EUR", 2000000), superChatResource("sc3", "USD", 10000000)},
+		},
+	}
+
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		requests = append(requests, q)
+		if q.Get("pageToken") == "fail" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(pages[q.Get("pageToken")])
+	}))
+	defer server.Close()
+
+	client := core.NewClient(core.WithBaseURL(server.URL))
+
+	t.Run("all pages", func(t *testing.T) {
+		requests = nil
+		events, err := GetAllSuperChatEvents(context.Background(), client, &ListSuperChatEventsParams{HL: "en"})
+		if err != nil {
+			t.Fatalf("GetAllSuperChatEvents() error = %v", err)
+		}
+		if len(events) != 3 || events[0].ID != "sc1" || events[1].ID != "sc2" || events[2].ID != "sc3" {
+			t.Errorf("got %d events, want sc1, sc2, sc3", len(events))
+		}
+		if len(requests) != 2 {
+			t.Fatalf("requests = %d, want 2", len(requests))
+		}
+		for i, q := range requests {
+			if q.Get("hl") != "en" || q.Get("maxResults") != "50" {
+				t.Errorf("request %d query = %v, want hl=en maxResults=50", i, q)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := GetAllSuperChatEvents(context.Background(), client, &ListSuperChatEventsParams{PageToken: "fail"})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		requests = nil
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := GetAllSuperChatEvents(ctx, client, nil); !errors.Is(err, context.Canceled) {
+			t.Errorf("error = %v, want context.Canceled", err)
+		}
+		if len(requests) != 0 {
+			t.Errorf("requests = %d, want 0 after cancellation", len(requests))
+		}
+	})
+}
+
+func TestSuperChatTotals(t *testing.T) {
+	events := []*SuperChatEventResource{
+		superChatResource("sc1", "USD", 5000000),
+		superChatResource("sc2", "EUR", 2000000),
+		superChatResource("sc3", "USD", 10000000),
+		superChatResource("sc4", "", 1000000),
+		{ID: "sc5"},
+		nil,
+	}
+
+	totals := SuperChatTotals(events)
+	if len(totals) != 2 {
+		t.Errorf("got %d currencies, want 2: %v", len(totals), totals)
+	}
+	if totals["USD"] != 15000000 {
+		t.Errorf("USD = %d, want 15000000", totals["USD"])
+	}
+	if totals["EUR"] != 2000000 {
+		t.Errorf("EUR = %d, want 2000000", totals["EUR"])
+	}
+
+	if got := SuperChatTotals(nil); len(got) != 0 {
+		t.Errorf("SuperChatTotals(nil) = %v, want empty", got)
+	}
+}
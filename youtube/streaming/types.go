@@ -1,6 +1,9 @@
 package streaming
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 // Message types returned by the YouTube Live Chat API.
 const (
@@ -145,10 +148,69 @@ type SuperChatDetails struct {
 	// UserComment is the optional message from the donor.
 	UserComment string `json:"userComment,omitempty"`
 
-	// Tier is the Super Chat tier (1-7).
+	// Tier is the Super Chat tier. Lower amounts belong to lower tiers;
+	// the lowest tier is 1.
 	Tier int `json:"tier,omitempty"`
 }
 
+// SuperChatTier describes how YouTube displays a Super Chat tier.
+type SuperChatTier struct {
+	// Tier is the tier number (SuperChatDetails.Tier).
+	Tier int
+
+	// Color is the tier's header color as a hex string (e.g., "#1E88E5").
+	Color string
+
+	// DurationSecs is how long the Super Chat stays pinned in the chat
+	// ticker, in seconds. Zero means it is not pinned.
+	DurationSecs int
+}
+
+// SuperChatTiers maps Super Chat tiers to their display color and ticker
+// duration. Tiers are ordered by amount, lowest first (USD ranges shown;
+// other currencies use equivalent amounts).
+//
+// Durations follow the YouTube Help Center Super Chat table; colors match
+// the YouTube live chat web client. YouTube may change either without notice,
+// and new tiers can be appended here.
+var SuperChatTiers = []SuperChatTier{
+	{Tier: 1, Color: "#1E88E5", DurationSecs: 0},      // $1.00 - $1.99, blue
+	{Tier: 2, Color: "#00E5FF", DurationSecs: 0},      // $2.00 - $4.99, light blue
+	{Tier: 3, Color: "#1DE9B6", DurationSecs: 120},    // $5.00 - $9.99, green
+	{Tier: 4, Color: "#FFCA28", DurationSecs: 300},    // $10.00 - $19.99, yellow
+	{Tier: 5, Color: "#F57C00", DurationSecs: 600},    // $20.00 - $49.99, orange
+	{Tier: 6, Color: "#E91E63", DurationSecs: 1800},   // $50.00 - $99.99, magenta
+	{Tier: 7, Color: "#E62117", DurationSecs: 3600},   // $100.00 - $199.99, red
+	{Tier: 8, Color: "#E62117", DurationSecs: 7200},   // $200.00 - $299.99, red
+	{Tier: 9, Color: "#E62117", DurationSecs: 10800},  // $300.00 - $399.99, red
+	{Tier: 10, Color: "#E62117", DurationSecs: 14400}, // $400.00 - $499.99, red
+	{Tier: 11, Color: "#E62117", DurationSecs: 18000}, // $500.00, red
+}
+
+// tierInfo returns the SuperChatTiers entry for the tier, if known.
+func (d *SuperChatDetails) tierInfo() (SuperChatTier, bool) {
+	for _, t := range SuperChatTiers {
+		if t.Tier == d.Tier {
+			return t, true
+		}
+	}
+	return SuperChatTier{}, false
+}
+
+// TierColor returns the tier's display color as a hex string (e.g., "#1E88E5").
+// Returns empty string for unknown tiers.
+func (d *SuperChatDetails) TierColor() string {
+	t, _ := d.tierInfo()
+	return t.Color
+}
+
+// TierDurationSecs returns how long the Super Chat stays pinned in the chat
+// ticker, in seconds. Returns 0 for unpinned or unknown tiers.
+func (d *SuperChatDetails) TierDurationSecs() int {
+	t, _ := d.tierInfo()
+	return t.DurationSecs
+}
+
 // SuperStickerDetails contains details for a Super Sticker.
 type SuperStickerDetails struct {
 	// SuperStickerID is the ID of the sticker.
@@ -356,6 +418,40 @@ func (r *LiveChatMessageListResponse) IsChatEnded() bool {
 	return r.OfflineAt != nil
 }
 
+// SortedByPublishedAt returns the response's messages ordered by
+// Snippet.PublishedAt, oldest first. Items is not modified.
+//
+// YouTube generally returns messages in chronological order, but this is not
+// guaranteed, so use this when display order matters (e.g., an overlay
+// merging messages from overlapping polls). The sort is stable: messages
+// with equal timestamps keep their API order, and messages without a
+// timestamp sort first.
+func (r *LiveChatMessageListResponse) SortedByPublishedAt() []*LiveChatMessage {
+	sorted := slices.Clone(r.Items)
+	slices.SortStableFunc(sorted, func(a, b *LiveChatMessage) int {
+		return a.publishedAt().Compare(b.publishedAt())
+	})
+	return sorted
+}
+
+// Age returns how long ago the message was published, or 0 if the
+// publish time is unknown.
+func (m *LiveChatMessage) Age() time.Duration {
+	published := m.publishedAt()
+	if published.IsZero() {
+		return 0
+	}
+	return time.Since(published)
+}
+
+// publishedAt returns the message's publish time, or the zero time if unset.
+func (m *LiveChatMessage) publishedAt() time.Time {
+	if m == nil || m.Snippet == nil {
+		return time.Time{}
+	}
+	return m.Snippet.PublishedAt
+}
+
 // Message returns the display message text.
 func (m *LiveChatMessage) Message() string {
 	if m.Snippet == nil {
@@ -822,6 +918,10 @@ type Cuepoint struct {
 	// WalltimeMs is the wall clock time when the cuepoint should be inserted.
 	// Alternative to InsertionOffsetTimeMs.
 	WalltimeMs int64 `json:"walltimeMs,omitempty,string"`
+
+	// inserted records how InsertCuepoint timed this cuepoint, for
+	// WaitForCuepoint. It is nil for cuepoints not returned by InsertCuepoint.
+	inserted *insertedCuepoint
 }
 
 // CuepointRequest is the request body for inserting a cuepoint.
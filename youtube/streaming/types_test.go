@@ -2,6 +2,7 @@ package streaming
 
 import (
 	"encoding/json"
+	"slices"
 	"testing"
 	"time"
 )
@@ -51,6 +52,64 @@ func TestLiveChatMessageListResponse_IsChatEnded(t *testing.T) {
 	}
 }
 
+func TestLiveChatMessageListResponse_SortedByPublishedAt(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	msg := func(id string, offset time.Duration) *LiveChatMessage {
+		return &LiveChatMessage{ID: id, Snippet: &MessageSnippet{PublishedAt: base.Add(offset)}}
+	}
+
+	resp := &LiveChatMessageListResponse{
+		Items: []*LiveChatMessage{
+			msg("c", 2*time.Second),
+			msg("a", 0),
+			{ID: "no-snippet"},
+			msg("b1", time.Second),
+			msg("b2", time.Second),
+		},
+	}
+
+	sorted := resp.SortedByPublishedAt()
+
+	var ids []string
+	for _, m := range sorted {
+		ids = append(ids, m.ID)
+	}
+	want := []string{"no-snippet", "a", "b1", "b2", "c"}
+	if !slices.Equal(ids, want) {
+		t.Errorf("sorted IDs = %v, want %v", ids, want)
+	}
+	if resp.Items[0].ID != "c" {
+		t.Error("SortedByPublishedAt() should not modify Items")
+	}
+
+	empty := &LiveChatMessageListResponse{}
+	if got := empty.SortedByPublishedAt(); len(got) != 0 {
+		t.Errorf("SortedByPublishedAt() on empty response = %v, want empty", got)
+	}
+}
+
+func TestLiveChatMessage_Age(t *testing.T) {
+	recent := &LiveChatMessage{Snippet: &MessageSnippet{PublishedAt: time.Now().Add(-time.Minute)}}
+	if age := recent.Age(); age < time.Minute || age > time.Minute+5*time.Second {
+		t.Errorf("Age() = %v, want about 1m", age)
+	}
+
+	tests := []struct {
+		name string
+		msg  *LiveChatMessage
+	}{
+		{"nil snippet", &LiveChatMessage{}},
+		{"zero time", &LiveChatMessage{Snippet: &MessageSnippet{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if age := tt.msg.Age(); age != 0 {
+				t.Errorf("Age() = %v, want 0", age)
+			}
+		})
+	}
+}
+
 func TestLiveChatMessage_Type(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -316,6 +375,46 @@ func TestSuperChatDetails_JSON(t *testing.T) {
 	}
 }
 
+func TestSuperChatDetails_Tier(t *testing.T) {
+	tests := []struct {
+		tier         int
+		wantColor    string
+		wantDuration int
+	}{
+		{0, "", 0},
+		{1, "#1E88E5", 0},
+		{2, "#00E5FF", 0},
+		{3, "#1DE9B6", 120},
+		{4, "#FFCA28", 300},
+		{5, "#F57C00", 600},
+		{6, "#E91E63", 1800},
+		{7, "#E62117", 3600},
+		{11, "#E62117", 18000},
+		{12, "", 0},
+	}
+
+	for _, tt := range tests {
+		d := &SuperChatDetails{Tier: tt.tier}
+		if got := d.TierColor(); got != tt.wantColor {
+			t.Errorf("tier %d: TierColor() = %q, want %q", tt.tier, got, tt.wantColor)
+		}
+		if got := d.TierDurationSecs(); got != tt.wantDuration {
+			t.Errorf("tier %d: TierDurationSecs() = %d, want %d", tt.tier, got, tt.wantDuration)
+		}
+	}
+}
+
+func TestSuperChatTiers_Ordered(t *testing.T) {
+	for i, tier := range SuperChatTiers {
+		if tier.Tier != i+1 {
+			t.Errorf("SuperChatTiers[%d].Tier = %d, want %d", i, tier.Tier, i+1)
+		}
+		if i > 0 && tier.DurationSecs < SuperChatTiers[i-1].DurationSecs {
+			t.Errorf("tier %d duration %d is shorter than tier %d", tier.Tier, tier.DurationSecs, tier.Tier-1)
+		}
+	}
+}
+
 func TestUserBannedDetails_JSON(t *testing.T) {
 	jsonData := `{
 		"snippet": {